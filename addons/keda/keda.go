@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"sort"
 	"strings"
 	"text/template"
@@ -60,11 +61,20 @@ const (
 	kameletAnnotationMetadataPrefix = "camel.apache.org/keda.metadata."
 	// kameletAnnotationAuthenticationPrefix is used to define virtual authentication fields computed from Kamelet properties.
 	kameletAnnotationAuthenticationPrefix = "camel.apache.org/keda.authentication."
+
+	// kafkaComponent is the scheme of the Kafka consumer endpoints triggers are inferred from.
+	kafkaComponent = "kafka"
+	// kafkaScalerType is the KEDA scaler type associated to the Kafka consumer endpoints.
+	kafkaScalerType = "kafka"
+	// rabbitmqComponent is the scheme of the AMQP consumer endpoints triggers are inferred from.
+	rabbitmqComponent = "rabbitmq"
+	// rabbitmqScalerType is the KEDA scaler type associated to the AMQP consumer endpoints.
+	rabbitmqScalerType = "rabbitmq"
 )
 
 // The KEDA trait can be used for automatic integration with KEDA autoscalers.
 // The trait can be either manually configured using the `triggers` option or automatically configured
-// via markers in the Kamelets.
+// via markers in the Kamelets, or from the Kafka and RabbitMQ consumer endpoints of the integration.
 //
 // For information on how to use KEDA enabled Kamelets with the KEDA trait, refer to
 // xref:ROOT:kamelets/kamelets-user.adoc#kamelet-keda-user[the KEDA section in the Kamelets user guide].
@@ -76,7 +86,9 @@ const (
 // +camel-k:trait=keda.
 type Trait struct {
 	traitv1.Trait `property:",squash" json:",inline"`
-	// Enables automatic configuration of the trait. Allows the trait to infer KEDA triggers from the Kamelets.
+	// Enables automatic configuration of the trait. Allows the trait to infer KEDA triggers from the Kamelets,
+	// from the Kafka consumer endpoints (topic, `groupId` and `brokers` options),
+	// and from the RabbitMQ consumer endpoints (`queue` and `hostname` or `addresses` options).
 	Auto *bool `property:"auto" json:"auto,omitempty"`
 	// Set the spec->replicas field on the top level controller to an explicit value if missing, to allow KEDA to recognize it as a scalable resource.
 	HackControllerReplicas *bool `property:"hack-controller-replicas" json:"hackControllerReplicas,omitempty"`
@@ -125,16 +137,21 @@ func (t *kedaTrait) Configure(e *trait.Environment) (bool, error) {
 		return false, nil
 	}
 
-	if t.Auto == nil || *t.Auto {
-		if err := t.populateTriggersFromKamelets(e); err != nil {
-			return false, err
-		}
-	}
-
-	return len(t.Triggers) > 0, nil
+	// The triggers are inferred when the trait is applied, as the endpoint options can be
+	// read from the application properties, that are computed by the preceding traits
+	return pointer.BoolDeref(t.Auto, true) || len(t.Triggers) > 0, nil
 }
 
 func (t *kedaTrait) Apply(e *trait.Environment) error {
+	if pointer.BoolDeref(t.Auto, true) {
+		if err := t.populateTriggersFromSources(e); err != nil {
+			return err
+		}
+	}
+	if len(t.Triggers) == 0 {
+		return nil
+	}
+
 	if e.IntegrationInPhase(camelv1.IntegrationPhaseInitialization) {
 		if t.HackControllerReplicas == nil || *t.HackControllerReplicas {
 			if err := t.hackControllerReplicas(e); err != nil {
@@ -312,15 +329,20 @@ func (t *kedaTrait) getTopControllerReference(e *trait.Environment) *v1.ObjectRe
 	}
 }
 
-func (t *kedaTrait) populateTriggersFromKamelets(e *trait.Environment) error {
+func (t *kedaTrait) populateTriggersFromSources(e *trait.Environment) error {
 	sources, err := kubernetes.ResolveIntegrationSources(e.Ctx, e.Client, e.Integration, e.Resources)
 	if err != nil {
 		return err
 	}
 	kameletURIs := make(map[string][]string)
+	var kafkaURIs, rabbitmqURIs []string
 	if err := metadata.Each(e.CamelCatalog, sources, func(_ int, meta metadata.IntegrationMetadata) bool {
 		for _, kameletURI := range meta.FromURIs {
-			if kameletStr := source.ExtractKamelet(kameletURI); kameletStr != "" && camelv1alpha1.ValidKameletName(kameletStr) {
+			if component := uri.GetComponent(kameletURI); component == kafkaComponent {
+				util.StringSliceUniqueAdd(&kafkaURIs, kameletURI)
+			} else if component == rabbitmqComponent {
+				util.StringSliceUniqueAdd(&rabbitmqURIs, kameletURI)
+			} else if kameletStr := source.ExtractKamelet(kameletURI); kameletStr != "" && camelv1alpha1.ValidKameletName(kameletStr) {
 				kamelet := kameletStr
 				if strings.Contains(kamelet, "/") {
 					kamelet = kamelet[0:strings.Index(kamelet, "/")]
@@ -336,6 +358,28 @@ func (t *kedaTrait) populateTriggersFromKamelets(e *trait.Environment) error {
 		return err
 	}
 
+	if err := t.populateTriggersFromKamelets(e, kameletURIs); err != nil {
+		return err
+	}
+
+	sort.Strings(kafkaURIs)
+	for _, kafkaURI := range kafkaURIs {
+		if err := t.populateTriggersFromKafkaURI(e, kafkaURI); err != nil {
+			return err
+		}
+	}
+
+	sort.Strings(rabbitmqURIs)
+	for _, rabbitmqURI := range rabbitmqURIs {
+		if err := t.populateTriggersFromRabbitMQURI(e, rabbitmqURI); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *kedaTrait) populateTriggersFromKamelets(e *trait.Environment, kameletURIs map[string][]string) error {
 	if len(kameletURIs) == 0 {
 		return nil
 	}
@@ -450,6 +494,107 @@ func (t *kedaTrait) populateTriggersFromKameletURI(e *trait.Environment, kamelet
 	return nil
 }
 
+func (t *kedaTrait) populateTriggersFromKafkaURI(e *trait.Environment, kafkaURI string) error {
+	topics := strings.TrimPrefix(kafkaURI, kafkaComponent+":")
+	if i := strings.Index(topics, "?"); i >= 0 {
+		topics = topics[0:i]
+	}
+	brokers, err := t.getComponentOptionValue(e, kafkaComponent, kafkaURI, "brokers")
+	if err != nil {
+		return err
+	}
+	groupID, err := t.getComponentOptionValue(e, kafkaComponent, kafkaURI, "groupId")
+	if err != nil {
+		return err
+	}
+	if topics == "" || brokers == "" || groupID == "" {
+		t.L.Infof("Skipping KEDA trigger inference for endpoint %q: the topic, brokers and groupId options are required", kafkaURI)
+		return nil
+	}
+
+	for _, topic := range strings.Split(topics, ",") {
+		t.Triggers = append(t.Triggers, kedaTrigger{
+			Type: kafkaScalerType,
+			Metadata: map[string]string{
+				"bootstrapServers": brokers,
+				"consumerGroup":    groupID,
+				"topic":            topic,
+			},
+		})
+	}
+	return nil
+}
+
+func (t *kedaTrait) populateTriggersFromRabbitMQURI(e *trait.Environment, rabbitmqURI string) error {
+	options := make(map[string]string)
+	for _, option := range []string{"queue", "hostname", "portNumber", "addresses", "vhost", "username", "password"} {
+		v, err := t.getComponentOptionValue(e, rabbitmqComponent, rabbitmqURI, option)
+		if err != nil {
+			return err
+		}
+		options[option] = v
+	}
+	address := options["hostname"]
+	if address == "" && options["addresses"] != "" {
+		address = strings.Split(options["addresses"], ",")[0]
+	} else if address != "" {
+		port := options["portNumber"]
+		if port == "" {
+			port = "5672"
+		}
+		address = fmt.Sprintf("%s:%s", address, port)
+	}
+	if options["queue"] == "" || address == "" {
+		t.L.Infof("Skipping KEDA trigger inference for endpoint %q: the queue, and hostname or addresses options are required", rabbitmqURI)
+		return nil
+	}
+
+	host := url.URL{
+		Scheme: "amqp",
+		Host:   address,
+	}
+	if vhost := options["vhost"]; vhost != "" {
+		host.Path = "/" + vhost
+		host.RawPath = "/" + url.PathEscape(vhost)
+	}
+	trigger := kedaTrigger{
+		Type: rabbitmqScalerType,
+		Metadata: map[string]string{
+			"protocol":  "amqp",
+			"queueName": options["queue"],
+		},
+	}
+	if options["username"] != "" {
+		// The credentials are part of the host URL, that's stored in the authentication secret
+		host.User = url.UserPassword(options["username"], options["password"])
+		trigger.authentication = map[string]string{
+			"host": host.String(),
+		}
+	} else {
+		trigger.Metadata["host"] = host.String()
+	}
+	t.Triggers = append(t.Triggers, trigger)
+	return nil
+}
+
+func (t *kedaTrait) getComponentOptionValue(e *trait.Environment, component, endpointURI, option string) (string, error) {
+	// From top priority to lowest
+	if v := uri.GetQueryParameter(endpointURI, option); v != "" {
+		return v, nil
+	}
+	componentKey := fmt.Sprintf("camel.component.%s.%s", component, option)
+	for _, c := range e.Integration.Spec.Configuration {
+		if c.Type == "property" && strings.HasPrefix(c.Value, componentKey) {
+			v, err := property.DecodePropertyFileValue(c.Value, componentKey)
+			if err != nil {
+				return "", errors.Wrapf(err, "could not decode property %q", componentKey)
+			}
+			return v, nil
+		}
+	}
+	return e.ApplicationProperties[componentKey], nil
+}
+
 func (t *kedaTrait) evaluateTemplateParameters(e *trait.Environment, kamelet *camelv1alpha1.Kamelet, kameletURI string) (map[string]string, map[string]bool, error) {
 	paramTemplates := make(map[string]string)
 	authenticationParam := make(map[string]bool)
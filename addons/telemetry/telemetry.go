@@ -18,6 +18,9 @@ limitations under the License.
 package telemetry
 
 import (
+	"fmt"
+	"strconv"
+
 	"k8s.io/utils/pointer"
 
 	"github.com/apache/camel-k/addons/telemetry/discovery"
@@ -44,9 +47,11 @@ type Trait struct {
 	ServiceName string `property:"service-name" json:"serviceName,omitempty"`
 	// The target endpoint of the Telemetry service (automatically discovered by default)
 	Endpoint string `property:"endpoint" json:"endpoint,omitempty"`
-	// The sampler of the telemetry used for tracing (default "on")
+	// The sampler of the telemetry used for tracing, either `on`, `off` or `ratio` (default "on").
+	// The OpenTelemetry sampler names are also supported, i.e., `always_on`, `always_off`,
+	// `traceidratio`, `parentbased_always_on`, `parentbased_always_off` and `parentbased_traceidratio`.
 	Sampler string `property:"sampler" json:"sampler,omitempty"`
-	// The sampler ratio of the telemetry used for tracing
+	// The sampler ratio of the telemetry used for tracing, between 0 and 1
 	SamplerRatio string `property:"sampler-ratio" json:"sampler-ratio,omitempty"`
 	// The sampler of the telemetry used for tracing is parent based (default "true")
 	SamplerParentBased *bool `property:"sampler-parent-based" json:"sampler-parent-based,omitempty"`
@@ -66,6 +71,22 @@ const (
 	propSamplerParentBased = "propSamplerParentBased"
 )
 
+// telemetrySamplers maps the supported sampler names to the runtime sampler, and whether it's parent based.
+var telemetrySamplers = map[string]struct {
+	sampler     string
+	parentBased *bool
+}{
+	"on":                       {sampler: "on"},
+	"off":                      {sampler: "off"},
+	"ratio":                    {sampler: "ratio"},
+	"always_on":                {sampler: "on", parentBased: pointer.Bool(false)},
+	"always_off":               {sampler: "off", parentBased: pointer.Bool(false)},
+	"traceidratio":             {sampler: "ratio", parentBased: pointer.Bool(false)},
+	"parentbased_always_on":    {sampler: "on", parentBased: pointer.Bool(true)},
+	"parentbased_always_off":   {sampler: "off", parentBased: pointer.Bool(true)},
+	"parentbased_traceidratio": {sampler: "ratio", parentBased: pointer.Bool(true)},
+}
+
 var (
 	telemetryProperties = map[v1.RuntimeProvider]map[string]string{
 		v1.RuntimeProviderQuarkus: {
@@ -111,12 +132,50 @@ func (t *telemetryTrait) Configure(e *trait.Environment) (bool, error) {
 
 		if t.Sampler == "" {
 			t.Sampler = "on"
+			if t.SamplerRatio != "" {
+				t.Sampler = "ratio"
+			}
 		}
 	}
 
+	if err := t.configureSampler(); err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
+// configureSampler validates the sampler configuration, and resolves the OpenTelemetry sampler names
+// into the runtime sampler configuration.
+func (t *telemetryTrait) configureSampler() error {
+	if t.SamplerRatio != "" {
+		ratio, err := strconv.ParseFloat(t.SamplerRatio, 64)
+		if err != nil || ratio < 0 || ratio > 1 {
+			return fmt.Errorf("invalid telemetry sampler ratio %s: it must be a number between 0 and 1", t.SamplerRatio)
+		}
+	}
+	if t.Sampler == "" {
+		return nil
+	}
+
+	sampler, ok := telemetrySamplers[t.Sampler]
+	if !ok {
+		return fmt.Errorf("unsupported telemetry sampler %s", t.Sampler)
+	}
+	if sampler.parentBased != nil {
+		if t.SamplerParentBased != nil && *t.SamplerParentBased != *sampler.parentBased {
+			return fmt.Errorf("telemetry sampler %s conflicts with sampler-parent-based=%t", t.Sampler, *t.SamplerParentBased)
+		}
+		t.SamplerParentBased = sampler.parentBased
+	}
+	t.Sampler = sampler.sampler
+	if t.SamplerRatio != "" && t.Sampler != "ratio" {
+		return fmt.Errorf("telemetry sampler ratio %s requires a ratio based sampler", t.SamplerRatio)
+	}
+
+	return nil
+}
+
 func (t *telemetryTrait) Apply(e *trait.Environment) error {
 	util.StringSliceUniqueAdd(&e.Integration.Status.Capabilities, v1.CapabilityTelemetry)
 
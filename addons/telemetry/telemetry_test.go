@@ -76,6 +76,77 @@ func TestTelemetryTraitWithValues(t *testing.T) {
 	assert.Equal(t, "false", e.ApplicationProperties["quarkus.opentelemetry.tracer.sampler.parent-based"])
 }
 
+func TestTelemetryTraitWithOpenTelemetrySampler(t *testing.T) {
+	e := createEnvironment(t, camel.QuarkusCatalog)
+	telemetry := NewTelemetryTrait()
+	tt, _ := telemetry.(*telemetryTrait)
+	tt.Enabled = pointer.Bool(true)
+	tt.Endpoint = "http://endpoint3"
+	tt.Sampler = "traceidratio"
+	tt.SamplerRatio = "0.1"
+	ok, err := telemetry.Configure(e)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	err = telemetry.Apply(e)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "ratio", e.ApplicationProperties["quarkus.opentelemetry.tracer.sampler"])
+	assert.Equal(t, "0.1", e.ApplicationProperties["quarkus.opentelemetry.tracer.sampler.ratio"])
+	assert.Equal(t, "false", e.ApplicationProperties["quarkus.opentelemetry.tracer.sampler.parent-based"])
+}
+
+func TestTelemetryTraitWithSamplerRatioOnly(t *testing.T) {
+	e := createEnvironment(t, camel.QuarkusCatalog)
+	telemetry := NewTelemetryTrait()
+	tt, _ := telemetry.(*telemetryTrait)
+	tt.Enabled = pointer.Bool(true)
+	tt.Endpoint = "http://endpoint3"
+	tt.SamplerRatio = "0.5"
+	ok, err := telemetry.Configure(e)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	err = telemetry.Apply(e)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "ratio", e.ApplicationProperties["quarkus.opentelemetry.tracer.sampler"])
+	assert.Equal(t, "0.5", e.ApplicationProperties["quarkus.opentelemetry.tracer.sampler.ratio"])
+	assert.Equal(t, "true", e.ApplicationProperties["quarkus.opentelemetry.tracer.sampler.parent-based"])
+}
+
+func TestTelemetryTraitWithInvalidSampler(t *testing.T) {
+	tests := []struct {
+		name        string
+		sampler     string
+		ratio       string
+		parentBased *bool
+		err         string
+	}{
+		{name: "unknown sampler", sampler: "sometimes", err: "unsupported telemetry sampler sometimes"},
+		{name: "ratio above 1", sampler: "ratio", ratio: "1.5", err: "invalid telemetry sampler ratio 1.5: it must be a number between 0 and 1"},
+		{name: "negative ratio", sampler: "ratio", ratio: "-0.1", err: "invalid telemetry sampler ratio -0.1: it must be a number between 0 and 1"},
+		{name: "not a number", sampler: "ratio", ratio: "half", err: "invalid telemetry sampler ratio half: it must be a number between 0 and 1"},
+		{name: "ratio without ratio sampler", sampler: "on", ratio: "0.1", err: "telemetry sampler ratio 0.1 requires a ratio based sampler"},
+		{name: "parent based conflict", sampler: "parentbased_traceidratio", ratio: "0.1", parentBased: pointer.Bool(false), err: "telemetry sampler parentbased_traceidratio conflicts with sampler-parent-based=false"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := createEnvironment(t, camel.QuarkusCatalog)
+			telemetry := NewTelemetryTrait()
+			tt, _ := telemetry.(*telemetryTrait)
+			tt.Enabled = pointer.Bool(true)
+			tt.Endpoint = "http://endpoint3"
+			tt.Sampler = test.sampler
+			tt.SamplerRatio = test.ratio
+			tt.SamplerParentBased = test.parentBased
+			ok, err := telemetry.Configure(e)
+			assert.False(t, ok)
+			assert.EqualError(t, err, test.err)
+		})
+	}
+}
+
 func createEnvironment(t *testing.T, catalogGen func() (*camel.RuntimeCatalog, error)) *trait.Environment {
 	t.Helper()
 
@@ -49,7 +49,8 @@ const (
 	// camel-k-operator-custom-resource-definitions
 	// camel-k-operator-bind-addressable-resolver
 	// camel-k-operator-local-registry
-	ExpectedKubeClusterRoles = 4
+	// camel-k-operator-nodes
+	ExpectedKubeClusterRoles = 5
 
 	// camel-k-operator-openshift
 	ExpectedOSPromoteRoles = 1
@@ -37,6 +37,12 @@ type BuildSpec struct {
 	// and its phase set to BuildPhaseFailed.
 	// +kubebuilder:validation:Format=duration
 	Timeout metav1.Duration `json:"timeout,omitempty"`
+	// MaxRecoveryAttempts is the maximum number of times a failed Build is retried.
+	// It defaults to 5 when not set.
+	MaxRecoveryAttempts *int32 `json:"maxRecoveryAttempts,omitempty"`
+	// MaxRunningBuilds is the maximum number of builds that can run concurrently in the Build namespace.
+	// The Build is queued until the number of running builds drops below that limit. There is no limit when not set.
+	MaxRunningBuilds *int32 `json:"maxRunningBuilds,omitempty"`
 }
 
 // Task represents the abstract task. Only one of the task should be configured to represent the specific task chosen.
@@ -84,6 +90,9 @@ type MavenBuildSpec struct {
 	Repositories []Repository `json:"repositories,omitempty"`
 	// Servers (auth)
 	Servers []Server `json:"servers,omitempty"`
+	// The Secret key holding the encrypted Maven master password, that the security of the Maven settings
+	// is generated from
+	MasterPassword *corev1.SecretKeySelector `json:"masterPassword,omitempty"`
 }
 
 // PublishTask image publish configuration
@@ -104,10 +113,16 @@ type BuildahTask struct {
 	PublishTask `json:",inline"`
 	// The platform of build image
 	Platform string `json:"platform,omitempty"`
+	// The platforms of the image manifest list, that's built instead of a single image when set
+	Platforms []string `json:"platforms,omitempty"`
 	// log more information
 	Verbose *bool `json:"verbose,omitempty"`
 	// docker image to use
 	ExecutorImage string `json:"executorImage,omitempty"`
+	// run Buildah as a non-root user, which requires the executor image to provide that user
+	Rootless *bool `json:"rootless,omitempty"`
+	// the UID of the non-root user Buildah is run as, when rootless (default 1000)
+	RunAsUser *int64 `json:"runAsUser,omitempty"`
 }
 
 // KanikoTask is used to configure Kaniko
@@ -159,12 +174,16 @@ type BuildStatus struct {
 	BaseImage string `json:"baseImage,omitempty"`
 	// a list of artifacts contained in the build
 	Artifacts []Artifact `json:"artifacts,omitempty"`
+	// the status of each task of the build
+	Tasks []TaskStatus `json:"tasks,omitempty"`
 	// the error description (if any)
 	Error string `json:"error,omitempty"`
 	// the reason of the failure (if any)
 	Failure *Failure `json:"failure,omitempty"`
 	// the time when it started
 	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// the position of the build in the scheduling queue, starting at 1 (if queued)
+	QueuePosition int32 `json:"queuePosition,omitempty"`
 	// a list of conditions occurred during the build
 	Conditions []BuildCondition `json:"conditions,omitempty"`
 	// how long it took for the build
@@ -173,6 +192,20 @@ type BuildStatus struct {
 	Duration string `json:"duration,omitempty"`
 }
 
+// TaskStatus defines the observed state of a Build task
+type TaskStatus struct {
+	// the name of the task
+	Name string `json:"name"`
+	// describes the phase of the task
+	Phase BuildPhase `json:"phase,omitempty"`
+	// the time when the task started
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// the time when the task completed
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// the error description (if any)
+	Error string `json:"error,omitempty"`
+}
+
 // BuildPhase --
 type BuildPhase string
 
@@ -182,6 +215,8 @@ type BuildConditionType string
 const (
 	// BuildKind --
 	BuildKind string = "Build"
+	// BuildMaxRecoveryAttemptsDefault is the default maximum number of times a failed Build is retried.
+	BuildMaxRecoveryAttemptsDefault = 5
 
 	// BuildPhaseNone --
 	BuildPhaseNone BuildPhase = ""
@@ -48,6 +48,58 @@ func (buildPhase *BuildPhase) String() string {
 	return string(*buildPhase)
 }
 
+// GetName returns the name of the task, whatever its kind.
+func (t *Task) GetName() string {
+	switch {
+	case t.Builder != nil:
+		return t.Builder.Name
+	case t.Buildah != nil:
+		return t.Buildah.Name
+	case t.Kaniko != nil:
+		return t.Kaniko.Name
+	case t.Spectrum != nil:
+		return t.Spectrum.Name
+	case t.S2i != nil:
+		return t.S2i.Name
+	}
+	return ""
+}
+
+// GetMaxRecoveryAttempts returns the maximum number of times a failed build is retried.
+func (in *BuildSpec) GetMaxRecoveryAttempts() int {
+	if in.MaxRecoveryAttempts == nil {
+		return BuildMaxRecoveryAttemptsDefault
+	}
+	return int(*in.MaxRecoveryAttempts)
+}
+
+// GetMaxRunningBuilds returns the maximum number of builds that can run concurrently, or 0 when there is no limit.
+func (in *BuildSpec) GetMaxRunningBuilds() int {
+	if in.MaxRunningBuilds == nil {
+		return 0
+	}
+	return int(*in.MaxRunningBuilds)
+}
+
+// GetTaskStatus returns the status of the task with the provided name.
+func (in *BuildStatus) GetTaskStatus(name string) *TaskStatus {
+	for i := range in.Tasks {
+		if in.Tasks[i].Name == name {
+			return &in.Tasks[i]
+		}
+	}
+	return nil
+}
+
+// SetTaskStatus sets the status of the task, replacing any previous status with the same name.
+func (in *BuildStatus) SetTaskStatus(status TaskStatus) {
+	if s := in.GetTaskStatus(status.Name); s != nil {
+		*s = status
+		return
+	}
+	in.Tasks = append(in.Tasks, status)
+}
+
 // GetCondition returns the condition with the provided type.
 func (in *BuildStatus) GetCondition(condType BuildConditionType) *BuildCondition {
 	for i := range in.Conditions {
@@ -0,0 +1,53 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+)
+
+func TestGetMaxRecoveryAttempts(t *testing.T) {
+	spec := BuildSpec{}
+	assert.Equal(t, BuildMaxRecoveryAttemptsDefault, spec.GetMaxRecoveryAttempts())
+
+	spec.MaxRecoveryAttempts = pointer.Int32(2)
+	assert.Equal(t, 2, spec.GetMaxRecoveryAttempts())
+}
+
+func TestSetTaskStatus(t *testing.T) {
+	status := BuildStatus{}
+
+	status.SetTaskStatus(TaskStatus{Name: "builder", Phase: BuildPhaseRunning})
+	status.SetTaskStatus(TaskStatus{Name: "kaniko", Phase: BuildPhasePending})
+	assert.Len(t, status.Tasks, 2)
+
+	status.SetTaskStatus(TaskStatus{Name: "builder", Phase: BuildPhaseSucceeded})
+	assert.Len(t, status.Tasks, 2)
+	assert.Equal(t, BuildPhaseSucceeded, status.GetTaskStatus("builder").Phase)
+	assert.Equal(t, BuildPhasePending, status.GetTaskStatus("kaniko").Phase)
+	assert.Nil(t, status.GetTaskStatus("spectrum"))
+}
+
+func TestGetTaskName(t *testing.T) {
+	task := Task{Kaniko: &KanikoTask{BaseTask: BaseTask{Name: "kaniko"}}}
+	assert.Equal(t, "kaniko", task.GetName())
+	assert.Equal(t, "", (&Task{}).GetName())
+}
@@ -132,6 +132,10 @@ type Traits struct {
 	Deployer *trait.DeployerTrait `property:"deployer" json:"deployer,omitempty"`
 	// The configuration of Deployment trait
 	Deployment *trait.DeploymentTrait `property:"deployment" json:"deployment,omitempty"`
+	// The configuration of DNS trait
+	DNS *trait.DNSTrait `property:"dns" json:"dns,omitempty"`
+	// The configuration of Drain trait
+	Drain *trait.DrainTrait `property:"drain" json:"drain,omitempty"`
 	// The configuration of Environment trait
 	Environment *trait.EnvironmentTrait `property:"environment" json:"environment,omitempty"`
 	// The configuration of Error Handler trait
@@ -140,6 +144,12 @@ type Traits struct {
 	GC *trait.GCTrait `property:"gc" json:"gc,omitempty"`
 	// The configuration of Health trait
 	Health *trait.HealthTrait `property:"health" json:"health,omitempty"`
+	// The configuration of HPA trait
+	HPA *trait.HPATrait `property:"hpa" json:"hpa,omitempty"`
+	// The configuration of HTTP trait
+	HTTP *trait.HTTPTrait `property:"http" json:"http,omitempty"`
+	// The configuration of Infinispan trait
+	Infinispan *trait.InfinispanTrait `property:"infinispan" json:"infinispan,omitempty"`
 	// The configuration of Ingress trait
 	Ingress *trait.IngressTrait `property:"ingress" json:"ingress,omitempty"`
 	// The configuration of Istio trait
@@ -148,6 +158,8 @@ type Traits struct {
 	Jolokia *trait.JolokiaTrait `property:"jolokia" json:"jolokia,omitempty"`
 	// The configuration of JVM trait
 	JVM *trait.JVMTrait `property:"jvm" json:"jvm,omitempty"`
+	// The configuration of Kafka trait
+	Kafka *trait.KafkaTrait `property:"kafka" json:"kafka,omitempty"`
 	// The configuration of Kamelets trait
 	Kamelets *trait.KameletsTrait `property:"kamelets" json:"kamelets,omitempty"`
 	// The configuration of Knative trait
@@ -158,6 +170,8 @@ type Traits struct {
 	Logging *trait.LoggingTrait `property:"logging" json:"logging,omitempty"`
 	// The configuration of Mount trait
 	Mount *trait.MountTrait `property:"mount" json:"mount,omitempty"`
+	// The configuration of NetworkPolicy trait
+	NetworkPolicy *trait.NetworkPolicyTrait `property:"network-policy" json:"network-policy,omitempty"`
 	// The configuration of OpenAPI trait
 	OpenAPI *trait.OpenAPITrait `property:"openapi" json:"openapi,omitempty"`
 	// The configuration of Owner trait
@@ -178,12 +192,22 @@ type Traits struct {
 	Registry *trait.RegistryTrait `property:"registry" json:"registry,omitempty"`
 	// The configuration of Route trait
 	Route *trait.RouteTrait `property:"route" json:"route,omitempty"`
+	// The configuration of Security Context trait
+	SecurityContext *trait.SecurityContextTrait `property:"security-context" json:"security-context,omitempty"`
 	// The configuration of Service trait
 	Service *trait.ServiceTrait `property:"service" json:"service,omitempty"`
+	// The configuration of Service CA trait
+	ServiceCA *trait.ServiceCATrait `property:"service-ca" json:"service-ca,omitempty"`
 	// The configuration of Service Binding trait
 	ServiceBinding *trait.ServiceBindingTrait `property:"service-binding" json:"service-binding,omitempty"`
+	// The configuration of Spot trait
+	Spot *trait.SpotTrait `property:"spot" json:"spot,omitempty"`
+	// The configuration of StatefulSet trait
+	StatefulSet *trait.StatefulSetTrait `property:"stateful-set" json:"stateful-set,omitempty"`
 	// The configuration of Toleration trait
 	Toleration *trait.TolerationTrait `property:"toleration" json:"toleration,omitempty"`
+	// The configuration of Validation trait
+	Validation *trait.ValidationTrait `property:"validation" json:"validation,omitempty"`
 
 	// The extension point with addon traits
 	Addons map[string]AddonTrait `json:"addons,omitempty"`
@@ -281,6 +305,8 @@ type Capability struct {
 const (
 	// ServiceTypeUser service user type label marker
 	ServiceTypeUser = "user"
+	// ServiceTypeAdditional service type label marker of the Services that only expose some of the integration ports
+	ServiceTypeAdditional = "additional"
 
 	// CapabilityRest defines the REST API service exposure capability
 	CapabilityRest = "rest"
@@ -118,6 +118,8 @@ type IntegrationStatus struct {
 	Capabilities []string `json:"capabilities,omitempty"`
 	// the timestamp representing the last time when this integration was initialized.
 	InitializationTimestamp *metav1.Time `json:"lastInitTimestamp,omitempty"`
+	// the number of attempts made to resolve the unmet dependencies of the Integration
+	DependenciesResolutionAttempts int32 `json:"dependenciesResolutionAttempts,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -166,6 +168,8 @@ const (
 	IntegrationConditionKnativeServiceAvailable IntegrationConditionType = "KnativeServiceAvailable"
 	// IntegrationConditionCronJobAvailable --
 	IntegrationConditionCronJobAvailable IntegrationConditionType = "CronJobAvailable"
+	// IntegrationConditionStatefulSetAvailable --
+	IntegrationConditionStatefulSetAvailable IntegrationConditionType = "StatefulSetAvailable"
 	// IntegrationConditionExposureAvailable --
 	IntegrationConditionExposureAvailable IntegrationConditionType = "ExposureAvailable"
 	// IntegrationConditionPrometheusAvailable --
@@ -207,6 +211,8 @@ const (
 	IntegrationConditionCronJobAvailableReason string = "CronJobAvailableReason"
 	// IntegrationConditionCronJobNotAvailableReason --
 	IntegrationConditionCronJobNotAvailableReason string = "CronJobNotAvailableReason"
+	// IntegrationConditionStatefulSetAvailableReason --
+	IntegrationConditionStatefulSetAvailableReason string = "StatefulSetAvailable"
 	// IntegrationConditionPrometheusAvailableReason --
 	IntegrationConditionPrometheusAvailableReason string = "PrometheusAvailable"
 	// IntegrationConditionJolokiaAvailableReason --
@@ -220,6 +226,10 @@ const (
 	IntegrationConditionDeploymentReadyReason string = "DeploymentReady"
 	// IntegrationConditionDeploymentProgressingReason --
 	IntegrationConditionDeploymentProgressingReason string = "DeploymentProgressing"
+	// IntegrationConditionStatefulSetReadyReason --
+	IntegrationConditionStatefulSetReadyReason string = "StatefulSetReady"
+	// IntegrationConditionStatefulSetProgressingReason --
+	IntegrationConditionStatefulSetProgressingReason string = "StatefulSetProgressing"
 	// IntegrationConditionCronJobCreatedReason --
 	IntegrationConditionCronJobCreatedReason string = "CronJobCreated"
 	// IntegrationConditionCronJobActiveReason --
@@ -244,6 +254,55 @@ const (
 	IntegrationConditionKameletsAvailableReason string = "KameletsAvailable"
 	// IntegrationConditionKameletsNotAvailableReason --
 	IntegrationConditionKameletsNotAvailableReason string = "KameletsNotAvailable"
+
+	// IntegrationConditionDependenciesResolved --
+	IntegrationConditionDependenciesResolved IntegrationConditionType = "DependenciesResolved"
+	// IntegrationConditionDependenciesResolvedReason --
+	IntegrationConditionDependenciesResolvedReason string = "DependenciesResolved"
+	// IntegrationConditionDependenciesResolutionRetryingReason --
+	IntegrationConditionDependenciesResolutionRetryingReason string = "DependenciesResolutionRetrying"
+	// IntegrationConditionDependenciesResolutionFailedReason --
+	IntegrationConditionDependenciesResolutionFailedReason string = "DependenciesResolutionFailed"
+
+	// IntegrationConditionReconciliationPaused --
+	IntegrationConditionReconciliationPaused IntegrationConditionType = "ReconciliationPaused"
+	// IntegrationConditionReconciliationPausedReason --
+	IntegrationConditionReconciliationPausedReason string = "ReconciliationPaused"
+
+	// IntegrationConditionImageSignatureVerified --
+	IntegrationConditionImageSignatureVerified IntegrationConditionType = "ImageSignatureVerified"
+	// IntegrationConditionImageSignatureVerifiedReason --
+	IntegrationConditionImageSignatureVerifiedReason string = "ImageSignatureVerified"
+	// IntegrationConditionImageSignatureVerificationFailedReason --
+	IntegrationConditionImageSignatureVerificationFailedReason string = "ImageSignatureVerificationFailed"
+
+	// IntegrationConditionResourcesConfigured --
+	IntegrationConditionResourcesConfigured IntegrationConditionType = "ResourcesConfigured"
+	// IntegrationConditionResourcesConfiguredReason --
+	IntegrationConditionResourcesConfiguredReason string = "ResourcesConfigured"
+	// IntegrationConditionResourcesUnsetReason --
+	IntegrationConditionResourcesUnsetReason string = "ResourcesUnset"
+
+	// IntegrationConditionArchitectureAffinity --
+	IntegrationConditionArchitectureAffinity IntegrationConditionType = "ArchitectureAffinity"
+	// IntegrationConditionArchitectureAffinityReason --
+	IntegrationConditionArchitectureAffinityReason string = "ArchitectureAffinity"
+	// IntegrationConditionMultiArchImageReason --
+	IntegrationConditionMultiArchImageReason string = "MultiArchImage"
+	// IntegrationConditionArchitectureInspectionFailedReason --
+	IntegrationConditionArchitectureInspectionFailedReason string = "ArchitectureInspectionFailed"
+
+	// IntegrationConditionResourcesAdmitted --
+	IntegrationConditionResourcesAdmitted IntegrationConditionType = "ResourcesAdmitted"
+	// IntegrationConditionResourcesAdmittedReason --
+	IntegrationConditionResourcesAdmittedReason string = "ResourcesAdmitted"
+	// IntegrationConditionResourcesNotAdmittedReason --
+	IntegrationConditionResourcesNotAdmittedReason string = "ResourcesNotAdmitted"
+
+	// IntegrationConditionResourcesConflict --
+	IntegrationConditionResourcesConflict IntegrationConditionType = "ResourcesConflict"
+	// IntegrationConditionResourcesConflictReason --
+	IntegrationConditionResourcesConflictReason string = "ResourcesConflict"
 )
 
 // IntegrationCondition describes the state of a resource at a certain point.
@@ -294,4 +353,6 @@ type PodSpec struct {
 	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty" patchStrategy:"merge" patchMergeKey:"topologyKey" protobuf:"bytes,33,opt,name=topologySpreadConstraints"`
 	// PodSecurityContext
 	SecurityContext corev1.PodSecurityContext `json:"securityContext,omitempty" protobuf:"bytes,34,opt,name=securityContext"`
+	// HostAliases
+	HostAliases []corev1.HostAlias `json:"hostAliases,omitempty" patchStrategy:"merge" patchMergeKey:"ip" protobuf:"bytes,23,rep,name=hostAliases"`
 }
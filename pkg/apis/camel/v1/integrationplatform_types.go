@@ -122,6 +122,10 @@ type IntegrationPlatformBuildSpec struct {
 	Registry RegistrySpec `json:"registry,omitempty"`
 	// how much time to wait before time out the build process
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// the maximum number of times a failed build is retried
+	MaxRecoveryAttempts *int32 `json:"maxRecoveryAttempts,omitempty"`
+	// the maximum number of builds that can run concurrently in the platform namespace
+	MaxRunningBuilds *int32 `json:"maxRunningBuilds,omitempty"`
 	// Maven configuration used to build the Camel/Camel-Quarkus applications
 	Maven MavenSpec `json:"maven,omitempty"`
 	// Generic options that can used by each publish strategy
@@ -37,4 +37,34 @@ type AffinityTrait struct {
 	// Defines a set of pods (namely those matching the label selector, relative to the given namespace) that the
 	// integration pod(s) should not be co-located with.
 	PodAntiAffinityLabels []string `property:"pod-anti-affinity-labels" json:"podAntiAffinityLabels,omitempty"`
+	// Never co-locates the integration pod(s) with the pods of any integration, including its own replicas, in the same node (default *false*).
+	IntegrationsAntiAffinity *bool `property:"integrations-anti-affinity" json:"integrationsAntiAffinity,omitempty"`
+	// Whether the anti-affinity against other integrations is enforced (`required`) or only a best effort
+	// of the scheduler (`preferred`) (default `required`).
+	// +kubebuilder:validation:Enum=required;preferred
+	IntegrationsAntiAffinityType string `property:"integrations-anti-affinity-type" json:"integrationsAntiAffinityType,omitempty"`
+	// The scope of the integrations the anti-affinity applies to, either those in the integration
+	// namespace (`namespace`) or those in all the namespaces (`cluster`) (default `namespace`).
+	// +kubebuilder:validation:Enum=namespace;cluster
+	IntegrationsAntiAffinityScope string `property:"integrations-anti-affinity-scope" json:"integrationsAntiAffinityScope,omitempty"`
+	// Constrains the integration pod(s) to the nodes of the architecture the integration image is built for,
+	// using the `kubernetes.io/arch` node label, when the image is single-arch, so that the pods are not scheduled
+	// on nodes they cannot run on. The image architecture is inspected from the image registry, and no constraint is
+	// added for multi-arch images, nor for the images that are not referenced by digest, as their tag may be moved
+	// to an image built for another architecture (default *false*).
+	ArchitectureAffinity *bool `property:"architecture-affinity" json:"architectureAffinity,omitempty"`
+	// The architecture the integration pod(s) are constrained to, e.g., `arm64`, instead of the one inspected
+	// from the integration image.
+	// +kubebuilder:validation:Enum=amd64;arm64;arm;ppc64le;s390x
+	Architecture string `property:"architecture" json:"architecture,omitempty"`
+	// The topology keys the integration pod(s) are spread across, e.g., `topology.kubernetes.io/zone`, so that multiple
+	// replicas are evenly distributed across the topology domains, with a topology spread constraint per key.
+	// Note that Knative Serving requires the `kubernetes.podspec-topologyspreadconstraints` feature to be enabled.
+	TopologySpreadKeys []string `property:"topology-spread-keys" json:"topologySpreadKeys,omitempty"`
+	// The maximum difference of the number of integration pods between any two topology domains (default `1`).
+	TopologySpreadMaxSkew *int32 `property:"topology-spread-max-skew" json:"topologySpreadMaxSkew,omitempty"`
+	// Whether the pods that would increase the skew beyond the maximum are not scheduled (`DoNotSchedule`),
+	// or are scheduled preferring the topology domains that reduce the skew (`ScheduleAnyway`) (default `DoNotSchedule`).
+	// +kubebuilder:validation:Enum=DoNotSchedule;ScheduleAnyway
+	TopologySpreadWhenUnsatisfiable string `property:"topology-spread-when-unsatisfiable" json:"topologySpreadWhenUnsatisfiable,omitempty"`
 }
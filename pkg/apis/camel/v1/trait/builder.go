@@ -27,4 +27,24 @@ type BuilderTrait struct {
 	Verbose *bool `property:"verbose" json:"verbose,omitempty"`
 	// A list of properties to be provided to the build task
 	Properties []string `property:"properties" json:"properties,omitempty"`
+	// A list of Maven profiles to be activated by the build task, both when resolving the dependencies and when
+	// packaging the integration, e.g., to add environment-specific dependencies. The profiles must be defined
+	// in the Maven settings of the integration platform.
+	MavenProfiles []string `property:"maven-profiles" json:"mavenProfiles,omitempty"`
+	// The base image the integration image is built from, e.g., to use a specific patched JDK image (default the
+	// base image of the integration platform). It must be a valid image reference, and provide a Java runtime that's
+	// compatible with the Camel runtime version. It does not apply to native builds.
+	BaseImage string `property:"base-image" json:"baseImage,omitempty"`
+	// The platforms the integration image is built for, in the form `os/arch[/variant]`, e.g., `linux/amd64` and
+	// `linux/arm64`, which produces a multi-arch image manifest list, so that the integration can run on the nodes of
+	// any of these platforms. It's only supported by the `Buildah` publish strategy, and the base image must be
+	// available for all the platforms. Each platform is built in turn, possibly with emulation, so that the build
+	// duration increases with the number of platforms. Multiple platforms aren't supported by the Quarkus `native`
+	// package type, as the native executable is only compiled for the build platform.
+	Platforms []string `property:"platforms" json:"platforms,omitempty"`
+	// The Secret key holding the encrypted Maven master password, in the form `secret-name/key`, e.g., as output by
+	// `mvn --encrypt-master-password`. It's used to generate the `settings-security.xml` file of the build, so that the
+	// server passwords of the Maven settings, that are encrypted with the master password, are decrypted. It can't be set
+	// when the settings security of the integration platform Maven configuration is set.
+	MavenMasterPasswordSecret string `property:"maven-master-password-secret" json:"mavenMasterPasswordSecret,omitempty"`
 }
@@ -26,4 +26,8 @@ type CamelTrait struct {
 	RuntimeVersion string `property:"runtime-version" json:"runtimeVersion,omitempty"`
 	// A list of properties to be provided to the Integration runtime
 	Properties []string `property:"properties" json:"properties,omitempty"`
+	// Rolls out the integration when the content of the ConfigMaps, that the integration sources reference, changes
+	// (default `false`). It enables updating the routes by syncing the sources into these ConfigMaps, e.g., from
+	// a Git repository, and requires each referenced ConfigMap to hold a valid source, under the source content key.
+	ReloadSources *bool `property:"reload-sources" json:"reloadSources,omitempty"`
 }
@@ -39,6 +39,18 @@ type ContainerTrait struct {
 	LimitCPU string `property:"limit-cpu" json:"limitCPU,omitempty"`
 	// The maximum amount of memory required.
 	LimitMemory string `property:"limit-memory" json:"limitMemory,omitempty"`
+	// The size preset of the container resources, either `small`, `medium` or `large`, which sets
+	// the CPU and memory requests and limits to predefined values. The request and limit properties
+	// override the corresponding values of the preset.
+	// +kubebuilder:validation:Enum=small;medium;large
+	Size string `property:"size" json:"size,omitempty"`
+	// Overrides the predefined values of the size presets, e.g., from the integration platform, each one
+	// in the form `<size>:<request-cpu>,<request-memory>,<limit-cpu>,<limit-memory>`, e.g., `small:100m,256Mi,500m,512Mi`.
+	SizePresets []string `property:"size-presets" json:"sizePresets,omitempty"`
+	// Reports the integrations whose container has no CPU or memory request or limit configured, with the
+	// `ResourcesConfigured` condition set to `False`. It doesn't prevent the integration from running, and can be
+	// enabled for all the integrations from the integration platform (default `false`).
+	ResourcesAdvisory *bool `property:"resources-advisory" json:"resourcesAdvisory,omitempty"`
 
 	// Can be used to enable/disable exposure via kubernetes Service.
 	Expose *bool `property:"expose" json:"expose,omitempty"`
@@ -54,6 +66,10 @@ type ContainerTrait struct {
 	Name string `property:"name" json:"name,omitempty"`
 	// The main container image
 	Image string `property:"image" json:"image,omitempty"`
+	// The registry mirror the integration image is pulled from, in the form `host[:port][/path]`, e.g., a pull-through
+	// cache that's configured on the cluster nodes. The registry of the integration image reference is replaced with the
+	// mirror, while preserving its repository, tag and digest, and the image is still pushed to the platform registry.
+	ImageMirror string `property:"image-mirror" json:"imageMirror,omitempty"`
 	// The pull policy: Always|Never|IfNotPresent
 	// +kubebuilder:validation:Enum=Always;Never;IfNotPresent
 	ImagePullPolicy corev1.PullPolicy `property:"image-pull-policy" json:"imagePullPolicy,omitempty"`
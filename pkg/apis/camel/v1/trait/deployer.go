@@ -23,10 +23,43 @@ package trait
 // +camel-k:trait=deployer.
 type DeployerTrait struct {
 	Trait `property:",squash" json:",inline"`
-	// Allows to explicitly select the desired deployment kind between `deployment`, `cron-job` or `knative-service` when creating the resources for running the integration.
-	// +kubebuilder:validation:Enum=deployment;cron-job;knative-service
+	// Allows to explicitly select the desired deployment kind between `deployment`, `cron-job`, `knative-service` or `stateful-set` when creating the resources for running the integration.
+	// +kubebuilder:validation:Enum=deployment;cron-job;knative-service;stateful-set
 	Kind string `property:"kind" json:"kind,omitempty"`
 	// Use server-side apply to update the owned resources (default `true`).
 	// Note that it automatically falls back to client-side patching, if SSA is not available, e.g., on old Kubernetes clusters.
 	UseSSA *bool `property:"use-ssa" json:"useSSA,omitempty"`
+	// Use a three-way merge patch to update the owned resources client-side, i.e., when server-side apply is disabled
+	// or not available (default `false`). The last applied configuration is stored in the `camel.apache.org/last-applied-configuration`
+	// annotation, so that the fields removed from the generated resources are deleted, while the fields set by other actors are preserved.
+	ThreeWayPatch *bool `property:"three-way-patch" json:"threeWayPatch,omitempty"`
+	// Do not overwrite the changes made to the owned resources by other actors, and report them with the `ResourcesConflict`
+	// integration condition instead (default `false`). With server-side apply, the ownership of the fields managed by other
+	// field managers is not forced. Otherwise, it implies the three-way patch, and the fields modified since the last applied
+	// configuration are not patched.
+	FailOnConflict *bool `property:"fail-on-conflict" json:"failOnConflict,omitempty"`
+	// Pauses the reconciliation of the resources owned by the integration, so that the operator neither creates,
+	// patches nor garbage collects them, e.g., to manually intervene during a maintenance window (default `false`).
+	// Note that it does not pause the rollout of the integration pods.
+	Paused *bool `property:"paused" json:"paused,omitempty"`
+	// Pauses the reconciliation of the resources owned by the integration until the given time, expressed
+	// as an RFC 3339 timestamp, e.g., `2023-03-01T06:00:00Z`. The reconciliation automatically resumes afterwards.
+	PausedUntil string `property:"paused-until" json:"pausedUntil,omitempty"`
+	// Verifies the cosign signature of the integration image before deploying the integration (default `false`).
+	// When the verification fails, the integration resources are neither created nor patched, and the integration
+	// reports the `ImageSignatureVerified` condition with the failure reason.
+	// The integration container image is pinned to the digest of the verified image.
+	VerifyImageSignature *bool `property:"verify-image-signature" json:"verifyImageSignature,omitempty"`
+	// The name of the Secret, in the integration namespace, holding the PEM encoded public key the integration image
+	// signature is verified against, under the `cosign.pub` key. It's required to verify the image signature,
+	// as only the key-based verification of the signatures is supported.
+	ImageSignatureKeySecret string `property:"image-signature-key-secret" json:"imageSignatureKeySecret,omitempty"`
+	// The prefix of the names of the resources generated after the integration name, e.g., the Deployment, the Service and
+	// the ConfigMaps, so that they follow a naming convention such as `prefix-<name>`. The references between these resources,
+	// e.g., the Ingress backend Service, are renamed accordingly, while the selectors rely on the integration labels.
+	NamePrefix string `property:"name-prefix" json:"namePrefix,omitempty"`
+	// The suffix of the names of the resources generated after the integration name, e.g., `-prod`.
+	// The resulting names must fit the Kubernetes names length limits, e.g., 63 characters for the Services,
+	// and 52 characters for the CronJobs.
+	NameSuffix string `property:"name-suffix" json:"nameSuffix,omitempty"`
 }
@@ -46,4 +46,25 @@ type DeploymentTrait struct {
 	// Absolute number is calculated from percentage by rounding up.
 	// Defaults to 25%.
 	RollingUpdateMaxSurge *int `property:"rolling-update-max-surge" json:"rollingUpdateMaxSurge,omitempty"`
+	// The path of the runtime graceful shutdown endpoint, invoked with an HTTP GET request on the integration
+	// container port by a pre-stop hook, before the container is sent the SIGTERM signal.
+	// It gives the runtime a chance to commit the consumer offsets, and close the consumers, before exiting.
+	PreStopPath string `property:"pre-stop-path" json:"preStopPath,omitempty"`
+	// The time in seconds granted to the runtime to gracefully shut down, e.g., to complete
+	// the in-flight exchanges, commit the consumer offsets and close the consumers.
+	// It configures the runtime shutdown timeout accordingly.
+	ShutdownTimeout *int64 `property:"shutdown-timeout" json:"shutdownTimeout,omitempty"`
+	// The duration in seconds the integration pods are granted to terminate. It must be greater than
+	// the shutdown timeout, and defaults to the shutdown timeout extended by 10 seconds, when it is set.
+	TerminationGracePeriodSeconds *int64 `property:"termination-grace-period-seconds" json:"terminationGracePeriodSeconds,omitempty"`
+	// Coordinates the rolling updates of message consumer integrations, e.g., JMS consumers, so that
+	// the integration never runs more consumers than its number of replicas: the consumers of an outgoing pod
+	// are paused and drained before an incoming pod is started, and the rollout only proceeds once the incoming pod is ready.
+	// It requires the health trait readiness probe, and can't be combined with a non-zero `rolling-update-max-surge`.
+	// The integration must have a single replica, unless the `Recreate` strategy is used, as the outgoing and incoming pods
+	// otherwise consume concurrently while the rollout replaces them one at a time.
+	ExclusiveConsumers *bool `property:"exclusive-consumers" json:"exclusiveConsumers,omitempty"`
+	// The time in seconds the outgoing pod is granted to complete the exchanges in-flight once its consumers
+	// are paused, when `exclusive-consumers` is enabled. It's the default shutdown timeout, that must not be lower (default `30`).
+	ConsumerPauseSeconds *int64 `property:"consumer-pause-seconds" json:"consumerPauseSeconds,omitempty"`
 }
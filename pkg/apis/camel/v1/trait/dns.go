@@ -0,0 +1,46 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The DNS trait allows configuring the DNS resolution of the integration pods.
+//
+// It can be used to tune the resolver options, e.g., to lower the `ndots` option, whose `5` default value causes
+// extra DNS lookups for every host name that is not fully qualified, and thus adds latency to integrations doing many
+// outbound calls. It can also be used to set a custom resolver configuration, with the `None` DNS policy.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=dns.
+type DNSTrait struct {
+	Trait `property:",squash" json:",inline"`
+	// The `ndots` resolver option, i.e., the number of dots a name must contain to be resolved as an absolute name first.
+	// It must be between `0` and `15`.
+	NDots *int `property:"ndots" json:"ndots,omitempty"`
+	// A list of additional resolver options, in the form `name[:value]`, e.g., `timeout:2`, `attempts:3` or `single-request-reopen`.
+	Options []string `property:"options" json:"options,omitempty"`
+	// The DNS policy of the integration pods, i.e., `ClusterFirst`, `ClusterFirstWithHostNet`, `Default` or `None`.
+	// With the `None` policy, the cluster DNS settings are ignored, and the resolver is entirely configured
+	// with the trait properties, in which case at least one nameserver must be provided.
+	// +kubebuilder:validation:Enum=ClusterFirst;ClusterFirstWithHostNet;Default;None
+	Policy string `property:"policy" json:"policy,omitempty"`
+	// A list of IP addresses of DNS servers, to be used in addition to the ones derived from the DNS policy.
+	// At most `3` nameservers can be provided.
+	Nameservers []string `property:"nameservers" json:"nameservers,omitempty"`
+	// A list of DNS search domains for host name lookups, to be used in addition to the ones derived from the DNS policy.
+	Searches []string `property:"searches" json:"searches,omitempty"`
+}
@@ -0,0 +1,35 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Drain trait exposes an endpoint to drain an integration pod on demand, e.g., for a blue-green cutover, outside
+// of the pod lifecycle events. Draining suspends the Camel context, so that the consumers stop accepting new work,
+// while the in-flight exchanges complete, and the readiness probe fails, so that the pod stops receiving traffic,
+// without being restarted. It can be resumed afterwards.
+//
+// The endpoint is served by the Jolokia agent, that must be enabled with authentication, either with the Jolokia
+// `credentials-secret` option, or with client certificates. The agent is restricted to the operations required
+// to drain the pod, and to monitor its progress. The endpoint is not exposed by the integration Service, so it's
+// only reachable with the pod IP, from within the cluster.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=drain.
+type DrainTrait struct {
+	Trait `property:",squash" json:",inline"`
+}
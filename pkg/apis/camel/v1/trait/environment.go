@@ -29,6 +29,10 @@ type EnvironmentTrait struct {
 	HTTPProxy *bool `property:"http-proxy" json:"httpProxy,omitempty"`
 	// A list of environment variables to be added to the integration container.
 	// The syntax is KEY=VALUE, e.g., `MY_VAR="my value"`.
+	// The value can also be sourced from a ConfigMap or Secret key, with `configmap:name/key` or `secret:name/key`,
+	// from a field of the pod, with `fieldRef:status.podIP`, or from a resource of the container,
+	// with `resourceFieldRef:limits.memory`. The pod fields are the ones supported by the downward API,
+	// and neither can be referenced when the integration is deployed as a Knative service.
 	// These take precedence over the previously defined environment variables.
 	Vars []string `property:"vars" json:"vars,omitempty"`
 }
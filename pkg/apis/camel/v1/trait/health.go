@@ -54,4 +54,32 @@ type HealthTrait struct {
 	ReadinessSuccessThreshold int32 `property:"readiness-success-threshold" json:"readinessSuccessThreshold,omitempty"`
 	// Minimum consecutive failures for the readiness probe to be considered failed after having succeeded.
 	ReadinessFailureThreshold int32 `property:"readiness-failure-threshold" json:"readinessFailureThreshold,omitempty"`
+
+	// Configures the startup probe for the integration container (default `false`).
+	// The liveness and readiness probes are only performed once it has succeeded, e.g., to give slow starting
+	// integrations enough time to start. It's not supported by Knative Serving.
+	StartupProbeEnabled *bool `property:"startup-probe-enabled" json:"startupProbeEnabled,omitempty"`
+	// Scheme to use when connecting to the startup probe (default `HTTP`).
+	StartupScheme string `property:"startup-scheme" json:"startupScheme,omitempty"`
+	// Number of seconds after the container has started before the startup probe is initiated.
+	StartupInitialDelay int32 `property:"startup-initial-delay" json:"startupInitialDelay,omitempty"`
+	// Number of seconds after which the startup probe times out.
+	StartupTimeout int32 `property:"startup-timeout" json:"startupTimeout,omitempty"`
+	// How often to perform the startup probe.
+	StartupPeriod int32 `property:"startup-period" json:"startupPeriod,omitempty"`
+	// Minimum consecutive failures for the startup probe to be considered failed after having succeeded.
+	StartupFailureThreshold int32 `property:"startup-failure-threshold" json:"startupFailureThreshold,omitempty"`
+
+	// The health checks the integration readiness depends on, among `context`, `routes`, `consumers`, `registry`
+	// and `datasource`, e.g., to only mark the integration ready once its database connection succeeds.
+	// The health checks that are not listed are disabled (default all the health checks provided by the runtime).
+	// As the runtime enables or disables a health check for all the probes, the `context` and `registry` health checks
+	// that are not listed are removed from the liveness probe as well, unless they are listed in `liveness-checks`.
+	ReadinessChecks []string `property:"readiness-checks" json:"readinessChecks,omitempty"`
+	// The health checks the integration liveness depends on, among `context` and `registry`, that are the health checks
+	// the runtime reports to the liveness group, as well as to the readiness group. As the integration container is
+	// restarted when its liveness probe fails, it should only depend on the health checks whose failure warrants a restart,
+	// e.g., the Camel context one, rather than on transient downstream outages. The health checks that are not listed
+	// are disabled, so they must not be readiness checks (default all the liveness health checks provided by the runtime).
+	LivenessChecks []string `property:"liveness-checks" json:"livenessChecks,omitempty"`
 }
@@ -0,0 +1,46 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The HPA trait creates a HorizontalPodAutoscaler, that scales the integration Deployment, or StatefulSet, based on the average
+// resources utilization, or custom metrics, of its pods.
+//
+// When the integration runs as a Knative Service, the trait configures the Knative Horizontal Pod Autoscaler class
+// instead, that only supports the CPU target, and must not be combined with the autoscaling options of the
+// Knative Service trait.
+//
+// The integration replicas are managed by the autoscaler, and can't be set together with the trait.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=hpa.
+type HPATrait struct {
+	Trait `property:",squash" json:",inline"`
+	// The lower limit for the number of replicas the integration can be scaled down to (default `1`).
+	MinReplicas *int32 `property:"min-replicas" json:"minReplicas,omitempty"`
+	// The upper limit for the number of replicas the integration can be scaled up to. It's required.
+	MaxReplicas *int32 `property:"max-replicas" json:"maxReplicas,omitempty"`
+	// The target average CPU utilization of the integration pods, as a percentage of the requested CPU
+	// (default `80`, unless another target is set).
+	CPU *int32 `property:"cpu" json:"cpu,omitempty"`
+	// The target average memory utilization of the integration pods, as a percentage of the requested memory.
+	Memory *int32 `property:"memory" json:"memory,omitempty"`
+	// The target average values of custom pods metrics, in the `name=quantity` form, e.g., `http_requests_per_second=100`.
+	// The metrics must be served by a custom metrics API adapter.
+	Metrics []string `property:"metrics" json:"metrics,omitempty"`
+}
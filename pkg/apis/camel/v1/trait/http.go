@@ -0,0 +1,48 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The HTTP trait allows configuring the HTTP server of the integration, that serves the HTTP based consumers,
+// e.g., the `platform-http` endpoints and the REST DSL.
+//
+// It can be used to bound the time and the resources slow clients are granted, and thus protect the integration
+// from exhausting its worker threads and connections, without modifying the routes.
+// It can also enable the access log, while keeping the request headers that hold credentials or personal data out of it.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=http.
+type HTTPTrait struct {
+	Trait `property:",squash" json:",inline"`
+	// The time a connection is kept open when no data is exchanged, after which it's closed.
+	// It must be expressed as a Golang `time.Duration` string representation, rounded to a second precision, e.g., `30s` or `5m`.
+	IdleTimeout string `property:"idle-timeout" json:"idleTimeout,omitempty"`
+	// The time the server waits for the client to send the request data, e.g., the request body, before failing the request.
+	// It must be expressed as a Golang `time.Duration` string representation, rounded to a second precision, e.g., `10s`.
+	ReadTimeout string `property:"read-timeout" json:"readTimeout,omitempty"`
+	// The maximum size of the request headers, beyond which the request is rejected, expressed in bytes,
+	// with an optional `K`, `M` or `G` unit suffix, e.g., `8K`.
+	MaxHeaderSize string `property:"max-header-size" json:"maxHeaderSize,omitempty"`
+	// Enables the HTTP server access log, that logs a line for each request, in the common log format.
+	AccessLog *bool `property:"access-log" json:"accessLog,omitempty"`
+	// The request headers that are logged in the access log, e.g., `User-Agent` or `X-Request-ID`.
+	AccessLogHeaders []string `property:"access-log-headers" json:"accessLogHeaders,omitempty"`
+	// The request headers that must never be logged in the access log, because they hold credentials
+	// or personal data (default `Authorization` and `Cookie`). They must not be in the logged headers.
+	AccessLogRedactedHeaders []string `property:"access-log-redacted-headers" json:"accessLogRedactedHeaders,omitempty"`
+}
@@ -0,0 +1,48 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Infinispan trait configures the embedded Infinispan data grid of the integration, so that its caches are
+// clustered across the integration pods, that discover each other with JGroups.
+//
+// The cache manager is configured with the Infinispan configuration the trait generates, that defines the clustered
+// caches, and the JGroups discovery. The `kube-ping` discovery lists the integration pods with the Kubernetes API,
+// which the integration service account is granted access to, while the `dns-ping` discovery resolves the addresses
+// of the integration pods with the headless Service the trait creates.
+//
+// The caches are only shared by the pods of the same integration, that must be run by a Deployment, and the state
+// is lost once all the pods are stopped.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=infinispan.
+type InfinispanTrait struct {
+	Trait `property:",squash" json:",inline"`
+	// The names of the clustered caches.
+	Caches []string `property:"caches" json:"caches,omitempty"`
+	// The clustering mode of the caches, either `replicated`, so that each pod holds all the entries, or `distributed`,
+	// so that each entry is held by a number of pods, i.e., the `owners` (default `replicated`).
+	// +kubebuilder:validation:Enum=replicated;distributed
+	CacheMode string `property:"cache-mode" json:"cacheMode,omitempty"`
+	// The number of pods that hold each entry, applicable when `cache-mode` is `distributed`. It must not exceed the number
+	// of integration replicas (default `2`).
+	Owners *int `property:"owners" json:"owners,omitempty"`
+	// The JGroups discovery of the integration pods, either `kube-ping` or `dns-ping` (default `kube-ping`).
+	// +kubebuilder:validation:Enum=kube-ping;dns-ping
+	Discovery string `property:"discovery" json:"discovery,omitempty"`
+}
@@ -27,6 +27,18 @@ type IngressTrait struct {
 	Trait `property:",squash" json:",inline"`
 	// To configure the host exposed by the ingress.
 	Host string `property:"host" json:"host,omitempty"`
+	// The paths exposed by the ingress, each with its own rule, e.g., `/api`.
+	// By default, the paths of the `platform-http` consumers are discovered when `auto` is enabled,
+	// or else `/` is exposed.
+	Paths []string `property:"paths" json:"paths,omitempty"`
+	// The name of the IngressClass the ingress is handled by. The default IngressClass of the cluster is used if it's not set.
+	IngressClassName string `property:"ingress-class-name" json:"ingressClassName,omitempty"`
+	// The name of the TLS Secret that holds the certificate and private key used by the ingress to terminate TLS,
+	// for the configured host.
+	TLSSecret string `property:"tls-secret" json:"tlsSecret,omitempty"`
+	// The annotations added to the ingress, in the form `<key>=<value>`, e.g., to configure cert-manager
+	// or the ingress controller.
+	Annotations []string `property:"annotations" json:"annotations,omitempty"`
 	// To automatically add an ingress whenever the integration uses an HTTP endpoint consumer.
 	Auto *bool `property:"auto" json:"auto,omitempty"`
 }
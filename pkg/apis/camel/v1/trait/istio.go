@@ -18,7 +18,7 @@ limitations under the License.
 package trait
 
 // The Istio trait allows configuring properties related to the Istio service mesh,
-// such as sidecar injection and outbound IP ranges.
+// such as sidecar injection, outbound IP ranges and mutual TLS.
 //
 // +camel-k:trait=istio.
 type IstioTrait struct {
@@ -27,4 +27,9 @@ type IstioTrait struct {
 	Allow string `property:"allow" json:"allow,omitempty"`
 	// Forces the value for labels `sidecar.istio.io/inject`. By default the label is set to `true` on deployment and not set on Knative Service.
 	Inject *bool `property:"inject" json:"inject,omitempty"`
+	// Generates a PeerAuthentication, that sets the mutual TLS mode of the integration pods sidecars, and a DestinationRule,
+	// that sets the TLS mode of the traffic to the integration Service accordingly, if the integration is exposed by a Service.
+	// The `STRICT` mode only accepts mutual TLS traffic, so that the integration can participate in a locked-down mesh.
+	// +kubebuilder:validation:Enum=STRICT;PERMISSIVE;DISABLE
+	MTLSMode string `property:"mtls-mode" json:"mtlsMode,omitempty"`
 }
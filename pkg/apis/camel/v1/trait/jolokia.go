@@ -33,6 +33,13 @@ type JolokiaTrait struct {
 	// applicable when `protocol` is `https` and `use-ssl-client-authentication` is `true`
 	// (default `clientPrincipal=cn=system:master-proxy`, `cn=hawtio-online.hawtio.svc` and `cn=fuse-console.fuse.svc` for OpenShift).
 	ClientPrincipal []string `property:"client-principal" json:"clientPrincipal,omitempty"`
+	// Exposes the Jolokia endpoint with a dedicated cluster-local Service, named `<integration-name>-jolokia`,
+	// rather than with the Service of the integration (default `false`).
+	ClusterLocalService *bool `property:"cluster-local-service" json:"clusterLocalService,omitempty"`
+	// The name of the Secret, in the integration namespace, holding the `username` and `password` keys
+	// used for the basic authentication of the Jolokia endpoint. It can't be set together with the
+	// `user` and `password` options.
+	CredentialsSecret string `property:"credentials-secret" json:"credentialsSecret,omitempty"`
 	// Listen for multicast requests (default `false`)
 	DiscoveryEnabled *bool `property:"discovery-enabled" json:"discoveryEnabled,omitempty"`
 	// Mandate the client certificate contains a client flag in the extended key usage section,
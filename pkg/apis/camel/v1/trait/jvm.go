@@ -22,7 +22,9 @@ package trait
 // +camel-k:trait=jvm.
 type JVMTrait struct {
 	Trait `property:",squash" json:",inline"`
-	// Activates remote debugging, so that a debugger can be attached to the JVM, e.g., using port-forwarding
+	// Activates remote debugging, so that a debugger can be attached to the JVM, e.g., using port-forwarding.
+	// The debug port is named `jdwp` on the integration container, unless it's deployed as a Knative service.
+	// It's only exposed with the Service when it's selected with the service trait `ports` option.
 	Debug *bool `property:"debug" json:"debug,omitempty"`
 	// Suspends the target JVM immediately before the main class is loaded
 	DebugSuspend *bool `property:"debug-suspend" json:"debugSuspend,omitempty"`
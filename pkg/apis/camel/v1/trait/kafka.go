@@ -0,0 +1,42 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Kafka trait allows configuring the consumer group of the integration Kafka consumers, so that it's unique
+// and deterministic, avoiding the integrations that share the same name across environments, e.g., namespaces,
+// to accidentally join the same consumer group.
+//
+// The consumer group is derived from the integration namespace and name, i.e., `<namespace>.<name>[.<suffix>]`, and
+// set as the Kafka component `group-id` property. It can be overridden with the `group-id` option, or by configuring
+// the `camel.component.kafka.group-id` property of the integration.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=kafka.
+type KafkaTrait struct {
+	Trait `property:",squash" json:",inline"`
+	// The consumer group, overriding the one derived from the integration namespace and name.
+	GroupID string `property:"group-id" json:"groupId,omitempty"`
+	// A suffix appended to the consumer group derived from the integration namespace and name, e.g., to distinguish
+	// the consumers of several integration versions.
+	GroupIDSuffix string `property:"group-id-suffix" json:"groupIdSuffix,omitempty"`
+	// Derives the consumer group from the integration pod name, rather than the integration name, so that each
+	// pod consumes all the records, rather than a share of them. The pods don't resume from their committed offsets
+	// when they're re-created, as their name changes. It's only supported by the Deployment and CronJob strategies.
+	PerPod *bool `property:"per-pod" json:"perPod,omitempty"`
+}
@@ -43,10 +43,15 @@ type KnativeTrait struct {
 	EndpointSinks []string `property:"endpoint-sinks" json:"endpointSinks,omitempty"`
 	// List of event types that the integration will be subscribed to.
 	// Can contain simple event types or full Camel URIs (to use a specific broker different from "default").
+	// The events can be filtered on their CloudEvent attributes, with the `filter.<attribute>` parameters
+	// of the event URIs, e.g., `knative:event/my.type?filter.source=my-source`, that are set on the generated Triggers.
 	EventSources []string `property:"event-sources" json:"eventSources,omitempty"`
 	// List of event types that the integration will produce.
 	// Can contain simple event types or full Camel URIs (to use a specific broker).
 	EventSinks []string `property:"event-sinks" json:"eventSinks,omitempty"`
+	// The name of the Broker the events are consumed from, and produced to, when the event URIs don't set it
+	// with the `name` parameter (default `default`).
+	Broker string `property:"broker" json:"broker,omitempty"`
 	// Enables filtering on events based on the header "ce-knativehistory". Since this header has been removed in newer versions of
 	// Knative, filtering is disabled by default.
 	FilterSourceChannels *bool `property:"filter-source-channels" json:"filterSourceChannels,omitempty"`
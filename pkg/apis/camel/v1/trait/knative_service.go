@@ -59,6 +59,19 @@ type KnativeServiceTrait struct {
 	// Refer to the Knative documentation for more information.
 	// +kubebuilder:validation:Enum=cluster-local
 	Visibility string `property:"visibility" json:"visibility,omitempty"`
+	// The maximum duration in seconds that the request routing layer waits for a request delivered to the integration
+	// to begin replying. Knative also uses it as the termination grace period of the integration pods.
+	//
+	// Refer to the Knative documentation for more information.
+	TimeoutSeconds *int64 `property:"timeout-seconds" json:"timeoutSeconds,omitempty"`
+	// The time in seconds the integration is granted to complete in-flight requests when its pods are terminated,
+	// e.g., when the service is scaled down to zero. It configures the runtime graceful shutdown accordingly,
+	// and must be lower than the termination grace period, i.e., `timeout-seconds` (default `300`).
+	DrainTimeout *int64 `property:"drain-timeout" json:"drainTimeout,omitempty"`
+	// The path of the runtime graceful shutdown endpoint, invoked with an HTTP GET request on the integration
+	// container port by a pre-stop hook, before the container is sent the SIGTERM signal.
+	// Note that the container lifecycle hooks must be allowed by the Knative Serving admission webhook.
+	PreStopPath string `property:"pre-stop-path" json:"preStopPath,omitempty"`
 	// Automatically deploy the integration as Knative service when all conditions hold:
 	//
 	// * Integration is using the Knative profile
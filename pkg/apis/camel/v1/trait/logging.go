@@ -30,6 +30,9 @@ type LoggingTrait struct {
 	// Adjust the logging level (defaults to INFO)
 	// +kubebuilder:validation:Enum=FATAL;WARN;INFO;DEBUG;TRACE
 	Level string `property:"level" json:"level,omitempty"`
+	// The logging levels of the categories, in the form `<category>=<level>`, e.g., `org.apache.camel.component.kafka=DEBUG`,
+	// where the level is one of `OFF`, `FATAL`, `ERROR`, `WARN`, `INFO`, `DEBUG`, `TRACE` or `ALL`
+	Categories []string `property:"categories" json:"categories,omitempty"`
 	// Output the logs in JSON
 	JSON *bool `property:"json" json:"json,omitempty"`
 	// Enable "pretty printing" of the JSON logs
@@ -33,6 +33,13 @@ type MountTrait struct {
 	// The destination path can be either a default location or any path specified by the user.
 	// Syntax: [configmap|secret]:name[/key][@path], where name represents the resource name, key optionally represents the resource key to be filtered and path represents the destination path
 	Resources []string `property:"resources" json:"resources,omitempty"`
+	// Rolls out the integration when the content of the ConfigMaps and Secrets of the configs and resources changes
+	// (default `false`), so that the integration doesn't keep running with a stale configuration.
+	HotReload *bool `property:"hot-reload" json:"hotReload,omitempty"`
 	// A list of Persistent Volume Claims to be mounted. Syntax: [pvcname:/container/path]
 	Volumes []string `property:"volumes" json:"volumes,omitempty"`
+	// The size of the shared memory, i.e., the memory backed volume that's mounted at `/dev/shm`, e.g., `1Gi`, for the
+	// components that memory-map files into shared memory, as the container runtime default is 64MB. The shared memory
+	// usage counts against the container memory limit, so the size must be lower than the limit.
+	SharedMemorySize string `property:"shared-memory-size" json:"sharedMemorySize,omitempty"`
 }
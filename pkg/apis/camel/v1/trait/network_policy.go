@@ -0,0 +1,42 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The NetworkPolicy trait generates a NetworkPolicy that denies by default the ingress traffic to the integration pods,
+// and only allows the traffic that's required by the integration routes, e.g., to the HTTP port when the integration
+// exposes HTTP endpoints, to the container ports declared by the other traits, e.g., the Jolokia or Prometheus traits,
+// and to the Knative queue-proxy when the integration runs as a Knative Service.
+//
+// The traffic from the operator namespace, and from the configured namespaces and pods, is allowed as well.
+//
+// The egress traffic of the integration pods isn't restricted.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=network-policy.
+type NetworkPolicyTrait struct {
+	Trait `property:",squash" json:",inline"`
+	// To automatically allow the ingress traffic to the HTTP port, from any source, when the integration
+	// exposes HTTP endpoints, e.g., with the `platform-http` or `undertow` consumers or the REST DSL (default `true`).
+	Auto *bool `property:"auto" json:"auto,omitempty"`
+	// The namespaces from which all the ingress traffic to the integration pods is allowed.
+	AllowedNamespaces []string `property:"allowed-namespaces" json:"allowedNamespaces,omitempty"`
+	// The label selectors of the pods, in the integration namespace, from which all the ingress traffic
+	// to the integration pods is allowed, e.g., `app=frontend`.
+	AllowedPodSelectors []string `property:"allowed-pod-selectors" json:"allowedPodSelectors,omitempty"`
+}
@@ -23,8 +23,21 @@ package trait
 // +camel-k:trait=owner.
 type OwnerTrait struct {
 	Trait `property:",squash" json:",inline"`
-	// The set of annotations to be transferred
+	// The set of annotations to be transferred. The keys can be glob patterns, e.g., `example.com/*`,
+	// where `*` doesn't match the `/` prefix separator.
 	TargetAnnotations []string `property:"target-annotations" json:"targetAnnotations,omitempty"`
-	// The set of labels to be transferred
+	// The set of labels to be transferred. The keys can be glob patterns, e.g., `cost-center-*`,
+	// where `*` doesn't match the `/` prefix separator.
 	TargetLabels []string `property:"target-labels" json:"targetLabels,omitempty"`
+	// Sets the Kubernetes recommended `app.kubernetes.io` labels on all the resources created for the integration (default `false`).
+	// Their values default to the integration labels with the same keys, or are derived from the integration name.
+	// The labels that are already set on the resources are not overridden.
+	RecommendedLabels *bool `property:"recommended-labels" json:"recommendedLabels,omitempty"`
+	// The value of the `app.kubernetes.io/part-of` recommended label (default the integration name).
+	PartOf string `property:"part-of" json:"partOf,omitempty"`
+	// The value of the `app.kubernetes.io/component` recommended label (default `integration`).
+	Component string `property:"component" json:"component,omitempty"`
+	// The value of the `app.kubernetes.io/version` recommended label, that is only set when it's configured,
+	// or when the integration has this label.
+	Version string `property:"version" json:"version,omitempty"`
 }
@@ -24,7 +24,30 @@ package trait
 // This can be used to customize the container where Camel routes execute,
 // by using the `integration` container name.
 //
+// The template supports the volumes, init containers, containers, e.g., sidecars, ephemeral containers, restart policy,
+// termination grace period, active deadline, DNS policy, node selector, topology spread constraints, security context
+// and host aliases of the Pods.
+//
+// It also sets the priority class and the scheduler of the Pods, on all the controller strategies.
+// Note that Knative Serving requires the `kubernetes.podspec-priorityclassname` and `kubernetes.podspec-schedulername`
+// features to be enabled for these fields.
+//
 // +camel-k:trait=pod.
 type PodTrait struct {
 	Trait `property:",squash" json:",inline"`
+	// The names of the sidecar containers, declared in the Integration `.spec.podTemplate` field, that must be
+	// started and ready before the integration container is started, e.g., a proxy the integration connects through.
+	//
+	// The sidecar containers are started before the integration container, in the given order, and each is gated
+	// by a post-start hook, that waits for its readiness probe to succeed. An exec readiness probe is polled by a `/bin/sh`
+	// script, so the sidecar image must provide a shell, while an HTTP readiness probe is performed once by the kubelet,
+	// that restarts the sidecar container until it succeeds. The TCP and gRPC readiness probes aren't supported.
+	// A sidecar container that declares its own post-start hook, e.g., `pilot-agent wait`, is gated by that hook instead.
+	// Note that the post-start hooks gate the startup on all the clusters, including those supporting native sidecar containers.
+	SidecarContainers []string `property:"sidecar-containers" json:"sidecarContainers,omitempty"`
+	// The name of the `PriorityClass` of the Pods, so that business-critical integrations are scheduled ahead of,
+	// and not preempted by, lower priority workloads.
+	PriorityClassName string `property:"priority-class-name" json:"priorityClassName,omitempty"`
+	// The name of the scheduler the Pods are dispatched by, instead of the default scheduler.
+	SchedulerName string `property:"scheduler-name" json:"schedulerName,omitempty"`
 }
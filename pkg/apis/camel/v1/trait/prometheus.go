@@ -18,12 +18,12 @@ limitations under the License.
 package trait
 
 // The Prometheus trait configures a Prometheus-compatible endpoint. It also creates a `PodMonitor` resource,
-// so that the endpoint can be scraped automatically, when using the Prometheus operator.
+// or a `ServiceMonitor` resource, so that the endpoint can be scraped automatically, when using the Prometheus operator.
 //
 // The metrics are exposed using MicroProfile Metrics.
 //
-// WARNING: The creation of the `PodMonitor` resource requires the https://github.com/coreos/prometheus-operator[Prometheus Operator]
-// custom resource definition to be installed.
+// WARNING: The creation of the `PodMonitor` and `ServiceMonitor` resources requires the
+// https://github.com/coreos/prometheus-operator[Prometheus Operator] custom resource definitions to be installed.
 // You can set `pod-monitor` to `false` for the Prometheus trait to work without the Prometheus Operator.
 //
 // The Prometheus trait is disabled by default.
@@ -31,8 +31,20 @@ package trait
 // +camel-k:trait=prometheus.
 type PrometheusTrait struct {
 	Trait `property:",squash" json:",inline"`
-	// Whether a `PodMonitor` resource is created (default `true`).
+	// Whether a `PodMonitor` resource is created (default `true`, unless `service-monitor` is `true`). It selects the integration pods directly,
+	// so that the integrations that are not exposed by a `Service`, like headless ones, are scraped as well.
+	// The trait fails when the `PodMonitor` custom resource definition is not installed.
 	PodMonitor *bool `property:"pod-monitor" json:"podMonitor,omitempty"`
 	// The `PodMonitor` resource labels, applicable when `pod-monitor` is `true`.
 	PodMonitorLabels []string `property:"pod-monitor-labels" json:"podMonitorLabels,omitempty"`
+	// Whether a `ServiceMonitor` resource is created (default `false`). It selects the integration Service, that must
+	// expose the integration container port, e.g., with the service trait, and can't be enabled together with `pod-monitor`,
+	// as the integration pods would be scraped twice.
+	// The trait fails when the `ServiceMonitor` custom resource definition is not installed.
+	ServiceMonitor *bool `property:"service-monitor" json:"serviceMonitor,omitempty"`
+	// The `ServiceMonitor` resource labels, applicable when `service-monitor` is `true`.
+	ServiceMonitorLabels []string `property:"service-monitor-labels" json:"serviceMonitorLabels,omitempty"`
+	// The interval at which the endpoint is scraped, e.g., `30s`, applicable to the `PodMonitor` and `ServiceMonitor`
+	// resources (default to the Prometheus scrape interval).
+	Interval string `property:"interval" json:"interval,omitempty"`
 }
@@ -37,4 +37,9 @@ type PullSecretTrait struct {
 	ImagePullerDelegation *bool `property:"image-puller-delegation" json:"imagePullerDelegation,omitempty"`
 	// Automatically configures the platform registry secret on the pod if it is of type `kubernetes.io/dockerconfigjson`.
 	Auto *bool `property:"auto" json:"auto,omitempty"`
+	// Adds the pull secret to the image pull secrets of the integration service account (default `false`),
+	// so that the pods that don't inherit the pod template, e.g., created by other tools, can also pull the image.
+	// The service account is shared, so that the pull secret isn't removed from it when the trait is disabled,
+	// or the integration deleted. The pod template image pull secrets should be preferred when possible.
+	LinkServiceAccount *bool `property:"link-service-account" json:"linkServiceAccount,omitempty"`
 }
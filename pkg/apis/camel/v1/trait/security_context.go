@@ -0,0 +1,46 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Security Context trait configures the security context of the integration containers, e.g., to run the
+// integration in namespaces that enforce the `restricted` Pod Security Standard.
+//
+// Its defaults comply with the `restricted` policy: the containers must run as non-root users,
+// can't escalate their privileges, drop all the capabilities, and use the container runtime default seccomp profile.
+// The fields that are already set on the containers security context, e.g., with the `pod` trait, are kept unchanged.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=security-context.
+type SecurityContextTrait struct {
+	Trait `property:",squash" json:",inline"`
+	// Requires the containers to run as non-root users (default `true`).
+	RunAsNonRoot *bool `property:"run-as-non-root" json:"runAsNonRoot,omitempty"`
+	// The UID the containers processes run as. The image default user is used if it's not set, except for the integration container,
+	// that runs as the UID of the user of the image built by the operator when the processes must run as non-root, and not on OpenShift.
+	RunAsUser *int64 `property:"run-as-user" json:"runAsUser,omitempty"`
+	// Mounts the containers root filesystem as read-only (default `false`).
+	ReadOnlyRootFilesystem *bool `property:"read-only-root-filesystem" json:"readOnlyRootFilesystem,omitempty"`
+	// Allows the containers processes to gain more privileges than their parent process (default `false`).
+	AllowPrivilegeEscalation *bool `property:"allow-privilege-escalation" json:"allowPrivilegeEscalation,omitempty"`
+	// The capabilities dropped from the containers (default `ALL`).
+	DropCapabilities []string `property:"drop-capabilities" json:"dropCapabilities,omitempty"`
+	// The type of the seccomp profile of the containers (default `RuntimeDefault`).
+	// +kubebuilder:validation:Enum=RuntimeDefault;Unconfined
+	SeccompProfileType string `property:"seccomp-profile-type" json:"seccompProfileType,omitempty"`
+}
@@ -20,7 +20,9 @@ package trait
 // The Service trait exposes the integration with a Service resource so that it can be accessed by other applications
 // (or integrations) in the same namespace.
 //
-// It's enabled by default if the integration depends on a Camel component that can expose a HTTP endpoint.
+// It's enabled by default if the integration depends on a Camel component that can expose a HTTP endpoint,
+// or if its consumers listen on other ports, e.g., the `netty` or `grpc` consumers. In the latter case, only these
+// ports are exposed, unless the integration also exposes a HTTP endpoint.
 //
 // +camel-k:trait=service.
 type ServiceTrait struct {
@@ -33,6 +35,26 @@ type ServiceTrait struct {
 	// The type of service to be used, either 'ClusterIP', 'NodePort' or 'LoadBalancer'.
 	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
 	Type *ServiceType `property:"type" json:"type,omitempty"`
+	// Exposes all the named ports of the integration container, but the JVM debug port, with the Service (default `false`).
+	AllPorts *bool `property:"all-ports" json:"allPorts,omitempty"`
+	// The named ports of the integration container to expose with the Service, each one either
+	// as `<container-port-name>` or as `<service-port-name>:<container-port-name>`.
+	Ports []string `property:"ports" json:"ports,omitempty"`
+	// Generates an additional Service, named `<integration-name>-<service-port-name>`, for each of the ports selected
+	// with the `ports` option, or for each of the named ports of the integration container otherwise (default `false`).
+	// It's useful for tools that resolve each port through a distinct DNS name.
+	PortServices *bool `property:"port-services" json:"portServices,omitempty"`
+	// The name of an existing Service, that's provisioned out of band, e.g., with a specific load balancer IP, to expose
+	// the integration with, instead of creating one. The operator only updates its selector and ports, and neither owns
+	// nor deletes it, so that it's retained when the integration is deleted. The Service must exist in the integration namespace.
+	ExistingService string `property:"existing-service" json:"existingService,omitempty"`
+	// Exposes the ports the integration consumers listen on, other than the HTTP port, with the Service, as detected
+	// from the routes, e.g., the ports of the `netty` or `grpc` consumers (default `true`, unless `auto` is `false`).
+	// The ports are named `<scheme>-<port>`, or `<scheme>-udp-<port>` for the UDP ones.
+	ConsumerPorts *bool `property:"consumer-ports" json:"consumerPorts,omitempty"`
+	// The annotations added to the Service, in the form `<key>=<value>`, e.g., to configure the load balancer that's
+	// provisioned by the cloud provider for the `LoadBalancer` type.
+	Annotations []string `property:"annotations" json:"annotations,omitempty"`
 }
 
 type ServiceType string
@@ -0,0 +1,35 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Service CA trait configures the integration to trust the OpenShift cluster CA, so that internal services
+// exposed over TLS, with certificates issued by the OpenShift service CA operator, can be called securely.
+//
+// It mounts the ConfigMap, that's populated with the service CA bundle, and generates a JVM truststore,
+// out of the JVM default CA certificates and the service CA bundle, that's set as the integration truststore.
+//
+// The trait is a no-op when the integration does not run on OpenShift. It's disabled by default.
+//
+// +camel-k:trait=service-ca.
+type ServiceCATrait struct {
+	Trait `property:",squash" json:",inline"`
+	// The name of the ConfigMap that holds the service CA bundle (default `openshift-service-ca.crt`).
+	ConfigMap string `property:"config-map" json:"configMap,omitempty"`
+	// The key of the service CA bundle in the ConfigMap (default `service-ca.crt`).
+	Key string `property:"key" json:"key,omitempty"`
+}
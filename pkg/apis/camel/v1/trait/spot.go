@@ -0,0 +1,51 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Spot trait allows running the integration pods on spot, or preemptible, nodes, and draining them gracefully
+// within the preemption notice period, before the nodes are reclaimed.
+//
+// It's meant to be combined with a node termination handler, e.g., the AWS Node Termination Handler, that cordons and
+// drains the node as soon as the preemption notice is received. The readiness of the integration pods is gated on
+// the preemption of their node, so that they're removed from the Service endpoints as soon as the node is cordoned or
+// tainted, and the evicted pods are shut down gracefully, so that the in-flight exchanges are completed within the notice period.
+// The graceful shutdown options of the deployment trait, when they're set, must fit in the notice period.
+//
+// It only applies to the integrations deployed as Deployments, and it's disabled by default.
+//
+// +camel-k:trait=spot.
+type SpotTrait struct {
+	Trait `property:",squash" json:",inline"`
+	// The taints of the spot nodes to tolerate, in the form `Key[=Value]:Effect[:Seconds]`
+	// (default the GKE and AKS spot node taints).
+	Taints []string `property:"taints" json:"taints,omitempty"`
+	// The duration in seconds of the preemption notice, i.e., the time between the preemption signal and the node
+	// reclamation. It defaults to `30`, the notice period of the GKE and AKS spot nodes, while it's `120` for the AWS spot instances.
+	NoticePeriod *int64 `property:"notice-period" json:"noticePeriod,omitempty"`
+	// The time in seconds granted to the runtime to complete the in-flight exchanges, once the pod is evicted.
+	// Along with the `5` seconds granted for the pod to be removed from the Service endpoints, it must fit
+	// in the notice period (default `20`).
+	DrainTimeout *int64 `property:"drain-timeout" json:"drainTimeout,omitempty"`
+	// Whether the readiness of the integration pods is gated on the preemption of their node. The operator sets the
+	// readiness gate condition of the pods to false, once their node is cordoned, or tainted with one of the preemption
+	// taints. It's not enforced when the operator isn't granted the permission to get the nodes (default `true`).
+	ReadinessGate *bool `property:"readiness-gate" json:"readinessGate,omitempty"`
+	// The keys of the taints set on the nodes by the node termination handler, when the preemption notice is received
+	// (default the AWS Node Termination Handler and the GKE node termination taints).
+	PreemptionTaints []string `property:"preemption-taints" json:"preemptionTaints,omitempty"`
+}
@@ -0,0 +1,39 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// The StatefulSet trait is responsible for generating the Kubernetes StatefulSet, and its governing headless Service,
+// that run the integration when it requires a stable network identity, or persistent storage per replica.
+//
+// The StatefulSet is used when the trait is enabled, or when the `stateful-set` kind is selected with the deployer trait.
+//
+// +camel-k:trait=stateful-set.
+type StatefulSetTrait struct {
+	Trait `property:",squash" json:",inline"`
+	// The policy used to create and delete the pods, either one at a time and in order (`OrderedReady`, default),
+	// or all at once (`Parallel`).
+	// +kubebuilder:validation:Enum=OrderedReady;Parallel
+	PodManagementPolicy appsv1.PodManagementPolicyType `property:"pod-management-policy" json:"podManagementPolicy,omitempty"`
+	// The persistent volume claims created for each replica, and mounted into the integration container,
+	// with the syntax `name:size:/container/path[:storage-class]`, e.g., `data:1Gi:/var/data`.
+	VolumeClaims []string `property:"volume-claims" json:"volumeClaims,omitempty"`
+}
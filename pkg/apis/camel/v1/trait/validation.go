@@ -0,0 +1,39 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Validation trait checks the integration before it's built, so that errors are reported early,
+// instead of surfacing once the integration kit is built and the integration is running.
+//
+// The integration sources are checked to be present and non-empty. The sources, in languages that can be cheaply
+// parsed, i.e., the YAML and XML DSLs, are also checked to be structurally valid, and parse errors reference
+// the line where they occur.
+//
+// The resources generated by the traits can also be validated by the API server in dry-run mode, so that they're
+// checked against the admission controllers, e.g., validating webhooks or policy engines, before the integration is
+// built. As the integration image is not built yet, the resources are generated with a placeholder image.
+// The outcome is reported with the `ResourcesAdmitted` integration condition.
+//
+// +camel-k:trait=validation.
+type ValidationTrait struct {
+	Trait `property:",squash" json:",inline"`
+	// Checks the integration sources before building the integration (default `true`).
+	Sources *bool `property:"sources" json:"sources,omitempty"`
+	// Validates the resources generated by the traits with the API server in dry-run mode before building the integration (default `false`).
+	DryRun *bool `property:"dry-run" json:"dryRun,omitempty"`
+}
@@ -36,6 +36,26 @@ func (in *AffinityTrait) DeepCopyInto(out *AffinityTrait) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.IntegrationsAntiAffinity != nil {
+		in, out := &in.IntegrationsAntiAffinity, &out.IntegrationsAntiAffinity
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ArchitectureAffinity != nil {
+		in, out := &in.ArchitectureAffinity, &out.ArchitectureAffinity
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TopologySpreadKeys != nil {
+		in, out := &in.TopologySpreadKeys, &out.TopologySpreadKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TopologySpreadMaxSkew != nil {
+		in, out := &in.TopologySpreadMaxSkew, &out.TopologySpreadMaxSkew
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AffinityTrait.
@@ -62,6 +82,16 @@ func (in *BuilderTrait) DeepCopyInto(out *BuilderTrait) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MavenProfiles != nil {
+		in, out := &in.MavenProfiles, &out.MavenProfiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Platforms != nil {
+		in, out := &in.Platforms, &out.Platforms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderTrait.
@@ -83,6 +113,11 @@ func (in *CamelTrait) DeepCopyInto(out *CamelTrait) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ReloadSources != nil {
+		in, out := &in.ReloadSources, &out.ReloadSources
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelTrait.
@@ -124,6 +159,16 @@ func (in *ContainerTrait) DeepCopyInto(out *ContainerTrait) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SizePresets != nil {
+		in, out := &in.SizePresets, &out.SizePresets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourcesAdvisory != nil {
+		in, out := &in.ResourcesAdvisory, &out.ResourcesAdvisory
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Expose != nil {
 		in, out := &in.Expose, &out.Expose
 		*out = new(bool)
@@ -182,6 +227,42 @@ func (in *CronTrait) DeepCopy() *CronTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSTrait) DeepCopyInto(out *DNSTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.NDots != nil {
+		in, out := &in.NDots, &out.NDots
+		*out = new(int)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Searches != nil {
+		in, out := &in.Searches, &out.Searches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSTrait.
+func (in *DNSTrait) DeepCopy() *DNSTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DependenciesTrait) DeepCopyInto(out *DependenciesTrait) {
 	*out = *in
@@ -207,6 +288,26 @@ func (in *DeployerTrait) DeepCopyInto(out *DeployerTrait) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ThreeWayPatch != nil {
+		in, out := &in.ThreeWayPatch, &out.ThreeWayPatch
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FailOnConflict != nil {
+		in, out := &in.FailOnConflict, &out.FailOnConflict
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Paused != nil {
+		in, out := &in.Paused, &out.Paused
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VerifyImageSignature != nil {
+		in, out := &in.VerifyImageSignature, &out.VerifyImageSignature
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployerTrait.
@@ -238,6 +339,26 @@ func (in *DeploymentTrait) DeepCopyInto(out *DeploymentTrait) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.ShutdownTimeout != nil {
+		in, out := &in.ShutdownTimeout, &out.ShutdownTimeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ExclusiveConsumers != nil {
+		in, out := &in.ExclusiveConsumers, &out.ExclusiveConsumers
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConsumerPauseSeconds != nil {
+		in, out := &in.ConsumerPauseSeconds, &out.ConsumerPauseSeconds
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentTrait.
@@ -250,6 +371,22 @@ func (in *DeploymentTrait) DeepCopy() *DeploymentTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainTrait) DeepCopyInto(out *DrainTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainTrait.
+func (in *DrainTrait) DeepCopy() *DrainTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvironmentTrait) DeepCopyInto(out *EnvironmentTrait) {
 	*out = *in
@@ -318,6 +455,78 @@ func (in *GCTrait) DeepCopy() *GCTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HPATrait) DeepCopyInto(out *HPATrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPATrait.
+func (in *HPATrait) DeepCopy() *HPATrait {
+	if in == nil {
+		return nil
+	}
+	out := new(HPATrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPTrait) DeepCopyInto(out *HTTPTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AccessLogHeaders != nil {
+		in, out := &in.AccessLogHeaders, &out.AccessLogHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessLogRedactedHeaders != nil {
+		in, out := &in.AccessLogRedactedHeaders, &out.AccessLogRedactedHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTrait.
+func (in *HTTPTrait) DeepCopy() *HTTPTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthTrait) DeepCopyInto(out *HealthTrait) {
 	*out = *in
@@ -332,6 +541,21 @@ func (in *HealthTrait) DeepCopyInto(out *HealthTrait) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.StartupProbeEnabled != nil {
+		in, out := &in.StartupProbeEnabled, &out.StartupProbeEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReadinessChecks != nil {
+		in, out := &in.ReadinessChecks, &out.ReadinessChecks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LivenessChecks != nil {
+		in, out := &in.LivenessChecks, &out.LivenessChecks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthTrait.
@@ -344,10 +568,46 @@ func (in *HealthTrait) DeepCopy() *HealthTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfinispanTrait) DeepCopyInto(out *InfinispanTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.Caches != nil {
+		in, out := &in.Caches, &out.Caches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Owners != nil {
+		in, out := &in.Owners, &out.Owners
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfinispanTrait.
+func (in *InfinispanTrait) DeepCopy() *InfinispanTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(InfinispanTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressTrait) DeepCopyInto(out *IngressTrait) {
 	*out = *in
 	in.Trait.DeepCopyInto(&out.Trait)
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Auto != nil {
 		in, out := &in.Auto, &out.Auto
 		*out = new(bool)
@@ -436,6 +696,11 @@ func (in *JolokiaTrait) DeepCopyInto(out *JolokiaTrait) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ClusterLocalService != nil {
+		in, out := &in.ClusterLocalService, &out.ClusterLocalService
+		*out = new(bool)
+		**out = **in
+	}
 	if in.DiscoveryEnabled != nil {
 		in, out := &in.DiscoveryEnabled, &out.DiscoveryEnabled
 		*out = new(bool)
@@ -488,6 +753,27 @@ func (in *JolokiaTrait) DeepCopy() *JolokiaTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTrait) DeepCopyInto(out *KafkaTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.PerPod != nil {
+		in, out := &in.PerPod, &out.PerPod
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTrait.
+func (in *KafkaTrait) DeepCopy() *KafkaTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KameletsTrait) DeepCopyInto(out *KameletsTrait) {
 	*out = *in
@@ -528,6 +814,16 @@ func (in *KnativeServiceTrait) DeepCopyInto(out *KnativeServiceTrait) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(int64)
+		**out = **in
+	}
 	if in.Auto != nil {
 		in, out := &in.Auto, &out.Auto
 		*out = new(bool)
@@ -615,6 +911,11 @@ func (in *LoggingTrait) DeepCopyInto(out *LoggingTrait) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.JSON != nil {
 		in, out := &in.JSON, &out.JSON
 		*out = new(bool)
@@ -651,6 +952,11 @@ func (in *MountTrait) DeepCopyInto(out *MountTrait) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.HotReload != nil {
+		in, out := &in.HotReload, &out.HotReload
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]string, len(*in))
@@ -668,6 +974,37 @@ func (in *MountTrait) DeepCopy() *MountTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyTrait) DeepCopyInto(out *NetworkPolicyTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.Auto != nil {
+		in, out := &in.Auto, &out.Auto
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedPodSelectors != nil {
+		in, out := &in.AllowedPodSelectors, &out.AllowedPodSelectors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyTrait.
+func (in *NetworkPolicyTrait) DeepCopy() *NetworkPolicyTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenAPITrait) DeepCopyInto(out *OpenAPITrait) {
 	*out = *in
@@ -703,6 +1040,11 @@ func (in *OwnerTrait) DeepCopyInto(out *OwnerTrait) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RecommendedLabels != nil {
+		in, out := &in.RecommendedLabels, &out.RecommendedLabels
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OwnerTrait.
@@ -766,6 +1108,11 @@ func (in *PlatformTrait) DeepCopy() *PlatformTrait {
 func (in *PodTrait) DeepCopyInto(out *PodTrait) {
 	*out = *in
 	in.Trait.DeepCopyInto(&out.Trait)
+	if in.SidecarContainers != nil {
+		in, out := &in.SidecarContainers, &out.SidecarContainers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodTrait.
@@ -792,6 +1139,16 @@ func (in *PrometheusTrait) DeepCopyInto(out *PrometheusTrait) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ServiceMonitor != nil {
+		in, out := &in.ServiceMonitor, &out.ServiceMonitor
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ServiceMonitorLabels != nil {
+		in, out := &in.ServiceMonitorLabels, &out.ServiceMonitorLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusTrait.
@@ -818,6 +1175,11 @@ func (in *PullSecretTrait) DeepCopyInto(out *PullSecretTrait) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.LinkServiceAccount != nil {
+		in, out := &in.LinkServiceAccount, &out.LinkServiceAccount
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullSecretTrait.
@@ -902,6 +1264,47 @@ func (in *RouteTrait) DeepCopy() *RouteTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityContextTrait) DeepCopyInto(out *SecurityContextTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.RunAsNonRoot != nil {
+		in, out := &in.RunAsNonRoot, &out.RunAsNonRoot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RunAsUser != nil {
+		in, out := &in.RunAsUser, &out.RunAsUser
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadOnlyRootFilesystem != nil {
+		in, out := &in.ReadOnlyRootFilesystem, &out.ReadOnlyRootFilesystem
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowPrivilegeEscalation != nil {
+		in, out := &in.AllowPrivilegeEscalation, &out.AllowPrivilegeEscalation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DropCapabilities != nil {
+		in, out := &in.DropCapabilities, &out.DropCapabilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityContextTrait.
+func (in *SecurityContextTrait) DeepCopy() *SecurityContextTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityContextTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceBindingTrait) DeepCopyInto(out *ServiceBindingTrait) {
 	*out = *in
@@ -923,6 +1326,22 @@ func (in *ServiceBindingTrait) DeepCopy() *ServiceBindingTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceCATrait) DeepCopyInto(out *ServiceCATrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCATrait.
+func (in *ServiceCATrait) DeepCopy() *ServiceCATrait {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceCATrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceTrait) DeepCopyInto(out *ServiceTrait) {
 	*out = *in
@@ -942,6 +1361,31 @@ func (in *ServiceTrait) DeepCopyInto(out *ServiceTrait) {
 		*out = new(ServiceType)
 		**out = **in
 	}
+	if in.AllPorts != nil {
+		in, out := &in.AllPorts, &out.AllPorts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PortServices != nil {
+		in, out := &in.PortServices, &out.PortServices
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConsumerPorts != nil {
+		in, out := &in.ConsumerPorts, &out.ConsumerPorts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceTrait.
@@ -954,6 +1398,68 @@ func (in *ServiceTrait) DeepCopy() *ServiceTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpotTrait) DeepCopyInto(out *SpotTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NoticePeriod != nil {
+		in, out := &in.NoticePeriod, &out.NoticePeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadinessGate != nil {
+		in, out := &in.ReadinessGate, &out.ReadinessGate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PreemptionTaints != nil {
+		in, out := &in.PreemptionTaints, &out.PreemptionTaints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotTrait.
+func (in *SpotTrait) DeepCopy() *SpotTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(SpotTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatefulSetTrait) DeepCopyInto(out *StatefulSetTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.VolumeClaims != nil {
+		in, out := &in.VolumeClaims, &out.VolumeClaims
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatefulSetTrait.
+func (in *StatefulSetTrait) DeepCopy() *StatefulSetTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(StatefulSetTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TolerationTrait) DeepCopyInto(out *TolerationTrait) {
 	*out = *in
@@ -999,3 +1505,29 @@ func (in *Trait) DeepCopy() *Trait {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidationTrait) DeepCopyInto(out *ValidationTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidationTrait.
+func (in *ValidationTrait) DeepCopy() *ValidationTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidationTrait)
+	in.DeepCopyInto(out)
+	return out
+}
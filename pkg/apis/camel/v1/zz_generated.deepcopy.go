@@ -149,6 +149,16 @@ func (in *BuildSpec) DeepCopyInto(out *BuildSpec) {
 		}
 	}
 	out.Timeout = in.Timeout
+	if in.MaxRecoveryAttempts != nil {
+		in, out := &in.MaxRecoveryAttempts, &out.MaxRecoveryAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRunningBuilds != nil {
+		in, out := &in.MaxRunningBuilds, &out.MaxRunningBuilds
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildSpec.
@@ -169,6 +179,13 @@ func (in *BuildStatus) DeepCopyInto(out *BuildStatus) {
 		*out = make([]Artifact, len(*in))
 		copy(*out, *in)
 	}
+	if in.Tasks != nil {
+		in, out := &in.Tasks, &out.Tasks
+		*out = make([]TaskStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Failure != nil {
 		in, out := &in.Failure, &out.Failure
 		*out = new(Failure)
@@ -202,11 +219,26 @@ func (in *BuildahTask) DeepCopyInto(out *BuildahTask) {
 	*out = *in
 	out.BaseTask = in.BaseTask
 	out.PublishTask = in.PublishTask
+	if in.Platforms != nil {
+		in, out := &in.Platforms, &out.Platforms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Verbose != nil {
 		in, out := &in.Verbose, &out.Verbose
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Rootless != nil {
+		in, out := &in.Rootless, &out.Rootless
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RunAsUser != nil {
+		in, out := &in.RunAsUser, &out.RunAsUser
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildahTask.
@@ -902,6 +934,16 @@ func (in *IntegrationPlatformBuildSpec) DeepCopyInto(out *IntegrationPlatformBui
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.MaxRecoveryAttempts != nil {
+		in, out := &in.MaxRecoveryAttempts, &out.MaxRecoveryAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRunningBuilds != nil {
+		in, out := &in.MaxRunningBuilds, &out.MaxRunningBuilds
+		*out = new(int32)
+		**out = **in
+	}
 	in.Maven.DeepCopyInto(&out.Maven)
 	if in.PublishStrategyOptions != nil {
 		in, out := &in.PublishStrategyOptions, &out.PublishStrategyOptions
@@ -1251,6 +1293,11 @@ func (in *MavenBuildSpec) DeepCopyInto(out *MavenBuildSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MasterPassword != nil {
+		in, out := &in.MasterPassword, &out.MasterPassword
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MavenBuildSpec.
@@ -1381,6 +1428,13 @@ func (in *PodSpec) DeepCopyInto(out *PodSpec) {
 		}
 	}
 	in.SecurityContext.DeepCopyInto(&out.SecurityContext)
+	if in.HostAliases != nil {
+		in, out := &in.HostAliases, &out.HostAliases
+		*out = make([]corev1.HostAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSpec.
@@ -1706,6 +1760,29 @@ func (in *Task) DeepCopy() *Task {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskStatus) DeepCopyInto(out *TaskStatus) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskStatus.
+func (in *TaskStatus) DeepCopy() *TaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TraitConfiguration) DeepCopyInto(out *TraitConfiguration) {
 	*out = *in
@@ -1785,6 +1862,16 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.DeploymentTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(trait.DNSTrait)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(trait.DrainTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Environment != nil {
 		in, out := &in.Environment, &out.Environment
 		*out = new(trait.EnvironmentTrait)
@@ -1805,6 +1892,21 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.HealthTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HPA != nil {
+		in, out := &in.HPA, &out.HPA
+		*out = new(trait.HPATrait)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(trait.HTTPTrait)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Infinispan != nil {
+		in, out := &in.Infinispan, &out.Infinispan
+		*out = new(trait.InfinispanTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Ingress != nil {
 		in, out := &in.Ingress, &out.Ingress
 		*out = new(trait.IngressTrait)
@@ -1825,6 +1927,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.JVMTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(trait.KafkaTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Kamelets != nil {
 		in, out := &in.Kamelets, &out.Kamelets
 		*out = new(trait.KameletsTrait)
@@ -1850,6 +1957,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.MountTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(trait.NetworkPolicyTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.OpenAPI != nil {
 		in, out := &in.OpenAPI, &out.OpenAPI
 		*out = new(trait.OpenAPITrait)
@@ -1900,21 +2012,46 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.RouteTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(trait.SecurityContextTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Service != nil {
 		in, out := &in.Service, &out.Service
 		*out = new(trait.ServiceTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceCA != nil {
+		in, out := &in.ServiceCA, &out.ServiceCA
+		*out = new(trait.ServiceCATrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ServiceBinding != nil {
 		in, out := &in.ServiceBinding, &out.ServiceBinding
 		*out = new(trait.ServiceBindingTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Spot != nil {
+		in, out := &in.Spot, &out.Spot
+		*out = new(trait.SpotTrait)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StatefulSet != nil {
+		in, out := &in.StatefulSet, &out.StatefulSet
+		*out = new(trait.StatefulSetTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Toleration != nil {
 		in, out := &in.Toleration, &out.Toleration
 		*out = new(trait.TolerationTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(trait.ValidationTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Addons != nil {
 		in, out := &in.Addons, &out.Addons
 		*out = make(map[string]AddonTrait, len(*in))
@@ -17,10 +17,33 @@ limitations under the License.
 
 package builder
 
+import "sort"
+
 const BuildahPlatform = "BuildahPlatform"
 const BuildahImage = "BuildahImage"
+const BuildahRootless = "BuildahRootless"
+const BuildahUser = "BuildahUser"
 const BuildahDefaultImageName = "quay.io/buildah/stable"
 
+// BuildahSupportedPlatforms are the platforms multi-arch images can be built for.
+var BuildahSupportedPlatforms = map[string]bool{
+	"linux/amd64":   true,
+	"linux/arm64":   true,
+	"linux/arm/v7":  true,
+	"linux/ppc64le": true,
+	"linux/s390x":   true,
+}
+
+// BuildahSupportedPlatformList returns the sorted list of the platforms multi-arch images can be built for.
+func BuildahSupportedPlatformList() []string {
+	platforms := make([]string, 0, len(BuildahSupportedPlatforms))
+	for p := range BuildahSupportedPlatforms {
+		platforms = append(platforms, p)
+	}
+	sort.Strings(platforms)
+	return platforms
+}
+
 var buildahSupportedOptions = map[string]PublishStrategyOption{
 	BuildahPlatform: {
 		Name:        BuildahPlatform,
@@ -31,4 +54,14 @@ var buildahSupportedOptions = map[string]PublishStrategyOption{
 		description:  "The docker image to use for Buildah",
 		defaultValue: BuildahDefaultImageName,
 	},
+	BuildahRootless: {
+		Name:         BuildahRootless,
+		description:  "To run Buildah as a non-root user, when the cluster does not permit privileged build containers",
+		defaultValue: "false",
+	},
+	BuildahUser: {
+		Name:         BuildahUser,
+		description:  "The UID of the non-root user Buildah is run as, when rootless",
+		defaultValue: "1000",
+	},
 }
@@ -20,17 +20,29 @@ package builder
 // KanikoCacheDir is the cache directory for Kaniko builds (mounted into the Kaniko pod).
 const KanikoCacheDir = "/kaniko/cache"
 const KanikoPVCName = "KanikoPersistentVolumeClaim"
+const KanikoPVCSize = "KanikoPersistentVolumeClaimSize"
+const KanikoPVCStorageClass = "KanikoPersistentVolumeClaimStorageClass"
 const KanikoBuildCacheEnabled = "KanikoBuildCacheEnabled"
 const KanikoExecutorImage = "KanikoExecutorImage"
 const KanikoWarmerImage = "KanikoWarmerImage"
 const KanikoDefaultExecutorImageName = "gcr.io/kaniko-project/executor"
 const KanikoDefaultWarmerImageName = "gcr.io/kaniko-project/warmer"
+const KanikoDefaultPVCSize = "1Gi"
 
 var kanikoSupportedOptions = map[string]PublishStrategyOption{
 	KanikoPVCName: {
 		Name:        KanikoPVCName,
 		description: "The name of the PersistentVolumeClaim",
 	},
+	KanikoPVCSize: {
+		Name:         KanikoPVCSize,
+		description:  "The storage size requested by the PersistentVolumeClaim, when created by the operator",
+		defaultValue: KanikoDefaultPVCSize,
+	},
+	KanikoPVCStorageClass: {
+		Name:        KanikoPVCStorageClass,
+		description: "The storage class of the PersistentVolumeClaim, when created by the operator",
+	},
 	KanikoBuildCacheEnabled: {
 		Name:         KanikoBuildCacheEnabled,
 		description:  "To enable or disable the Kaniko cache",
@@ -119,6 +119,18 @@ func generateProjectSettings(ctx *builderContext) error {
 		ctx.Maven.SettingsSecurity = []byte(settingsSecurity)
 	}
 
+	if ctx.Build.Maven.MasterPassword != nil {
+		master, err := kubernetes.GetSecretRefValue(ctx.C, ctx.Client, ctx.Namespace, ctx.Build.Maven.MasterPassword)
+		if err != nil {
+			return err
+		}
+		data, err := maven.NewSettingsSecurity(strings.TrimSpace(master)).MarshalBytes()
+		if err != nil {
+			return err
+		}
+		ctx.Maven.SettingsSecurity = data
+	}
+
 	return nil
 }
 
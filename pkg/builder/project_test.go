@@ -430,6 +430,54 @@ func TestMavenSettingsWithSettingsSecurityFromSecret(t *testing.T) {
 	assert.Equal(t, []byte("setting-security-data"), ctx.Maven.SettingsSecurity)
 }
 
+func TestMavenSettingsWithMasterPasswordFromSecret(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	c, err := test.NewFakeClient(
+		&corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "Secret",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "maven-security",
+			},
+			Data: map[string][]byte{
+				"master": []byte("{jSMOWnoPFgsHVpMvz5VrIt5kRbzGpI8u+9EF1iFQyJQ=}\n"),
+			},
+		},
+	)
+
+	assert.Nil(t, err)
+
+	ctx := builderContext{
+		Catalog:   catalog,
+		Client:    c,
+		Namespace: "ns",
+		Build: v1.BuilderTask{
+			Runtime: catalog.Runtime,
+			Maven: v1.MavenBuildSpec{
+				MasterPassword: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: "maven-security",
+					},
+					Key: "master",
+				},
+			},
+		},
+	}
+
+	err = Project.GenerateProjectSettings.execute(&ctx)
+	assert.Nil(t, err)
+
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<settingsSecurity>
+  <master>{jSMOWnoPFgsHVpMvz5VrIt5kRbzGpI8u+9EF1iFQyJQ=}</master>
+</settingsSecurity>`, string(ctx.Maven.SettingsSecurity))
+}
+
 func TestInjectEmptyServersIntoDefaultMavenSettings(t *testing.T) {
 	settings, err := maven.NewSettings(maven.DefaultRepositories)
 	assert.Nil(t, err)
@@ -24,9 +24,12 @@ package v1
 type BuildahTaskApplyConfiguration struct {
 	BaseTaskApplyConfiguration    `json:",inline"`
 	PublishTaskApplyConfiguration `json:",inline"`
-	Platform                      *string `json:"platform,omitempty"`
-	Verbose                       *bool   `json:"verbose,omitempty"`
-	ExecutorImage                 *string `json:"executorImage,omitempty"`
+	Platform                      *string  `json:"platform,omitempty"`
+	Platforms                     []string `json:"platforms,omitempty"`
+	Verbose                       *bool    `json:"verbose,omitempty"`
+	ExecutorImage                 *string  `json:"executorImage,omitempty"`
+	Rootless                      *bool    `json:"rootless,omitempty"`
+	RunAsUser                     *int64   `json:"runAsUser,omitempty"`
 }
 
 // BuildahTaskApplyConfiguration constructs an declarative configuration of the BuildahTask type for use with
@@ -83,6 +86,16 @@ func (b *BuildahTaskApplyConfiguration) WithPlatform(value string) *BuildahTaskA
 	return b
 }
 
+// WithPlatforms adds the given value to the Platforms field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Platforms field.
+func (b *BuildahTaskApplyConfiguration) WithPlatforms(values ...string) *BuildahTaskApplyConfiguration {
+	for i := range values {
+		b.Platforms = append(b.Platforms, values[i])
+	}
+	return b
+}
+
 // WithVerbose sets the Verbose field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Verbose field is set to the value of the last call.
@@ -98,3 +111,19 @@ func (b *BuildahTaskApplyConfiguration) WithExecutorImage(value string) *Buildah
 	b.ExecutorImage = &value
 	return b
 }
+
+// WithRootless sets the Rootless field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rootless field is set to the value of the last call.
+func (b *BuildahTaskApplyConfiguration) WithRootless(value bool) *BuildahTaskApplyConfiguration {
+	b.Rootless = &value
+	return b
+}
+
+// WithRunAsUser sets the RunAsUser field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RunAsUser field is set to the value of the last call.
+func (b *BuildahTaskApplyConfiguration) WithRunAsUser(value int64) *BuildahTaskApplyConfiguration {
+	b.RunAsUser = &value
+	return b
+}
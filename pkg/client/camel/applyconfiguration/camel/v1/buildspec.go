@@ -27,9 +27,11 @@ import (
 // BuildSpecApplyConfiguration represents an declarative configuration of the BuildSpec type for use
 // with apply.
 type BuildSpecApplyConfiguration struct {
-	Tasks    []TaskApplyConfiguration `json:"tasks,omitempty"`
-	Strategy *camelv1.BuildStrategy   `json:"strategy,omitempty"`
-	Timeout  *metav1.Duration         `json:"timeout,omitempty"`
+	Tasks               []TaskApplyConfiguration `json:"tasks,omitempty"`
+	Strategy            *camelv1.BuildStrategy   `json:"strategy,omitempty"`
+	Timeout             *metav1.Duration         `json:"timeout,omitempty"`
+	MaxRecoveryAttempts *int32                   `json:"maxRecoveryAttempts,omitempty"`
+	MaxRunningBuilds    *int32                   `json:"maxRunningBuilds,omitempty"`
 }
 
 // BuildSpecApplyConfiguration constructs an declarative configuration of the BuildSpec type for use with
@@ -66,3 +68,19 @@ func (b *BuildSpecApplyConfiguration) WithTimeout(value metav1.Duration) *BuildS
 	b.Timeout = &value
 	return b
 }
+
+// WithMaxRecoveryAttempts sets the MaxRecoveryAttempts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRecoveryAttempts field is set to the value of the last call.
+func (b *BuildSpecApplyConfiguration) WithMaxRecoveryAttempts(value int32) *BuildSpecApplyConfiguration {
+	b.MaxRecoveryAttempts = &value
+	return b
+}
+
+// WithMaxRunningBuilds sets the MaxRunningBuilds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRunningBuilds field is set to the value of the last call.
+func (b *BuildSpecApplyConfiguration) WithMaxRunningBuilds(value int32) *BuildSpecApplyConfiguration {
+	b.MaxRunningBuilds = &value
+	return b
+}
@@ -33,9 +33,11 @@ type BuildStatusApplyConfiguration struct {
 	Digest             *string                            `json:"digest,omitempty"`
 	BaseImage          *string                            `json:"baseImage,omitempty"`
 	Artifacts          []ArtifactApplyConfiguration       `json:"artifacts,omitempty"`
+	Tasks              []TaskStatusApplyConfiguration     `json:"tasks,omitempty"`
 	Error              *string                            `json:"error,omitempty"`
 	Failure            *FailureApplyConfiguration         `json:"failure,omitempty"`
 	StartedAt          *metav1.Time                       `json:"startedAt,omitempty"`
+	QueuePosition      *int32                             `json:"queuePosition,omitempty"`
 	Conditions         []BuildConditionApplyConfiguration `json:"conditions,omitempty"`
 	Duration           *string                            `json:"duration,omitempty"`
 }
@@ -99,6 +101,19 @@ func (b *BuildStatusApplyConfiguration) WithArtifacts(values ...*ArtifactApplyCo
 	return b
 }
 
+// WithTasks adds the given value to the Tasks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Tasks field.
+func (b *BuildStatusApplyConfiguration) WithTasks(values ...*TaskStatusApplyConfiguration) *BuildStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTasks")
+		}
+		b.Tasks = append(b.Tasks, *values[i])
+	}
+	return b
+}
+
 // WithError sets the Error field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Error field is set to the value of the last call.
@@ -123,6 +138,14 @@ func (b *BuildStatusApplyConfiguration) WithStartedAt(value metav1.Time) *BuildS
 	return b
 }
 
+// WithQueuePosition sets the QueuePosition field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QueuePosition field is set to the value of the last call.
+func (b *BuildStatusApplyConfiguration) WithQueuePosition(value int32) *BuildStatusApplyConfiguration {
+	b.QueuePosition = &value
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
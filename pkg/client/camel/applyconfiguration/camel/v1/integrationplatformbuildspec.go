@@ -34,6 +34,8 @@ type IntegrationPlatformBuildSpecApplyConfiguration struct {
 	BaseImage              *string                                     `json:"baseImage,omitempty"`
 	Registry               *RegistrySpecApplyConfiguration             `json:"registry,omitempty"`
 	Timeout                *metav1.Duration                            `json:"timeout,omitempty"`
+	MaxRecoveryAttempts    *int32                                      `json:"maxRecoveryAttempts,omitempty"`
+	MaxRunningBuilds       *int32                                      `json:"maxRunningBuilds,omitempty"`
 	Maven                  *MavenSpecApplyConfiguration                `json:"maven,omitempty"`
 	PublishStrategyOptions map[string]string                           `json:"PublishStrategyOptions,omitempty"`
 }
@@ -100,6 +102,22 @@ func (b *IntegrationPlatformBuildSpecApplyConfiguration) WithTimeout(value metav
 	return b
 }
 
+// WithMaxRecoveryAttempts sets the MaxRecoveryAttempts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRecoveryAttempts field is set to the value of the last call.
+func (b *IntegrationPlatformBuildSpecApplyConfiguration) WithMaxRecoveryAttempts(value int32) *IntegrationPlatformBuildSpecApplyConfiguration {
+	b.MaxRecoveryAttempts = &value
+	return b
+}
+
+// WithMaxRunningBuilds sets the MaxRunningBuilds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRunningBuilds field is set to the value of the last call.
+func (b *IntegrationPlatformBuildSpecApplyConfiguration) WithMaxRunningBuilds(value int32) *IntegrationPlatformBuildSpecApplyConfiguration {
+	b.MaxRunningBuilds = &value
+	return b
+}
+
 // WithMaven sets the Maven field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Maven field is set to the value of the last call.
@@ -28,24 +28,25 @@ import (
 // IntegrationStatusApplyConfiguration represents an declarative configuration of the IntegrationStatus type for use
 // with apply.
 type IntegrationStatusApplyConfiguration struct {
-	ObservedGeneration      *int64                                   `json:"observedGeneration,omitempty"`
-	Phase                   *v1.IntegrationPhase                     `json:"phase,omitempty"`
-	Digest                  *string                                  `json:"digest,omitempty"`
-	Image                   *string                                  `json:"image,omitempty"`
-	Dependencies            []string                                 `json:"dependencies,omitempty"`
-	Profile                 *v1.TraitProfile                         `json:"profile,omitempty"`
-	IntegrationKit          *corev1.ObjectReference                  `json:"integrationKit,omitempty"`
-	Platform                *string                                  `json:"platform,omitempty"`
-	GeneratedSources        []SourceSpecApplyConfiguration           `json:"generatedSources,omitempty"`
-	RuntimeVersion          *string                                  `json:"runtimeVersion,omitempty"`
-	RuntimeProvider         *v1.RuntimeProvider                      `json:"runtimeProvider,omitempty"`
-	Configuration           []ConfigurationSpecApplyConfiguration    `json:"configuration,omitempty"`
-	Conditions              []IntegrationConditionApplyConfiguration `json:"conditions,omitempty"`
-	Version                 *string                                  `json:"version,omitempty"`
-	Replicas                *int32                                   `json:"replicas,omitempty"`
-	Selector                *string                                  `json:"selector,omitempty"`
-	Capabilities            []string                                 `json:"capabilities,omitempty"`
-	InitializationTimestamp *metav1.Time                             `json:"lastInitTimestamp,omitempty"`
+	ObservedGeneration             *int64                                   `json:"observedGeneration,omitempty"`
+	Phase                          *v1.IntegrationPhase                     `json:"phase,omitempty"`
+	Digest                         *string                                  `json:"digest,omitempty"`
+	Image                          *string                                  `json:"image,omitempty"`
+	Dependencies                   []string                                 `json:"dependencies,omitempty"`
+	Profile                        *v1.TraitProfile                         `json:"profile,omitempty"`
+	IntegrationKit                 *corev1.ObjectReference                  `json:"integrationKit,omitempty"`
+	Platform                       *string                                  `json:"platform,omitempty"`
+	GeneratedSources               []SourceSpecApplyConfiguration           `json:"generatedSources,omitempty"`
+	RuntimeVersion                 *string                                  `json:"runtimeVersion,omitempty"`
+	RuntimeProvider                *v1.RuntimeProvider                      `json:"runtimeProvider,omitempty"`
+	Configuration                  []ConfigurationSpecApplyConfiguration    `json:"configuration,omitempty"`
+	Conditions                     []IntegrationConditionApplyConfiguration `json:"conditions,omitempty"`
+	Version                        *string                                  `json:"version,omitempty"`
+	Replicas                       *int32                                   `json:"replicas,omitempty"`
+	Selector                       *string                                  `json:"selector,omitempty"`
+	Capabilities                   []string                                 `json:"capabilities,omitempty"`
+	InitializationTimestamp        *metav1.Time                             `json:"lastInitTimestamp,omitempty"`
+	DependenciesResolutionAttempts *int32                                   `json:"dependenciesResolutionAttempts,omitempty"`
 }
 
 // IntegrationStatusApplyConfiguration constructs an declarative configuration of the IntegrationStatus type for use with
@@ -216,3 +217,11 @@ func (b *IntegrationStatusApplyConfiguration) WithInitializationTimestamp(value
 	b.InitializationTimestamp = &value
 	return b
 }
+
+// WithDependenciesResolutionAttempts sets the DependenciesResolutionAttempts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DependenciesResolutionAttempts field is set to the value of the last call.
+func (b *IntegrationStatusApplyConfiguration) WithDependenciesResolutionAttempts(value int32) *IntegrationStatusApplyConfiguration {
+	b.DependenciesResolutionAttempts = &value
+	return b
+}
@@ -29,6 +29,7 @@ type MavenBuildSpecApplyConfiguration struct {
 	MavenSpecApplyConfiguration `json:",inline"`
 	Repositories                []RepositoryApplyConfiguration `json:"repositories,omitempty"`
 	Servers                     []ServerApplyConfiguration     `json:"servers,omitempty"`
+	MasterPassword              *corev1.SecretKeySelector      `json:"masterPassword,omitempty"`
 }
 
 // MavenBuildSpecApplyConfiguration constructs an declarative configuration of the MavenBuildSpec type for use with
@@ -133,3 +134,11 @@ func (b *MavenBuildSpecApplyConfiguration) WithServers(values ...*ServerApplyCon
 	}
 	return b
 }
+
+// WithMasterPassword sets the MasterPassword field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MasterPassword field is set to the value of the last call.
+func (b *MavenBuildSpecApplyConfiguration) WithMasterPassword(value corev1.SecretKeySelector) *MavenBuildSpecApplyConfiguration {
+	b.MasterPassword = &value
+	return b
+}
@@ -37,6 +37,7 @@ type PodSpecApplyConfiguration struct {
 	NodeSelector                  map[string]string             `json:"nodeSelector,omitempty"`
 	TopologySpreadConstraints     []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 	SecurityContext               *v1.PodSecurityContext        `json:"securityContext,omitempty"`
+	HostAliases                   []v1.HostAlias                `json:"hostAliases,omitempty"`
 }
 
 // PodSpecApplyConfiguration constructs an declarative configuration of the PodSpec type for use with
@@ -148,3 +149,13 @@ func (b *PodSpecApplyConfiguration) WithSecurityContext(value v1.PodSecurityCont
 	b.SecurityContext = &value
 	return b
 }
+
+// WithHostAliases adds the given value to the HostAliases field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the HostAliases field.
+func (b *PodSpecApplyConfiguration) WithHostAliases(values ...v1.HostAlias) *PodSpecApplyConfiguration {
+	for i := range values {
+		b.HostAliases = append(b.HostAliases, values[i])
+	}
+	return b
+}
@@ -26,46 +26,58 @@ import (
 // TraitsApplyConfiguration represents an declarative configuration of the Traits type for use
 // with apply.
 type TraitsApplyConfiguration struct {
-	Affinity       *trait.AffinityTrait                    `json:"affinity,omitempty"`
-	Builder        *trait.BuilderTrait                     `json:"builder,omitempty"`
-	Camel          *trait.CamelTrait                       `json:"camel,omitempty"`
-	Container      *trait.ContainerTrait                   `json:"container,omitempty"`
-	Cron           *trait.CronTrait                        `json:"cron,omitempty"`
-	Dependencies   *trait.DependenciesTrait                `json:"dependencies,omitempty"`
-	Deployer       *trait.DeployerTrait                    `json:"deployer,omitempty"`
-	Deployment     *trait.DeploymentTrait                  `json:"deployment,omitempty"`
-	Environment    *trait.EnvironmentTrait                 `json:"environment,omitempty"`
-	ErrorHandler   *trait.ErrorHandlerTrait                `json:"error-handler,omitempty"`
-	GC             *trait.GCTrait                          `json:"gc,omitempty"`
-	Health         *trait.HealthTrait                      `json:"health,omitempty"`
-	Ingress        *trait.IngressTrait                     `json:"ingress,omitempty"`
-	Istio          *trait.IstioTrait                       `json:"istio,omitempty"`
-	Jolokia        *trait.JolokiaTrait                     `json:"jolokia,omitempty"`
-	JVM            *trait.JVMTrait                         `json:"jvm,omitempty"`
-	Kamelets       *trait.KameletsTrait                    `json:"kamelets,omitempty"`
-	Knative        *trait.KnativeTrait                     `json:"knative,omitempty"`
-	KnativeService *trait.KnativeServiceTrait              `json:"knative-service,omitempty"`
-	Logging        *trait.LoggingTrait                     `json:"logging,omitempty"`
-	Mount          *trait.MountTrait                       `json:"mount,omitempty"`
-	OpenAPI        *trait.OpenAPITrait                     `json:"openapi,omitempty"`
-	Owner          *trait.OwnerTrait                       `json:"owner,omitempty"`
-	PDB            *trait.PDBTrait                         `json:"pdb,omitempty"`
-	Platform       *trait.PlatformTrait                    `json:"platform,omitempty"`
-	Pod            *trait.PodTrait                         `json:"pod,omitempty"`
-	Prometheus     *trait.PrometheusTrait                  `json:"prometheus,omitempty"`
-	PullSecret     *trait.PullSecretTrait                  `json:"pull-secret,omitempty"`
-	Quarkus        *trait.QuarkusTrait                     `json:"quarkus,omitempty"`
-	Registry       *trait.RegistryTrait                    `json:"registry,omitempty"`
-	Route          *trait.RouteTrait                       `json:"route,omitempty"`
-	Service        *trait.ServiceTrait                     `json:"service,omitempty"`
-	ServiceBinding *trait.ServiceBindingTrait              `json:"service-binding,omitempty"`
-	Toleration     *trait.TolerationTrait                  `json:"toleration,omitempty"`
-	Addons         map[string]AddonTraitApplyConfiguration `json:"addons,omitempty"`
-	Keda           *TraitSpecApplyConfiguration            `json:"keda,omitempty"`
-	Master         *TraitSpecApplyConfiguration            `json:"master,omitempty"`
-	Strimzi        *TraitSpecApplyConfiguration            `json:"strimzi,omitempty"`
-	ThreeScale     *TraitSpecApplyConfiguration            `json:"3scale,omitempty"`
-	Tracing        *TraitSpecApplyConfiguration            `json:"tracing,omitempty"`
+	Affinity        *trait.AffinityTrait                    `json:"affinity,omitempty"`
+	Builder         *trait.BuilderTrait                     `json:"builder,omitempty"`
+	Camel           *trait.CamelTrait                       `json:"camel,omitempty"`
+	Container       *trait.ContainerTrait                   `json:"container,omitempty"`
+	Cron            *trait.CronTrait                        `json:"cron,omitempty"`
+	Dependencies    *trait.DependenciesTrait                `json:"dependencies,omitempty"`
+	Deployer        *trait.DeployerTrait                    `json:"deployer,omitempty"`
+	Deployment      *trait.DeploymentTrait                  `json:"deployment,omitempty"`
+	DNS             *trait.DNSTrait                         `json:"dns,omitempty"`
+	Drain           *trait.DrainTrait                       `json:"drain,omitempty"`
+	Environment     *trait.EnvironmentTrait                 `json:"environment,omitempty"`
+	ErrorHandler    *trait.ErrorHandlerTrait                `json:"error-handler,omitempty"`
+	GC              *trait.GCTrait                          `json:"gc,omitempty"`
+	Health          *trait.HealthTrait                      `json:"health,omitempty"`
+	HPA             *trait.HPATrait                         `json:"hpa,omitempty"`
+	HTTP            *trait.HTTPTrait                        `json:"http,omitempty"`
+	Infinispan      *trait.InfinispanTrait                  `json:"infinispan,omitempty"`
+	Ingress         *trait.IngressTrait                     `json:"ingress,omitempty"`
+	Istio           *trait.IstioTrait                       `json:"istio,omitempty"`
+	Jolokia         *trait.JolokiaTrait                     `json:"jolokia,omitempty"`
+	JVM             *trait.JVMTrait                         `json:"jvm,omitempty"`
+	Kafka           *trait.KafkaTrait                       `json:"kafka,omitempty"`
+	Kamelets        *trait.KameletsTrait                    `json:"kamelets,omitempty"`
+	Knative         *trait.KnativeTrait                     `json:"knative,omitempty"`
+	KnativeService  *trait.KnativeServiceTrait              `json:"knative-service,omitempty"`
+	Logging         *trait.LoggingTrait                     `json:"logging,omitempty"`
+	Mount           *trait.MountTrait                       `json:"mount,omitempty"`
+	NetworkPolicy   *trait.NetworkPolicyTrait               `json:"network-policy,omitempty"`
+	OpenAPI         *trait.OpenAPITrait                     `json:"openapi,omitempty"`
+	Owner           *trait.OwnerTrait                       `json:"owner,omitempty"`
+	PDB             *trait.PDBTrait                         `json:"pdb,omitempty"`
+	Platform        *trait.PlatformTrait                    `json:"platform,omitempty"`
+	Pod             *trait.PodTrait                         `json:"pod,omitempty"`
+	Prometheus      *trait.PrometheusTrait                  `json:"prometheus,omitempty"`
+	PullSecret      *trait.PullSecretTrait                  `json:"pull-secret,omitempty"`
+	Quarkus         *trait.QuarkusTrait                     `json:"quarkus,omitempty"`
+	Registry        *trait.RegistryTrait                    `json:"registry,omitempty"`
+	Route           *trait.RouteTrait                       `json:"route,omitempty"`
+	SecurityContext *trait.SecurityContextTrait             `json:"security-context,omitempty"`
+	Service         *trait.ServiceTrait                     `json:"service,omitempty"`
+	ServiceCA       *trait.ServiceCATrait                   `json:"service-ca,omitempty"`
+	ServiceBinding  *trait.ServiceBindingTrait              `json:"service-binding,omitempty"`
+	Spot            *trait.SpotTrait                        `json:"spot,omitempty"`
+	StatefulSet     *trait.StatefulSetTrait                 `json:"stateful-set,omitempty"`
+	Toleration      *trait.TolerationTrait                  `json:"toleration,omitempty"`
+	Validation      *trait.ValidationTrait                  `json:"validation,omitempty"`
+	Addons          map[string]AddonTraitApplyConfiguration `json:"addons,omitempty"`
+	Keda            *TraitSpecApplyConfiguration            `json:"keda,omitempty"`
+	Master          *TraitSpecApplyConfiguration            `json:"master,omitempty"`
+	Strimzi         *TraitSpecApplyConfiguration            `json:"strimzi,omitempty"`
+	ThreeScale      *TraitSpecApplyConfiguration            `json:"3scale,omitempty"`
+	Tracing         *TraitSpecApplyConfiguration            `json:"tracing,omitempty"`
 }
 
 // TraitsApplyConfiguration constructs an declarative configuration of the Traits type for use with
@@ -138,6 +150,22 @@ func (b *TraitsApplyConfiguration) WithDeployment(value trait.DeploymentTrait) *
 	return b
 }
 
+// WithDNS sets the DNS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DNS field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithDNS(value trait.DNSTrait) *TraitsApplyConfiguration {
+	b.DNS = &value
+	return b
+}
+
+// WithDrain sets the Drain field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Drain field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithDrain(value trait.DrainTrait) *TraitsApplyConfiguration {
+	b.Drain = &value
+	return b
+}
+
 // WithEnvironment sets the Environment field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Environment field is set to the value of the last call.
@@ -170,6 +198,30 @@ func (b *TraitsApplyConfiguration) WithHealth(value trait.HealthTrait) *TraitsAp
 	return b
 }
 
+// WithHPA sets the HPA field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HPA field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithHPA(value trait.HPATrait) *TraitsApplyConfiguration {
+	b.HPA = &value
+	return b
+}
+
+// WithHTTP sets the HTTP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HTTP field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithHTTP(value trait.HTTPTrait) *TraitsApplyConfiguration {
+	b.HTTP = &value
+	return b
+}
+
+// WithInfinispan sets the Infinispan field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Infinispan field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithInfinispan(value trait.InfinispanTrait) *TraitsApplyConfiguration {
+	b.Infinispan = &value
+	return b
+}
+
 // WithIngress sets the Ingress field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Ingress field is set to the value of the last call.
@@ -202,6 +254,14 @@ func (b *TraitsApplyConfiguration) WithJVM(value trait.JVMTrait) *TraitsApplyCon
 	return b
 }
 
+// WithKafka sets the Kafka field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kafka field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithKafka(value trait.KafkaTrait) *TraitsApplyConfiguration {
+	b.Kafka = &value
+	return b
+}
+
 // WithKamelets sets the Kamelets field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Kamelets field is set to the value of the last call.
@@ -242,6 +302,14 @@ func (b *TraitsApplyConfiguration) WithMount(value trait.MountTrait) *TraitsAppl
 	return b
 }
 
+// WithNetworkPolicy sets the NetworkPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkPolicy field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithNetworkPolicy(value trait.NetworkPolicyTrait) *TraitsApplyConfiguration {
+	b.NetworkPolicy = &value
+	return b
+}
+
 // WithOpenAPI sets the OpenAPI field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the OpenAPI field is set to the value of the last call.
@@ -322,6 +390,14 @@ func (b *TraitsApplyConfiguration) WithRoute(value trait.RouteTrait) *TraitsAppl
 	return b
 }
 
+// WithSecurityContext sets the SecurityContext field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecurityContext field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithSecurityContext(value trait.SecurityContextTrait) *TraitsApplyConfiguration {
+	b.SecurityContext = &value
+	return b
+}
+
 // WithService sets the Service field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Service field is set to the value of the last call.
@@ -330,6 +406,14 @@ func (b *TraitsApplyConfiguration) WithService(value trait.ServiceTrait) *Traits
 	return b
 }
 
+// WithServiceCA sets the ServiceCA field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceCA field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithServiceCA(value trait.ServiceCATrait) *TraitsApplyConfiguration {
+	b.ServiceCA = &value
+	return b
+}
+
 // WithServiceBinding sets the ServiceBinding field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ServiceBinding field is set to the value of the last call.
@@ -338,6 +422,22 @@ func (b *TraitsApplyConfiguration) WithServiceBinding(value trait.ServiceBinding
 	return b
 }
 
+// WithSpot sets the Spot field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Spot field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithSpot(value trait.SpotTrait) *TraitsApplyConfiguration {
+	b.Spot = &value
+	return b
+}
+
+// WithStatefulSet sets the StatefulSet field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StatefulSet field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithStatefulSet(value trait.StatefulSetTrait) *TraitsApplyConfiguration {
+	b.StatefulSet = &value
+	return b
+}
+
 // WithToleration sets the Toleration field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Toleration field is set to the value of the last call.
@@ -346,6 +446,14 @@ func (b *TraitsApplyConfiguration) WithToleration(value trait.TolerationTrait) *
 	return b
 }
 
+// WithValidation sets the Validation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Validation field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithValidation(value trait.ValidationTrait) *TraitsApplyConfiguration {
+	b.Validation = &value
+	return b
+}
+
 // WithAddons puts the entries into the Addons field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, the entries provided by each call will be put on the Addons field,
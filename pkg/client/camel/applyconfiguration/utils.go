@@ -144,6 +144,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.SpectrumTaskApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Task"):
 		return &camelv1.TaskApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TaskStatus"):
+		return &camelv1.TaskStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("TraitConfiguration"):
 		return &camelv1.TraitConfigurationApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Traits"):
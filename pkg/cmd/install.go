@@ -90,6 +90,7 @@ func newCmdInstall(rootCmdOptions *RootCmdOptions) (*cobra.Command, *installCmdO
 	cmd.Flags().Bool("skip-default-kamelets-setup", false, "Skip installation of the default Kamelets from catalog")
 	cmd.Flags().Bool("example", false, "Install example integration")
 	cmd.Flags().Bool("global", false, "Configure the operator to watch all namespaces. No integration platform is created. You can run integrations in a namespace by installing an integration platform: 'kamel install --skip-operator-setup -n my-namespace'")
+	cmd.Flags().StringSlice("watch-namespaces", nil, "The namespaces watched by the global operator, instead of all namespaces (used in combination with the --global flag)")
 	cmd.Flags().Bool("force", false, "Force replacement of configuration resources when already present.")
 	cmd.Flags().StringP("output", "o", "", "Output format. One of: json|yaml")
 	cmd.Flags().String("organization", "", "A organization on the Docker registry that can be used to publish images")
@@ -110,6 +111,7 @@ func newCmdInstall(rootCmdOptions *RootCmdOptions) (*cobra.Command, *installCmdO
 	cmd.Flags().String("build-publish-strategy", "", "Set the build publish strategy")
 	cmd.Flags().StringArray("build-publish-strategy-option", nil, "Add a build publish strategy option, as <name=value>")
 	cmd.Flags().String("build-timeout", "", "Set how long the build process can last")
+	cmd.Flags().Int("max-running-builds", 0, "Set the maximum number of builds that can run concurrently in the platform namespace")
 	cmd.Flags().String("trait-profile", "", "The profile to use for traits")
 
 	// Kaniko
@@ -143,6 +145,18 @@ func newCmdInstall(rootCmdOptions *RootCmdOptions) (*cobra.Command, *installCmdO
 	cmd.Flags().Bool("monitoring", false, "To enable or disable the operator monitoring")
 	cmd.Flags().Int("monitoring-port", 8080, "The port of the metrics endpoint")
 
+	// webhook
+	cmd.Flags().Bool("webhook", false, "To enable or disable the operator validating admission webhooks")
+	cmd.Flags().Int("webhook-port", 9443, "The port of the validating admission webhooks")
+
+	// Controllers work queues, the operator defaults are used when unset
+	cmd.Flags().StringArray("max-concurrent-reconciles", nil, "The maximum number of concurrent reconciles of the controller of the given kind, e.g. Integration=4")
+	cmd.Flags().Duration("rate-limiter-base-delay", 0, "The delay before retrying a failed reconcile, doubled on each consecutive failure")
+	cmd.Flags().Duration("rate-limiter-max-delay", 0, "The maximum delay before retrying a failed reconcile")
+	cmd.Flags().Float64("rate-limiter-qps", 0, "The overall rate of reconciles per second of each controller")
+	cmd.Flags().Int("rate-limiter-burst", 0, "The overall burst of reconciles of each controller")
+	cmd.Flags().Duration("sync-period", 0, "The period after which all the watched resources are reconciled again")
+
 	// Operator settings
 	cmd.Flags().StringArray("toleration", nil, "Add a Toleration to the operator Pod")
 	cmd.Flags().StringArray("node-selector", nil, "Add a NodeSelector to the operator Pod")
@@ -182,6 +196,7 @@ type installCmdOptions struct {
 	BuildPublishStrategy        string   `mapstructure:"build-publish-strategy"`
 	BuildPublishStrategyOptions []string `mapstructure:"build-publish-strategy-options"`
 	BuildTimeout                string   `mapstructure:"build-timeout"`
+	MaxRunningBuilds            int32    `mapstructure:"max-running-builds"`
 	MavenExtensions             []string `mapstructure:"maven-extensions"`
 	MavenLocalRepository        string   `mapstructure:"maven-local-repository"`
 	MavenProperties             []string `mapstructure:"maven-properties"`
@@ -192,12 +207,22 @@ type installCmdOptions struct {
 	HealthPort                  int32    `mapstructure:"health-port"`
 	Monitoring                  bool     `mapstructure:"monitoring"`
 	MonitoringPort              int32    `mapstructure:"monitoring-port"`
-	TraitProfile                string   `mapstructure:"trait-profile"`
-	Tolerations                 []string `mapstructure:"tolerations"`
-	NodeSelectors               []string `mapstructure:"node-selectors"`
-	ResourcesRequirements       []string `mapstructure:"operator-resources"`
-	LogLevel                    string   `mapstructure:"log-level"`
-	EnvVars                     []string `mapstructure:"operator-env-vars"`
+	Webhook                     bool     `mapstructure:"webhook"`
+	WebhookPort                 int32    `mapstructure:"webhook-port"`
+	// Controllers work queues
+	MaxConcurrentReconciles []string      `mapstructure:"max-concurrent-reconciles"`
+	RateLimiterBaseDelay    time.Duration `mapstructure:"rate-limiter-base-delay"`
+	RateLimiterMaxDelay     time.Duration `mapstructure:"rate-limiter-max-delay"`
+	RateLimiterQPS          float64       `mapstructure:"rate-limiter-qps"`
+	RateLimiterBurst        int           `mapstructure:"rate-limiter-burst"`
+	SyncPeriod              time.Duration `mapstructure:"sync-period"`
+	TraitProfile            string        `mapstructure:"trait-profile"`
+	Tolerations             []string      `mapstructure:"tolerations"`
+	NodeSelectors           []string      `mapstructure:"node-selectors"`
+	ResourcesRequirements   []string      `mapstructure:"operator-resources"`
+	LogLevel                string        `mapstructure:"log-level"`
+	WatchNamespaces         []string      `mapstructure:"watch-namespaces"`
+	EnvVars                 []string      `mapstructure:"operator-env-vars"`
 
 	registry         v1.RegistrySpec
 	registryAuth     registry.Auth
@@ -329,6 +354,10 @@ func (o *installCmdOptions) installOperator(cmd *cobra.Command, output *kubernet
 		platformName = platformutil.DefaultPlatformName
 	}
 
+	if olm && o.Webhook {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Warning: the validating admission webhooks are not installed via OLM")
+	}
+
 	// Set up operator
 	if !olm {
 		if !o.SkipOperatorSetup {
@@ -416,6 +445,7 @@ func (o *installCmdOptions) setupOperator(
 		CustomImagePullPolicy: o.OperatorImagePullPolicy,
 		Namespace:             namespace,
 		Global:                o.Global,
+		WatchNamespaces:       o.WatchNamespaces,
 		ClusterType:           o.ClusterType,
 		Health: install.OperatorHealthConfiguration{
 			Port: o.HealthPort,
@@ -424,6 +454,18 @@ func (o *installCmdOptions) setupOperator(
 			Enabled: o.Monitoring,
 			Port:    o.MonitoringPort,
 		},
+		Webhook: install.OperatorWebhookConfiguration{
+			Enabled: o.Webhook,
+			Port:    o.WebhookPort,
+		},
+		WorkQueue: install.OperatorWorkQueueConfiguration{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+			RateLimiterBaseDelay:    o.RateLimiterBaseDelay,
+			RateLimiterMaxDelay:     o.RateLimiterMaxDelay,
+			RateLimiterQPS:          o.RateLimiterQPS,
+			RateLimiterBurst:        o.RateLimiterBurst,
+			SyncPeriod:              o.SyncPeriod,
+		},
 		Tolerations:           o.Tolerations,
 		NodeSelectors:         o.NodeSelectors,
 		ResourcesRequirements: o.ResourcesRequirements,
@@ -530,6 +572,9 @@ func (o *installCmdOptions) setupIntegrationPlatform(
 			Duration: d,
 		}
 	}
+	if o.MaxRunningBuilds > 0 {
+		platform.Spec.Build.MaxRunningBuilds = &o.MaxRunningBuilds
+	}
 	if o.TraitProfile != "" {
 		platform.Spec.Profile = v1.TraitProfileByName(o.TraitProfile)
 	}
@@ -698,6 +743,14 @@ func (o *installCmdOptions) validate(_ *cobra.Command, _ []string) error {
 		result = multierr.Append(result, err)
 	}
 
+	if _, err := parseMaxConcurrentReconciles(o.MaxConcurrentReconciles); err != nil {
+		result = multierr.Append(result, err)
+	}
+
+	if len(o.WatchNamespaces) > 0 && !o.Global {
+		result = multierr.Append(result, fmt.Errorf("the watch-namespaces option can only be used in combination with the global option"))
+	}
+
 	if o.TraitProfile != "" {
 		tp := v1.TraitProfileByName(o.TraitProfile)
 		if tp == v1.TraitProfile("") {
@@ -19,6 +19,7 @@ package cmd
 
 import (
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -115,6 +116,13 @@ func TestInstallBuildTimeoutFlag(t *testing.T) {
 	assert.Equal(t, "10", installCmdOptions.BuildTimeout)
 }
 
+func TestInstallMaxRunningBuildsFlag(t *testing.T) {
+	installCmdOptions, rootCmd, _ := initializeInstallCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdInstall, "--max-running-builds", "5")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(5), installCmdOptions.MaxRunningBuilds)
+}
+
 func TestInstallClusterSetupFlag(t *testing.T) {
 	installCmdOptions, rootCmd, _ := initializeInstallCmdOptions(t)
 	_, err := test.ExecuteCommand(rootCmd, cmdInstall, "--cluster-setup")
@@ -150,6 +158,17 @@ func TestInstallGlobalFlag(t *testing.T) {
 	assert.Equal(t, true, installCmdOptions.Global)
 }
 
+func TestInstallWatchNamespacesFlag(t *testing.T) {
+	installCmdOptions, rootCmd, _ := initializeInstallCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdInstall, "--global", "--watch-namespaces", "ns1,ns2")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ns1", "ns2"}, installCmdOptions.WatchNamespaces)
+	assert.Nil(t, installCmdOptions.validate(nil, nil))
+
+	installCmdOptions.Global = false
+	assert.NotNil(t, installCmdOptions.validate(nil, nil))
+}
+
 func TestInstallHealthFlag(t *testing.T) {
 	installCmdOptions, rootCmd, _ := initializeInstallCmdOptions(t)
 	_, err := test.ExecuteCommand(rootCmd, cmdInstall, "--health-port", "7777")
@@ -206,6 +225,42 @@ func TestInstallMonitoringFlag(t *testing.T) {
 	assert.Equal(t, int32(7777), installCmdOptions.MonitoringPort)
 }
 
+func TestInstallWebhookFlag(t *testing.T) {
+	installCmdOptions, rootCmd, _ := initializeInstallCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdInstall,
+		"--webhook",
+		"--webhook-port", "8443")
+	assert.Nil(t, err)
+	assert.Equal(t, true, installCmdOptions.Webhook)
+	assert.Equal(t, int32(8443), installCmdOptions.WebhookPort)
+}
+
+func TestInstallWorkQueueFlags(t *testing.T) {
+	installCmdOptions, rootCmd, _ := initializeInstallCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdInstall,
+		"--max-concurrent-reconciles", "Integration=4",
+		"--max-concurrent-reconciles", "Build=2",
+		"--rate-limiter-base-delay", "10ms",
+		"--rate-limiter-max-delay", "5m",
+		"--rate-limiter-qps", "20",
+		"--rate-limiter-burst", "200",
+		"--sync-period", "1h")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Integration=4", "Build=2"}, installCmdOptions.MaxConcurrentReconciles)
+	assert.Equal(t, 10*time.Millisecond, installCmdOptions.RateLimiterBaseDelay)
+	assert.Equal(t, 5*time.Minute, installCmdOptions.RateLimiterMaxDelay)
+	assert.Equal(t, float64(20), installCmdOptions.RateLimiterQPS)
+	assert.Equal(t, 200, installCmdOptions.RateLimiterBurst)
+	assert.Equal(t, time.Hour, installCmdOptions.SyncPeriod)
+}
+
+func TestInstallInvalidMaxConcurrentReconciles(t *testing.T) {
+	installCmdOptions, rootCmd, _ := initializeInstallCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdInstall, "--max-concurrent-reconciles", "Integrations=4")
+	assert.Nil(t, err)
+	assert.NotNil(t, installCmdOptions.validate(nil, nil))
+}
+
 func TestInstallOlmFalseFlag(t *testing.T) {
 	installCmdOptions, rootCmd, _ := initializeInstallCmdOptions(t)
 	_, err := test.ExecuteCommand(rootCmd, cmdInstall, "--olm=false")
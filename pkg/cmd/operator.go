@@ -18,8 +18,17 @@ limitations under the License.
 package cmd
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
 	"github.com/apache/camel-k/pkg/platform"
+	"github.com/apache/camel-k/pkg/util"
 	"github.com/apache/camel-k/pkg/util/defaults"
+	"github.com/apache/camel-k/pkg/util/workqueue"
 	"github.com/spf13/cobra"
 
 	"github.com/apache/camel-k/pkg/cmd/operator"
@@ -36,13 +45,21 @@ func newCmdOperator() (*cobra.Command, *operatorCmdOptions) {
 		Long:    `Run the Camel K operator`,
 		Hidden:  true,
 		PreRunE: decode(&options),
-		Run:     options.run,
+		RunE:    options.run,
 	}
 
 	cmd.Flags().Int32("health-port", 8081, "The port of the health endpoint")
 	cmd.Flags().Int32("monitoring-port", 8080, "The port of the metrics endpoint")
 	cmd.Flags().Bool("leader-election", true, "Use leader election")
 	cmd.Flags().String("leader-election-id", "", "Use the given ID as the leader election Lease name")
+	cmd.Flags().Int32("webhook-port", 0, "The port of the validating admission webhooks, disabled when 0")
+	cmd.Flags().StringArray("max-concurrent-reconciles", nil, "The maximum number of concurrent reconciles of the controller of the given kind, e.g. Integration=4")
+	cmd.Flags().Duration("rate-limiter-base-delay", workqueue.DefaultBaseDelay, "The delay before retrying a failed reconcile, doubled on each consecutive failure")
+	cmd.Flags().Duration("rate-limiter-max-delay", workqueue.DefaultMaxDelay, "The maximum delay before retrying a failed reconcile")
+	cmd.Flags().Float64("rate-limiter-qps", workqueue.DefaultQPS, "The overall rate of reconciles per second of each controller")
+	cmd.Flags().Int("rate-limiter-burst", workqueue.DefaultBurst, "The overall burst of reconciles of each controller")
+	cmd.Flags().Duration("sync-period", 10*time.Hour, "The period after which all the watched resources are reconciled again")
+	cmd.Flags().String("webhook-cert-dir", "", "The directory containing the tls.crt and tls.key files of the validating admission webhooks")
 
 	return &cmd, &options
 }
@@ -52,9 +69,22 @@ type operatorCmdOptions struct {
 	MonitoringPort   int32  `mapstructure:"monitoring-port"`
 	LeaderElection   bool   `mapstructure:"leader-election"`
 	LeaderElectionID string `mapstructure:"leader-election-id"`
+	WebhookPort      int32  `mapstructure:"webhook-port"`
+	WebhookCertDir   string `mapstructure:"webhook-cert-dir"`
+	// Work queues tuning
+	MaxConcurrentReconciles []string      `mapstructure:"max-concurrent-reconciles"`
+	RateLimiterBaseDelay    time.Duration `mapstructure:"rate-limiter-base-delay"`
+	RateLimiterMaxDelay     time.Duration `mapstructure:"rate-limiter-max-delay"`
+	RateLimiterQPS          float64       `mapstructure:"rate-limiter-qps"`
+	RateLimiterBurst        int           `mapstructure:"rate-limiter-burst"`
+	SyncPeriod              time.Duration `mapstructure:"sync-period"`
 }
 
-func (o *operatorCmdOptions) run(_ *cobra.Command, _ []string) {
+func (o *operatorCmdOptions) run(_ *cobra.Command, _ []string) error {
+	maxConcurrentReconciles, err := parseMaxConcurrentReconciles(o.MaxConcurrentReconciles)
+	if err != nil {
+		return err
+	}
 
 	leaderElectionID := o.LeaderElectionID
 	if leaderElectionID == "" {
@@ -65,5 +95,39 @@ func (o *operatorCmdOptions) run(_ *cobra.Command, _ []string) {
 		}
 	}
 
-	operator.Run(o.HealthPort, o.MonitoringPort, o.LeaderElection, leaderElectionID)
+	queue := workqueue.Options{
+		MaxConcurrentReconciles: maxConcurrentReconciles,
+		BaseDelay:               o.RateLimiterBaseDelay,
+		MaxDelay:                o.RateLimiterMaxDelay,
+		QPS:                     o.RateLimiterQPS,
+		Burst:                   o.RateLimiterBurst,
+	}
+
+	operator.Run(o.HealthPort, o.MonitoringPort, o.LeaderElection, leaderElectionID, o.WebhookPort, o.WebhookCertDir, o.SyncPeriod, queue)
+	return nil
+}
+
+// The kinds of the resources reconciled by the operator controllers.
+var reconciledKinds = []string{
+	v1.BuildKind, v1.IntegrationKind, v1.IntegrationKitKind, v1.IntegrationPlatformKind,
+	v1alpha1.KameletKind, v1alpha1.KameletBindingKind,
+}
+
+func parseMaxConcurrentReconciles(values []string) (map[string]int, error) {
+	maxConcurrentReconciles := make(map[string]int, len(values))
+	for _, value := range values {
+		kind, count, ok := strings.Cut(value, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid max concurrent reconciles %q, expected <kind>=<count>", value)
+		}
+		if !util.StringSliceExists(reconciledKinds, kind) {
+			return nil, fmt.Errorf("invalid max concurrent reconciles %q, the kind must be one of %s", value, strings.Join(reconciledKinds, ", "))
+		}
+		n, err := strconv.Atoi(count)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid max concurrent reconciles %q, the count must be a positive integer", value)
+		}
+		maxConcurrentReconciles[kind] = n
+	}
+	return maxConcurrentReconciles, nil
 }
@@ -22,6 +22,7 @@ import (
 	"flag"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"os"
 	"reflect"
 	"runtime"
@@ -44,6 +45,7 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
@@ -66,13 +68,19 @@ import (
 	"github.com/apache/camel-k/pkg/event"
 	"github.com/apache/camel-k/pkg/install"
 	"github.com/apache/camel-k/pkg/platform"
+	"github.com/apache/camel-k/pkg/util"
 	"github.com/apache/camel-k/pkg/util/defaults"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
 	logutil "github.com/apache/camel-k/pkg/util/log"
+	"github.com/apache/camel-k/pkg/util/workqueue"
+	"github.com/apache/camel-k/pkg/webhook"
 )
 
 var log = logutil.Log.WithName("cmd")
 
+// leaderCheck is the name of the readiness check reporting the leadership.
+const leaderCheck = "leader"
+
 func printVersion() {
 	log.Info(fmt.Sprintf("Go Version: %s", runtime.Version()))
 	log.Info(fmt.Sprintf("Go OS/Arch: %s/%s", runtime.GOOS, runtime.GOARCH))
@@ -86,7 +94,7 @@ func printVersion() {
 }
 
 // Run starts the Camel K operator.
-func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID string) {
+func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID string, webhookPort int32, webhookCertDir string, syncPeriod time.Duration, queue workqueue.Options) {
 	rand.Seed(time.Now().UTC().UnixNano())
 
 	flag.Parse()
@@ -153,7 +161,7 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 	broadcaster := record.NewBroadcaster()
 	defer broadcaster.Shutdown()
 
-	if ok, err := kubernetes.CheckPermission(ctx, bootstrapClient, corev1.GroupName, "events", watchNamespace, "", "create"); err != nil || !ok {
+	if ok, err := platform.CheckPermissionInWatchNamespaces(ctx, bootstrapClient, corev1.GroupName, "events", "create"); err != nil || !ok {
 		// Do not sink Events to the server as they'll be rejected
 		broadcaster = event.NewSinkLessBroadcaster(broadcaster)
 		exitOnError(err, "cannot check permissions for creating Events")
@@ -165,7 +173,9 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 		// Fallback to using the watch namespace when the operator is not in-cluster.
 		// It does not support local (off-cluster) operator watching resources globally,
 		// in which case it's not possible to determine a namespace.
-		operatorNamespace = watchNamespace
+		if namespaces := platform.GetOperatorWatchNamespaces(); len(namespaces) == 1 {
+			operatorNamespace = namespaces[0]
+		}
 		if operatorNamespace == "" {
 			leaderElection = false
 			log.Info("unable to determine namespace for leader election")
@@ -191,10 +201,11 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 	selector := labels.NewSelector().Add(*hasIntegrationLabel)
 
 	selectors := cache.SelectorsByObject{
-		&corev1.Pod{}:        {Label: selector},
-		&appsv1.Deployment{}: {Label: selector},
-		&batchv1.Job{}:       {Label: selector},
-		&servingv1.Service{}: {Label: selector},
+		&corev1.Pod{}:         {Label: selector},
+		&appsv1.Deployment{}:  {Label: selector},
+		&appsv1.StatefulSet{}: {Label: selector},
+		&batchv1.Job{}:        {Label: selector},
+		&servingv1.Service{}:  {Label: selector},
 	}
 
 	if ok, err := kubernetes.IsAPIResourceInstalled(bootstrapClient, batchv1.SchemeGroupVersion.String(), reflect.TypeOf(batchv1.CronJob{}).Name()); ok && err == nil {
@@ -206,8 +217,18 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 		}
 	}
 
+	managerNamespace, namespaces := getManagerNamespaces(operatorNamespace)
+	newCache := cache.BuilderWithOptions(cache.Options{SelectorsByObject: selectors})
+	if len(namespaces) > 1 {
+		// Restrict the cache to the list of watched namespaces
+		newCache = func(config *rest.Config, opts cache.Options) (cache.Cache, error) {
+			opts.SelectorsByObject = selectors
+			return cache.MultiNamespacedCacheBuilder(namespaces)(config, opts)
+		}
+	}
+
 	mgr, err := manager.New(cfg, manager.Options{
-		Namespace:                     watchNamespace,
+		Namespace:                     managerNamespace,
 		EventBroadcaster:              broadcaster,
 		LeaderElection:                leaderElection,
 		LeaderElectionNamespace:       operatorNamespace,
@@ -216,11 +237,10 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 		LeaderElectionReleaseOnCancel: true,
 		HealthProbeBindAddress:        ":" + strconv.Itoa(int(healthPort)),
 		MetricsBindAddress:            ":" + strconv.Itoa(int(monitoringPort)),
-		NewCache: cache.BuilderWithOptions(
-			cache.Options{
-				SelectorsByObject: selectors,
-			},
-		),
+		Port:                          int(webhookPort),
+		CertDir:                       webhookCertDir,
+		SyncPeriod:                    &syncPeriod,
+		NewCache:                      newCache,
 	})
 	exitOnError(err, "")
 
@@ -235,10 +255,19 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 
 	log.Info("Configuring manager")
 	exitOnError(mgr.AddHealthzCheck("health-probe", healthz.Ping), "Unable add liveness check")
+	exitOnError(mgr.AddReadyzCheck("readiness-probe", healthz.Ping), "Unable add readiness check")
+	exitOnError(mgr.AddReadyzCheck(leaderCheck, leaderElectionCheck(mgr)), "Unable add leader election check")
+	if webhookPort > 0 {
+		exitOnError(mgr.AddReadyzCheck("webhook", mgr.GetWebhookServer().StartedChecker()), "Unable add webhook readiness check")
+	}
+	go reportLeadership(mgr)
 	exitOnError(apis.AddToScheme(mgr.GetScheme()), "")
 	ctrlClient, err := client.FromManager(mgr)
 	exitOnError(err, "")
-	exitOnError(controller.AddToManager(mgr, ctrlClient), "")
+	exitOnError(controller.AddToManager(mgr, ctrlClient, queue), "")
+	if webhookPort > 0 {
+		exitOnError(webhook.AddToManager(mgr, ctrlClient), "")
+	}
 
 	log.Info("Installing operator resources")
 	installCtx, installCancel := context.WithTimeout(ctx, 1*time.Minute)
@@ -250,6 +279,27 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 	exitOnError(mgr.Start(ctx), "manager exited non-zero")
 }
 
+// leaderElectionCheck reports whether the operator holds the leadership, at the /readyz/leader endpoint.
+// The check is excluded from the readiness probe of the operator pods, with /readyz?exclude=leader,
+// so that the standby replicas are available, and don't block the rolling updates.
+// The manager Elected channel is closed right away when leader election is disabled.
+func leaderElectionCheck(mgr manager.Manager) healthz.Checker {
+	return func(_ *http.Request) error {
+		select {
+		case <-mgr.Elected():
+			return nil
+		default:
+			return errors.New("waiting for the leadership")
+		}
+	}
+}
+
+// reportLeadership sets the leader metric once the operator holds the leadership.
+func reportLeadership(mgr manager.Manager) {
+	<-mgr.Elected()
+	leader.Set(1)
+}
+
 // findOrCreateIntegrationPlatform create default integration platform in operator namespace if not already exists.
 func findOrCreateIntegrationPlatform(ctx context.Context, c client.Client, operatorNamespace string) error {
 	var platformName string
@@ -290,6 +340,24 @@ func getWatchNamespace() (string, error) {
 	return ns, nil
 }
 
+// getManagerNamespaces returns the namespace the manager is scoped to, and the list of namespaces the manager cache
+// is restricted to, when the operator watches more than one namespace, in which case the manager is not scoped.
+// The operator namespace is always watched, as it holds the operator resources, e.g. the default IntegrationPlatform.
+// The manager is not scoped when the operator watches all namespaces.
+func getManagerNamespaces(operatorNamespace string) (string, []string) {
+	namespaces := platform.GetOperatorWatchNamespaces()
+	if len(namespaces) == 0 {
+		return "", nil
+	}
+	if operatorNamespace != "" {
+		util.StringSliceUniqueAdd(&namespaces, operatorNamespace)
+	}
+	if len(namespaces) == 1 {
+		return namespaces[0], namespaces
+	}
+	return "", namespaces
+}
+
 // getOperatorImage returns the image currently used by the running operator if present (when running out of cluster, it may be absent).
 func getOperatorImage(ctx context.Context, c ctrl.Reader) (string, error) {
 	ns := platform.GetOperatorNamespace()
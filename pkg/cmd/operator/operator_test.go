@@ -0,0 +1,50 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/camel-k/pkg/platform"
+)
+
+func TestGetManagerNamespaces(t *testing.T) {
+	testCases := []struct {
+		watchNamespace    string
+		operatorNamespace string
+		managerNamespace  string
+		namespaces        []string
+	}{
+		{watchNamespace: "", operatorNamespace: "camel-k", managerNamespace: ""},
+		{watchNamespace: "ns1", operatorNamespace: "ns1", managerNamespace: "ns1", namespaces: []string{"ns1"}},
+		{watchNamespace: "ns1,", operatorNamespace: "ns1", managerNamespace: "ns1", namespaces: []string{"ns1"}},
+		{watchNamespace: " ns1, ", operatorNamespace: "", managerNamespace: "ns1", namespaces: []string{"ns1"}},
+		{watchNamespace: "ns1", operatorNamespace: "camel-k", managerNamespace: "", namespaces: []string{"ns1", "camel-k"}},
+		{watchNamespace: "ns1, ns2", operatorNamespace: "ns2", managerNamespace: "", namespaces: []string{"ns1", "ns2"}},
+	}
+
+	for _, tc := range testCases {
+		t.Setenv(platform.OperatorWatchNamespaceEnvVariable, tc.watchNamespace)
+
+		managerNamespace, namespaces := getManagerNamespaces(tc.operatorNamespace)
+		assert.Equal(t, tc.managerNamespace, managerNamespace, tc.watchNamespace)
+		assert.Equal(t, tc.namespaces, namespaces, tc.watchNamespace)
+	}
+}
@@ -19,6 +19,7 @@ package cmd
 
 import (
 	"testing"
+	"time"
 
 	"github.com/apache/camel-k/pkg/util/test"
 	"github.com/spf13/cobra"
@@ -82,3 +83,31 @@ func TestOperatorMonitoringPortFlag(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, int32(7172), operatorCmdOptions.MonitoringPort)
 }
+
+func TestOperatorWorkQueueFlags(t *testing.T) {
+	operatorCmdOptions, rootCmd, _ := initializeOperatorCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdOperator,
+		"--max-concurrent-reconciles", "Integration=4",
+		"--max-concurrent-reconciles", "Build=2",
+		"--rate-limiter-base-delay", "1s",
+		"--rate-limiter-qps", "5",
+		"--sync-period", "1h")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Integration=4", "Build=2"}, operatorCmdOptions.MaxConcurrentReconciles)
+	assert.Equal(t, time.Second, operatorCmdOptions.RateLimiterBaseDelay)
+	assert.Equal(t, 5.0, operatorCmdOptions.RateLimiterQPS)
+	assert.Equal(t, time.Hour, operatorCmdOptions.SyncPeriod)
+
+	maxConcurrentReconciles, err := parseMaxConcurrentReconciles(operatorCmdOptions.MaxConcurrentReconciles)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{"Integration": 4, "Build": 2}, maxConcurrentReconciles)
+}
+
+func TestOperatorInvalidMaxConcurrentReconciles(t *testing.T) {
+	_, err := parseMaxConcurrentReconciles([]string{"Integration"})
+	assert.NotNil(t, err)
+	_, err = parseMaxConcurrentReconciles([]string{"Integration=0"})
+	assert.NotNil(t, err)
+	_, err = parseMaxConcurrentReconciles([]string{"Integrations=4"})
+	assert.NotNil(t, err)
+}
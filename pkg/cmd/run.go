@@ -114,6 +114,7 @@ func newCmdRun(rootCmdOptions *RootCmdOptions) (*cobra.Command, *runCmdOptions)
 	cmd.Flags().String("profile", "", "Trait profile used for deployment")
 	cmd.Flags().StringArrayP("trait", "t", nil, "Configure a trait. E.g. \"-t service.enabled=false\"")
 	cmd.Flags().StringP("output", "o", "", "Output format. One of: json|yaml")
+	cmd.Flags().String("dry-run", "", "Print the changes the operator would apply to the integration resources, without applying them. One of: diff")
 	cmd.Flags().Bool("compression", false, "Enable storage of sources and resources as a compressed binary blobs")
 	cmd.Flags().StringArray("open-api", nil, "Add an OpenAPI spec (syntax: [configmap|file]:name)")
 	cmd.Flags().StringArrayP("volume", "v", nil, "Mount a volume into the integration container. E.g \"-v pvcname:/container/path\"")
@@ -146,6 +147,7 @@ type runCmdOptions struct {
 	Profile         string   `mapstructure:"profile" yaml:",omitempty"`
 	OperatorID      string   `mapstructure:"operator-id" yaml:",omitempty"`
 	OutputFormat    string   `mapstructure:"output" yaml:",omitempty"`
+	DryRun          string   `mapstructure:"dry-run" yaml:",omitempty" kamel:"omitsave"`
 	PodTemplate     string   `mapstructure:"pod-template" yaml:",omitempty"`
 	Connects        []string `mapstructure:"connects" yaml:",omitempty"`
 	Resources       []string `mapstructure:"resources" yaml:",omitempty"`
@@ -270,6 +272,15 @@ func (o *runCmdOptions) validate() error {
 		return fmt.Errorf("cannot use --dev with -o/--output option")
 	}
 
+	if o.DryRun != "" {
+		if o.DryRun != dryRunDiff {
+			return fmt.Errorf(`invalid dry-run mode %q, expected "%s"`, o.DryRun, dryRunDiff)
+		}
+		if o.OutputFormat != "" || o.Dev || o.Sync || o.Logs || o.Wait {
+			return fmt.Errorf("cannot use --dry-run with -o/--output, --dev, --sync, --logs or --wait options")
+		}
+	}
+
 	for _, label := range o.Labels {
 		parts := strings.Split(label, "=")
 		if len(parts) != 2 {
@@ -555,6 +566,10 @@ func (o *runCmdOptions) createOrUpdateIntegration(cmd *cobra.Command, c client.C
 		return nil, showIntegrationOutput(cmd, integration, o.OutputFormat, c.GetScheme())
 	}
 
+	if o.DryRun != "" {
+		return nil, showIntegrationDiff(o.Context, cmd, c, integration)
+	}
+
 	if existing == nil {
 		err = c.Create(o.Context, integration)
 		fmt.Fprintln(cmd.OutOrStdout(), `Integration "`+name+`" created`)
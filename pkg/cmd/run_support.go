@@ -32,12 +32,25 @@ import (
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/client"
 	"github.com/apache/camel-k/pkg/cmd/source"
+	"github.com/apache/camel-k/pkg/trait"
 	"github.com/apache/camel-k/pkg/util/camel"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/apache/camel-k/pkg/util/patch"
 	"github.com/apache/camel-k/pkg/util/resource"
 	"github.com/magiconair/properties"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// dryRunDiff prints the differences between the live resources and the ones the operator would apply.
+	dryRunDiff = "diff"
+	// dryRunIntegrationUID is set on the integrations that are not created yet, as the owner references require a UID.
+	dryRunIntegrationUID = "dry-run"
 )
 
 func addDependency(cmd *cobra.Command, it *v1.Integration, dependency string, catalog *camel.RuntimeCatalog) {
@@ -206,3 +219,85 @@ func downloadDependency(ctx context.Context, url url.URL) (string, error) {
 	}
 	return out.Name(), nil
 }
+
+// showIntegrationDiff prints the differences between the live resources of the integration and the resources
+// the operator would apply, as returned by the API server in dry-run mode. Nothing is applied.
+func showIntegrationDiff(ctx context.Context, cmd *cobra.Command, c client.Client, integration *v1.Integration) error {
+	it := integration.DeepCopy()
+	if it.UID == "" {
+		it.UID = dryRunIntegrationUID
+	}
+	resources, err := trait.DryRun(ctx, c, it)
+	if err != nil {
+		return err
+	}
+
+	changes := false
+	for _, resource := range resources.Items() {
+		target, err := patch.ApplyPatch(resource)
+		if err != nil {
+			return err
+		}
+		kind := resource.GetObjectKind().GroupVersionKind().Kind
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(resource.GetObjectKind().GroupVersionKind())
+		if err := c.Get(ctx, ctrl.ObjectKeyFromObject(resource), live); k8serrors.IsNotFound(err) {
+			live = nil
+		} else if err != nil {
+			return fmt.Errorf("unable to get %s %s: %w", kind, resource.GetName(), err)
+		}
+		err = c.Patch(ctx, target, ctrl.Apply, ctrl.ForceOwnership, ctrl.FieldOwner("camel-k-operator"), ctrl.DryRunAll)
+		if err != nil {
+			return fmt.Errorf("error during dry-run apply of %s %s: %w", kind, resource.GetName(), err)
+		}
+		diff, err := resourceDiff(kind, live, target)
+		if err != nil {
+			return err
+		}
+		if diff != "" {
+			changes = true
+			fmt.Fprint(cmd.OutOrStdout(), diff)
+		}
+	}
+	if !changes {
+		fmt.Fprintln(cmd.OutOrStdout(), `No changes to the resources of integration "`+integration.Name+`"`)
+	}
+
+	return nil
+}
+
+// resourceDiff returns the unified diff between the YAML representations of the live and merged resources,
+// the live resource being nil when it does not exist. The fields maintained by the API server are ignored.
+func resourceDiff(kind string, live *unstructured.Unstructured, merged *unstructured.Unstructured) (string, error) {
+	toYAMLLines := func(u *unstructured.Unstructured) ([]string, error) {
+		if u == nil {
+			return nil, nil
+		}
+		u = u.DeepCopy()
+		u.SetManagedFields(nil)
+		u.SetResourceVersion("")
+		u.SetGeneration(0)
+		data, err := kubernetes.ToYAML(u)
+		if err != nil {
+			return nil, err
+		}
+		return difflib.SplitLines(string(data)), nil
+	}
+
+	a, err := toYAMLLines(live)
+	if err != nil {
+		return "", err
+	}
+	b, err := toYAMLLines(merged)
+	if err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("%s/%s", kind, merged.GetName())
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        a,
+		B:        b,
+		FromFile: "live/" + name,
+		ToFile:   "merged/" + name,
+		Context:  3,
+	})
+}
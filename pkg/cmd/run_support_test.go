@@ -27,6 +27,7 @@ import (
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/cmd/source"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func TestRunBinaryResource(t *testing.T) {
@@ -124,3 +125,44 @@ func TestDownloadDependencyWithoutFileName(t *testing.T) {
 	_, err = os.Stat(path)
 	assert.Nil(t, err)
 }
+
+func TestResourceDiff(t *testing.T) {
+	live := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata": map[string]interface{}{
+			"name":            "my-it",
+			"resourceVersion": "1",
+		},
+		"spec": map[string]interface{}{
+			"type": "ClusterIP",
+		},
+	}}
+	merged := live.DeepCopy()
+	merged.SetResourceVersion("2")
+
+	diff, err := resourceDiff("Service", live, merged)
+	assert.Nil(t, err)
+	assert.Equal(t, "", diff)
+
+	assert.Nil(t, unstructured.SetNestedField(merged.Object, "NodePort", "spec", "type"))
+	diff, err = resourceDiff("Service", live, merged)
+	assert.Nil(t, err)
+	assert.Contains(t, diff, "--- live/Service/my-it\n+++ merged/Service/my-it\n")
+	assert.Contains(t, diff, "-  type: ClusterIP\n+  type: NodePort\n")
+}
+
+func TestResourceDiffWithNewResource(t *testing.T) {
+	merged := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata": map[string]interface{}{
+			"name": "my-it",
+		},
+	}}
+
+	diff, err := resourceDiff("Service", nil, merged)
+	assert.Nil(t, err)
+	assert.Contains(t, diff, "+kind: Service\n")
+	assert.NotContains(t, diff, "\n-")
+}
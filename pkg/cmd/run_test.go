@@ -146,6 +146,27 @@ func TestRunDevModeOutputFlag(t *testing.T) {
 		err.Error())
 }
 
+func TestRunDryRunFlag(t *testing.T) {
+	runCmdOptions, rootCmd, _ := initializeRunCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdRun, "--dry-run", "diff", integrationSource)
+	assert.Nil(t, err)
+	assert.Equal(t, "diff", runCmdOptions.DryRun)
+}
+
+func TestRunDryRunInvalidModeFlag(t *testing.T) {
+	_, rootCmd, _ := initializeRunCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdRun, "--dry-run", "server", integrationSource)
+	assert.NotNil(t, err)
+	assert.Equal(t, `invalid dry-run mode "server", expected "diff"`, err.Error())
+}
+
+func TestRunDryRunWaitFlag(t *testing.T) {
+	_, rootCmd, _ := initializeRunCmdOptions(t)
+	_, err := test.ExecuteCommand(rootCmd, cmdRun, "--dry-run", "diff", "--wait", integrationSource)
+	assert.NotNil(t, err)
+	assert.Equal(t, "cannot use --dry-run with -o/--output, --dev, --sync, --logs or --wait options", err.Error())
+}
+
 func TestRunEnvFlag(t *testing.T) {
 	runCmdOptions, rootCmd, _ := initializeRunCmdOptions(t)
 	_, err := test.ExecuteCommand(rootCmd, cmdRun,
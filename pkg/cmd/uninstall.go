@@ -35,6 +35,7 @@ import (
 
 	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
 	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/install"
 	"github.com/apache/camel-k/pkg/util/olm"
 )
 
@@ -184,6 +185,21 @@ func (o *uninstallCmdOptions) uninstallOperator(ctx context.Context, c client.Cl
 		}
 	}
 
+	return o.uninstallWebhooks(ctx, c)
+}
+
+// uninstallWebhooks removes the validating admission webhooks of the operator, if installed.
+func (o *uninstallCmdOptions) uninstallWebhooks(ctx context.Context, c client.Client) error {
+	err := c.AdmissionregistrationV1().ValidatingWebhookConfigurations().Delete(ctx, install.WebhookConfigurationName(o.Namespace), metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	err = c.CoreV1().Services(o.Namespace).Delete(ctx, install.WebhookServiceName, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
 	return nil
 }
 
@@ -30,6 +30,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
@@ -37,12 +38,16 @@ import (
 	camelevent "github.com/apache/camel-k/pkg/event"
 	"github.com/apache/camel-k/pkg/platform"
 	"github.com/apache/camel-k/pkg/util/monitoring"
+	"github.com/apache/camel-k/pkg/util/workqueue"
 )
 
 // Add creates a new Build Controller and adds it to the Manager. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
-func Add(mgr manager.Manager, c client.Client) error {
-	return add(mgr, newReconciler(mgr, c))
+func Add(mgr manager.Manager, c client.Client, options workqueue.Options) error {
+	if err := metrics.Registry.Register(&buildQueueCollector{reader: c}); err != nil {
+		return err
+	}
+	return add(mgr, newReconciler(mgr, c), options)
 }
 
 func newReconciler(mgr manager.Manager, c client.Client) reconcile.Reconciler {
@@ -61,9 +66,10 @@ func newReconciler(mgr manager.Manager, c client.Client) reconcile.Reconciler {
 	)
 }
 
-func add(mgr manager.Manager, r reconcile.Reconciler) error {
+func add(mgr manager.Manager, r reconcile.Reconciler, options workqueue.Options) error {
 	return builder.ControllerManagedBy(mgr).
 		Named("build-controller").
+		WithOptions(options.ControllerOptions(v1.BuildKind, r)).
 		// Watch for changes to primary resource Build
 		For(&v1.Build{}, builder.WithPredicates(
 			platform.FilteringFuncs{
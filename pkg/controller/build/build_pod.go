@@ -30,6 +30,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
@@ -42,6 +43,8 @@ import (
 const (
 	builderDir    = "/builder"
 	builderVolume = "camel-k-builder"
+	// The UID of the non-root user provided by the Buildah images, used by default when rootless
+	buildahRootlessUser = 1000
 )
 
 type registryConfigMap struct {
@@ -246,34 +249,64 @@ func addBuildahTaskToPod(ctx context.Context, c ctrl.Reader, build *v1.Build, ta
 		"--storage-driver=vfs",
 	}
 
-	if task.Platform != "" {
+	var push []string
+	// The index of the push command options
+	pushOptions := 2
+
+	if len(task.Platforms) > 0 {
+		// Build the image for each platform, and assemble them into a manifest list
 		bud = append(bud, []string{
 			"--platform",
-			task.Platform,
+			strings.Join(task.Platforms, ","),
+			"--pull-always",
+			"-f",
+			"Dockerfile",
+			"--manifest",
+			task.Image,
+			".",
 		}...)
-	}
 
-	bud = append(bud, []string{
-		"--pull-always",
-		"-f",
-		"Dockerfile",
-		"-t",
-		task.Image,
-		".",
-	}...)
+		push = []string{
+			"buildah",
+			"manifest",
+			"push",
+			"--storage-driver=vfs",
+			"--all",
+			"--digestfile=/dev/termination-log",
+			task.Image,
+			"docker://" + task.Image,
+		}
+		pushOptions = 3
+	} else {
+		if task.Platform != "" {
+			bud = append(bud, []string{
+				"--platform",
+				task.Platform,
+			}...)
+		}
 
-	push := []string{
-		"buildah",
-		"push",
-		"--storage-driver=vfs",
-		"--digestfile=/dev/termination-log",
-		task.Image,
-		"docker://" + task.Image,
+		bud = append(bud, []string{
+			"--pull-always",
+			"-f",
+			"Dockerfile",
+			"-t",
+			task.Image,
+			".",
+		}...)
+
+		push = []string{
+			"buildah",
+			"push",
+			"--storage-driver=vfs",
+			"--digestfile=/dev/termination-log",
+			task.Image,
+			"docker://" + task.Image,
+		}
 	}
 
 	if task.Verbose != nil && *task.Verbose {
 		bud = append(bud[:2], append([]string{"--log-level=debug"}, bud[2:]...)...)
-		push = append(push[:2], append([]string{"--log-level=debug"}, push[2:]...)...)
+		push = append(push[:pushOptions], append([]string{"--log-level=debug"}, push[pushOptions:]...)...)
 	}
 
 	env := make([]corev1.EnvVar, 0)
@@ -289,7 +322,7 @@ func addBuildahTaskToPod(ctx context.Context, c ctrl.Reader, build *v1.Build, ta
 		// This is easier to use the --cert-dir option, otherwise Buildah defaults to looking up certificates
 		// into a directory named after the registry address
 		bud = append(bud[:2], append([]string{"--cert-dir=/etc/containers/certs.d"}, bud[2:]...)...)
-		push = append(push[:2], append([]string{"--cert-dir=/etc/containers/certs.d"}, push[2:]...)...)
+		push = append(push[:pushOptions], append([]string{"--cert-dir=/etc/containers/certs.d"}, push[pushOptions:]...)...)
 	}
 
 	var auth string
@@ -311,7 +344,26 @@ func addBuildahTaskToPod(ctx context.Context, c ctrl.Reader, build *v1.Build, ta
 
 	if task.Registry.Insecure {
 		bud = append(bud[:2], append([]string{"--tls-verify=false"}, bud[2:]...)...)
-		push = append(push[:2], append([]string{"--tls-verify=false"}, push[2:]...)...)
+		push = append(push[:pushOptions], append([]string{"--tls-verify=false"}, push[pushOptions:]...)...)
+	}
+
+	var securityContext *corev1.SecurityContext
+	if task.Rootless != nil && *task.Rootless {
+		// The default OCI isolation requires privileges that are not granted to non-root users
+		env = append(env, corev1.EnvVar{
+			Name:  "BUILDAH_ISOLATION",
+			Value: "chroot",
+		})
+		user := pointer.Int64Deref(task.RunAsUser, buildahRootlessUser)
+		securityContext = &corev1.SecurityContext{
+			RunAsUser:    pointer.Int64(user),
+			RunAsNonRoot: pointer.Bool(true),
+		}
+		// The shared volumes, e.g. the builder volume holding the build context, must be writable by the non-root user
+		if pod.Spec.SecurityContext == nil {
+			pod.Spec.SecurityContext = &corev1.PodSecurityContext{}
+		}
+		pod.Spec.SecurityContext.FSGroup = pointer.Int64(user)
 	}
 
 	env = append(env, proxyFromEnvironment()...)
@@ -338,6 +390,7 @@ func addBuildahTaskToPod(ctx context.Context, c ctrl.Reader, build *v1.Build, ta
 		Env:             env,
 		WorkingDir:      filepath.Join(builderDir, build.Name, builder.ContextDir),
 		VolumeMounts:    volumeMounts,
+		SecurityContext: securityContext,
 	}
 
 	pod.Spec.Volumes = append(pod.Spec.Volumes, volumes...)
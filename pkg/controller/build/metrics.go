@@ -18,14 +18,17 @@ limitations under the License.
 package build
 
 import (
+	"context"
 	"math"
 	"time"
 
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/prometheus/client_golang/prometheus"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/platform"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -84,6 +87,42 @@ var (
 	)
 )
 
+var buildQueueLength = prometheus.NewDesc(
+	"camel_k_build_queue_length",
+	"Camel K builds waiting to be scheduled",
+	[]string{buildTypeLabel},
+	nil,
+)
+
+// buildQueueCollector reports the number of builds waiting in the scheduling phase,
+// listed from the informers cache when the metrics are scraped.
+type buildQueueCollector struct {
+	reader ctrl.Reader
+}
+
+func (c *buildQueueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- buildQueueLength
+}
+
+func (c *buildQueueCollector) Collect(ch chan<- prometheus.Metric) {
+	builds := &v1.BuildList{}
+	if err := c.reader.List(context.Background(), builds); err != nil {
+		Log.Error(err, "Unable to list the builds")
+		return
+	}
+
+	queued := make(map[string]int)
+	for i := range builds.Items {
+		build := &builds.Items[i]
+		if build.Status.Phase == v1.BuildPhaseScheduling && platform.IsOperatorHandler(build) {
+			queued[build.Labels[v1.IntegrationKitLayoutLabel]]++
+		}
+	}
+	for layout, length := range queued {
+		ch <- prometheus.MustNewConstMetric(buildQueueLength, prometheus.GaugeValue, float64(length), layout)
+	}
+}
+
 func init() {
 	// Register custom metrics with the global prometheus registry
 	metrics.Registry.MustRegister(buildDuration, buildRecovery, queueDuration)
@@ -91,6 +91,8 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *v1.Build) (*v
 		}
 	}
 
+	action.setTaskStatuses(build, pod)
+
 	switch pod.Status.Phase {
 
 	case corev1.PodPending, corev1.PodRunning:
@@ -262,6 +264,43 @@ func (action *monitorPodAction) patchPod(ctx context.Context, pod *corev1.Pod, m
 	return nil
 }
 
+// setTaskStatuses reports the status of each Build task, from the status of the Pod container that executes it.
+func (action *monitorPodAction) setTaskStatuses(build *v1.Build, pod *corev1.Pod) {
+	var containers []corev1.ContainerStatus
+	containers = append(containers, pod.Status.InitContainerStatuses...)
+	containers = append(containers, pod.Status.ContainerStatuses...)
+
+	for _, task := range build.Spec.Tasks {
+		status := v1.TaskStatus{
+			Name:  task.GetName(),
+			Phase: v1.BuildPhasePending,
+		}
+		for _, container := range containers {
+			if container.Name != status.Name {
+				continue
+			}
+			if r := container.State.Running; r != nil {
+				status.Phase = v1.BuildPhaseRunning
+				status.StartedAt = r.StartedAt.DeepCopy()
+			} else if t := container.State.Terminated; t != nil {
+				status.Phase = v1.BuildPhaseSucceeded
+				if t.ExitCode != 0 {
+					status.Phase = v1.BuildPhaseFailed
+					status.Error = t.Message
+					if status.Error == "" {
+						status.Error = t.Reason
+					}
+				}
+				status.StartedAt = t.StartedAt.DeepCopy()
+				status.CompletedAt = t.FinishedAt.DeepCopy()
+			}
+
+			break
+		}
+		build.Status.SetTaskStatus(status)
+	}
+}
+
 func (action *monitorPodAction) getTerminatedTime(pod *corev1.Pod) metav1.Time {
 	var finishedAt metav1.Time
 
@@ -99,6 +99,7 @@ func (action *monitorRoutineAction) runBuild(ctx context.Context, build *v1.Buil
 	defer cancel()
 
 	status := v1.BuildStatus{}
+	tasks := make([]v1.TaskStatus, 0, len(build.Spec.Tasks))
 	buildDir := ""
 	Builder := builder.New(action.client)
 
@@ -147,12 +148,28 @@ tasks:
 			}
 
 			// Execute the task
+			startedAt := metav1.Now()
 			status = Builder.Build(build).Task(task).Do(ctxWithTimeout)
+			completedAt := metav1.Now()
 
 			lastTask := i == len(build.Spec.Tasks)-1
 			taskFailed := status.Phase == v1.BuildPhaseFailed ||
 				status.Phase == v1.BuildPhaseError ||
 				status.Phase == v1.BuildPhaseInterrupted
+
+			// Record the task status alongside the ones of the previous tasks
+			taskStatus := v1.TaskStatus{
+				Name:        task.GetName(),
+				Phase:       v1.BuildPhaseSucceeded,
+				StartedAt:   &startedAt,
+				CompletedAt: &completedAt,
+			}
+			if taskFailed {
+				taskStatus.Phase = status.Phase
+				taskStatus.Error = status.Error
+			}
+			tasks = append(tasks, taskStatus)
+			status.Tasks = tasks
 			if lastTask && !taskFailed {
 				status.Phase = v1.BuildPhaseSucceeded
 			}
@@ -60,7 +60,7 @@ func (action *errorRecoveryAction) Handle(ctx context.Context, build *v1.Build)
 			Time:   metav1.Now(),
 			Recovery: v1.FailureRecovery{
 				Attempt:    0,
-				AttemptMax: 5,
+				AttemptMax: build.Spec.GetMaxRecoveryAttempts(),
 			},
 		}
 		return build, nil
@@ -19,11 +19,10 @@ package build
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/apimachinery/pkg/selection"
 
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -32,6 +31,9 @@ import (
 	"github.com/apache/camel-k/pkg/util/kubernetes"
 )
 
+// scheduleLock serializes the scheduling of builds, across reconciliation requests.
+var scheduleLock sync.Mutex
+
 func newScheduleAction(reader ctrl.Reader) Action {
 	return &scheduleAction{
 		reader: reader,
@@ -40,7 +42,6 @@ func newScheduleAction(reader ctrl.Reader) Action {
 
 type scheduleAction struct {
 	baseAction
-	lock   sync.Mutex
 	reader ctrl.Reader
 }
 
@@ -57,45 +58,47 @@ func (action *scheduleAction) CanHandle(build *v1.Build) bool {
 // Handle handles the builds.
 func (action *scheduleAction) Handle(ctx context.Context, build *v1.Build) (*v1.Build, error) {
 	// Enter critical section
-	action.lock.Lock()
-	defer action.lock.Unlock()
-
-	layout := build.Labels[v1.IntegrationKitLayoutLabel]
-
-	// Native builds can be run in parallel, as incremental images is not applicable.
-	if layout == v1.IntegrationKitLayoutNative {
-		// Reset the Build status, and transition it to pending phase.
-		// This must be done in the critical section, rather than delegated to the controller.
-		return nil, action.toPendingPhase(ctx, build)
-	}
-
-	// We assume incremental images is only applicable across images whose layout is identical
-	withCompatibleLayout, err := labels.NewRequirement(v1.IntegrationKitLayoutLabel, selection.Equals, []string{layout})
-	if err != nil {
-		return nil, err
-	}
+	scheduleLock.Lock()
+	defer scheduleLock.Unlock()
 
 	builds := &v1.BuildList{}
 	// We use the non-caching client as informers cache is not invalidated nor updated
 	// atomically by write operations
-	err = action.reader.List(ctx, builds,
-		ctrl.InNamespace(build.Namespace),
-		ctrl.MatchingLabelsSelector{
-			Selector: labels.NewSelector().Add(*withCompatibleLayout),
-		})
+	err := action.reader.List(ctx, builds, ctrl.InNamespace(build.Namespace))
 	if err != nil {
 		return nil, err
 	}
 
-	// Emulate a serialized working queue to only allow one build to run at a given time.
-	// This is currently necessary for the incremental build to work as expected.
-	// We may want to explicitly manage build priority as opposed to relying on
-	// the reconciliation loop to handle the queuing.
+	running := 0
+	layoutsInUse := make(map[string]bool)
+	var queue []v1.Build
 	for _, b := range builds.Items {
-		if b.Status.Phase == v1.BuildPhasePending || b.Status.Phase == v1.BuildPhaseRunning {
-			// Let's requeue the build in case one is already running
+		switch b.Status.Phase {
+		case v1.BuildPhasePending, v1.BuildPhaseRunning:
+			running++
+			if layout := b.Labels[v1.IntegrationKitLayoutLabel]; isSerializedLayout(layout) {
+				layoutsInUse[layout] = true
+			}
+		case v1.BuildPhaseScheduling:
+			queue = append(queue, b)
+		}
+	}
+
+	position := queuePosition(queue, build)
+	ahead, blocked := schedulableAhead(queue, build, layoutsInUse)
+	// The builds are dequeued in FIFO order, as long as the maximum number of running builds is not reached.
+	// A queued build may wait for another build with the same layout to complete, while the builds queued
+	// behind it are started, in which case the FIFO order is not strictly enforced.
+	maxRunningBuilds := build.Spec.GetMaxRunningBuilds()
+	if blocked || maxRunningBuilds > 0 && ahead >= maxRunningBuilds-running {
+		if build.Status.QueuePosition == int32(position) {
+			// Let's requeue the build
 			return nil, nil
 		}
+		// Report the position of the build in the queue
+		return nil, action.patchBuildStatus(ctx, build, func(b *v1.Build) {
+			b.Status.QueuePosition = int32(position)
+		})
 	}
 
 	// Reset the Build status, and transition it to pending phase.
@@ -103,6 +106,61 @@ func (action *scheduleAction) Handle(ctx context.Context, build *v1.Build) (*v1.
 	return nil, action.toPendingPhase(ctx, build)
 }
 
+// queuePosition returns the position, starting at 1, of the build in the queue of scheduling builds,
+// ordered by creation time.
+func queuePosition(queue []v1.Build, build *v1.Build) int {
+	sort.SliceStable(queue, func(i, j int) bool {
+		ti, tj := queue[i].CreationTimestamp, queue[j].CreationTimestamp
+		if ti.Equal(&tj) {
+			return queue[i].Name < queue[j].Name
+		}
+		return ti.Before(&tj)
+	})
+	for i, b := range queue {
+		if b.Name == build.Name {
+			return i + 1
+		}
+	}
+	// The build is not listed yet, so it's considered at the end of the queue
+	return len(queue) + 1
+}
+
+// isSerializedLayout returns whether only one build with the given layout is allowed to run at a given time.
+// Native builds can be run in parallel, as incremental images is not applicable. Otherwise, we assume
+// incremental images is only applicable across images whose layout is identical, the builds without
+// a layout included.
+func isSerializedLayout(layout string) bool {
+	return layout != v1.IntegrationKitLayoutNative
+}
+
+// schedulableAhead returns the number of builds, queued ahead of the build, that can be started, and whether
+// the build has to wait for a running build, or a build queued ahead of it, with the same layout to complete.
+// The queued builds that are blocked by their layout are not counted, so that they don't prevent the builds
+// queued behind them from starting. The queue must be ordered, as done by queuePosition.
+func schedulableAhead(queue []v1.Build, build *v1.Build, layoutsInUse map[string]bool) (int, bool) {
+	inUse := make(map[string]bool, len(layoutsInUse))
+	for layout := range layoutsInUse {
+		inUse[layout] = true
+	}
+
+	ahead := 0
+	for _, b := range queue {
+		if b.Name == build.Name {
+			break
+		}
+		layout := b.Labels[v1.IntegrationKitLayoutLabel]
+		if !isSerializedLayout(layout) {
+			ahead++
+		} else if !inUse[layout] {
+			ahead++
+			inUse[layout] = true
+		}
+	}
+
+	layout := build.Labels[v1.IntegrationKitLayoutLabel]
+	return ahead, isSerializedLayout(layout) && inUse[layout]
+}
+
 func (action *scheduleAction) toPendingPhase(ctx context.Context, build *v1.Build) error {
 	err := action.patchBuildStatus(ctx, build, func(b *v1.Build) {
 		now := metav1.Now()
@@ -0,0 +1,109 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func TestQueuePosition(t *testing.T) {
+	now := time.Now()
+	newBuild := func(name string, created time.Time) v1.Build {
+		b := v1.NewBuild("ns", name)
+		b.CreationTimestamp = metav1.NewTime(created)
+		return *b
+	}
+
+	queue := []v1.Build{
+		newBuild("c", now.Add(2*time.Second)),
+		newBuild("b", now),
+		newBuild("a", now),
+	}
+
+	build := newBuild("a", now)
+	assert.Equal(t, 1, queuePosition(queue, &build))
+	build = newBuild("b", now)
+	assert.Equal(t, 2, queuePosition(queue, &build))
+	build = newBuild("c", now.Add(2*time.Second))
+	assert.Equal(t, 3, queuePosition(queue, &build))
+	build = newBuild("d", now.Add(time.Second))
+	assert.Equal(t, 4, queuePosition(queue, &build))
+}
+
+func TestSchedulableAhead(t *testing.T) {
+	now := time.Now()
+	newBuild := func(name string, layout string, created time.Time) v1.Build {
+		b := v1.NewBuild("ns", name)
+		b.CreationTimestamp = metav1.NewTime(created)
+		if layout != "" {
+			b.Labels = map[string]string{v1.IntegrationKitLayoutLabel: layout}
+		}
+		return *b
+	}
+
+	queue := []v1.Build{
+		newBuild("a", v1.IntegrationKitLayoutFastJar, now),
+		newBuild("b", v1.IntegrationKitLayoutFastJar, now.Add(time.Second)),
+		newBuild("c", "", now.Add(2*time.Second)),
+		newBuild("d", v1.IntegrationKitLayoutNative, now.Add(3*time.Second)),
+		newBuild("e", v1.IntegrationKitLayoutNative, now.Add(4*time.Second)),
+		newBuild("f", "", now.Add(5*time.Second)),
+	}
+	build := newBuild("a", v1.IntegrationKitLayoutFastJar, now)
+	queuePosition(queue, &build)
+
+	testCases := []struct {
+		name         string
+		layoutsInUse map[string]bool
+		ahead        int
+		blocked      bool
+	}{
+		{name: "a", ahead: 0},
+		// The build queued ahead with the same layout
+		{name: "b", ahead: 1, blocked: true},
+		// The builds with the same layout are not counted twice
+		{name: "c", ahead: 1},
+		{name: "d", ahead: 2},
+		// The native builds are not serialized
+		{name: "e", ahead: 3},
+		// The builds without a layout are serialized
+		{name: "f", ahead: 4, blocked: true},
+		// The builds blocked by a running build with the same layout are skipped
+		{name: "c", layoutsInUse: map[string]bool{v1.IntegrationKitLayoutFastJar: true}, ahead: 0},
+		{name: "a", layoutsInUse: map[string]bool{v1.IntegrationKitLayoutFastJar: true}, ahead: 0, blocked: true},
+	}
+
+	for _, tc := range testCases {
+		build := queue[0]
+		for _, b := range queue {
+			if b.Name == tc.name {
+				build = b
+			}
+		}
+		ahead, blocked := schedulableAhead(queue, &build, tc.layoutsInUse)
+		assert.Equal(t, tc.ahead, ahead, tc.name)
+		assert.Equal(t, tc.blocked, blocked, tc.name)
+	}
+}
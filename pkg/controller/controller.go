@@ -21,15 +21,16 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/util/workqueue"
 )
 
 // addToManager is a list of functions to add all Controllers to the Manager.
-var addToManager []func(ctrl.Manager, client.Client) error
+var addToManager []func(ctrl.Manager, client.Client, workqueue.Options) error
 
 // AddToManager adds all Controllers to the Manager.
-func AddToManager(manager ctrl.Manager, client client.Client) error {
+func AddToManager(manager ctrl.Manager, client client.Client, options workqueue.Options) error {
 	for _, f := range addToManager {
-		if err := f(manager, client); err != nil {
+		if err := f(manager, client, options); err != nil {
 			return err
 		}
 	}
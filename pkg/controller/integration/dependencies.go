@@ -0,0 +1,117 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+const (
+	// The maximum number of attempts to resolve the unmet dependencies of an integration.
+	dependenciesResolutionMaxAttempts = 10
+	// The delay before the first attempt, doubled for each subsequent attempt.
+	dependenciesResolutionInitialBackoff = 10 * time.Second
+	// The maximum delay between two attempts.
+	dependenciesResolutionMaxBackoff = 5 * time.Minute
+)
+
+// hasUnmetDependencies returns whether the integration initialization failed because some of its dependencies,
+// e.g., Kamelets, are not available yet.
+func hasUnmetDependencies(integration *v1.Integration) bool {
+	if !isInInitializationFailed(integration.Status) {
+		return false
+	}
+	cond := integration.Status.GetCondition(v1.IntegrationConditionKameletsAvailable)
+	return cond != nil && cond.Status == corev1.ConditionFalse
+}
+
+func dependenciesResolutionBackoff(attempts int32) time.Duration {
+	backoff := dependenciesResolutionInitialBackoff
+	for i := int32(0); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= dependenciesResolutionMaxBackoff {
+			return dependenciesResolutionMaxBackoff
+		}
+	}
+	return backoff
+}
+
+// dependenciesResolutionDelay returns the remaining delay before the next attempt to resolve the unmet dependencies
+// of the integration, and false when no more attempts must be made.
+func dependenciesResolutionDelay(integration *v1.Integration, now time.Time) (time.Duration, bool) {
+	if !hasUnmetDependencies(integration) || integration.Status.DependenciesResolutionAttempts >= dependenciesResolutionMaxAttempts {
+		return 0, false
+	}
+
+	// The last attempt is either the first initialization failure, or the last retry
+	cond := integration.Status.GetCondition(v1.IntegrationConditionDependenciesResolved)
+	if cond == nil {
+		cond = integration.Status.GetCondition(v1.IntegrationConditionReady)
+	}
+	last := cond.LastUpdateTime.Time
+
+	delay := last.Add(dependenciesResolutionBackoff(integration.Status.DependenciesResolutionAttempts)).Sub(now)
+	if delay < 0 {
+		delay = 0
+	}
+	return delay, true
+}
+
+// retryDependenciesResolution moves the integration back to the initialization phase, if the backoff delay
+// has elapsed since the last attempt, or reports a terminal condition once the maximum number of attempts is reached.
+func retryDependenciesResolution(integration *v1.Integration) *v1.Integration {
+	if !hasUnmetDependencies(integration) {
+		return nil
+	}
+
+	status := &integration.Status
+	if status.DependenciesResolutionAttempts >= dependenciesResolutionMaxAttempts {
+		if cond := status.GetCondition(v1.IntegrationConditionDependenciesResolved); cond != nil &&
+			cond.Reason == v1.IntegrationConditionDependenciesResolutionFailedReason {
+			return nil
+		}
+		status.SetCondition(
+			v1.IntegrationConditionDependenciesResolved,
+			corev1.ConditionFalse,
+			v1.IntegrationConditionDependenciesResolutionFailedReason,
+			fmt.Sprintf("dependencies still unmet after %d attempts: %s", status.DependenciesResolutionAttempts,
+				status.GetCondition(v1.IntegrationConditionKameletsAvailable).Message),
+		)
+		return integration
+	}
+
+	if delay, _ := dependenciesResolutionDelay(integration, time.Now()); delay > 0 {
+		return nil
+	}
+
+	status.DependenciesResolutionAttempts++
+	status.SetCondition(
+		v1.IntegrationConditionDependenciesResolved,
+		corev1.ConditionFalse,
+		v1.IntegrationConditionDependenciesResolutionRetryingReason,
+		fmt.Sprintf("attempt %d/%d to resolve the unmet dependencies", status.DependenciesResolutionAttempts, dependenciesResolutionMaxAttempts),
+	)
+	status.Phase = v1.IntegrationPhaseInitialization
+
+	return integration
+}
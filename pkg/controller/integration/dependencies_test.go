@@ -0,0 +1,121 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func TestDependenciesResolutionBackoff(t *testing.T) {
+	assert.Equal(t, 10*time.Second, dependenciesResolutionBackoff(0))
+	assert.Equal(t, 20*time.Second, dependenciesResolutionBackoff(1))
+	assert.Equal(t, 160*time.Second, dependenciesResolutionBackoff(4))
+	assert.Equal(t, 5*time.Minute, dependenciesResolutionBackoff(5))
+	assert.Equal(t, 5*time.Minute, dependenciesResolutionBackoff(9))
+}
+
+func TestRetryDependenciesResolution(t *testing.T) {
+	integration := newIntegrationWithUnmetDependencies(time.Now())
+
+	// The backoff delay has not elapsed yet
+	assert.Nil(t, retryDependenciesResolution(integration))
+	delay, retry := dependenciesResolutionDelay(integration, time.Now())
+	assert.True(t, retry)
+	assert.True(t, delay > 0 && delay <= dependenciesResolutionInitialBackoff)
+
+	integration = newIntegrationWithUnmetDependencies(time.Now().Add(-time.Minute))
+	retried := retryDependenciesResolution(integration)
+	assert.NotNil(t, retried)
+	assert.Equal(t, v1.IntegrationPhaseInitialization, retried.Status.Phase)
+	assert.Equal(t, int32(1), retried.Status.DependenciesResolutionAttempts)
+	cond := retried.Status.GetCondition(v1.IntegrationConditionDependenciesResolved)
+	assert.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1.IntegrationConditionDependenciesResolutionRetryingReason, cond.Reason)
+	assert.Equal(t, "attempt 1/10 to resolve the unmet dependencies", cond.Message)
+
+	// No retry once the integration is no longer in error
+	_, retry = dependenciesResolutionDelay(retried, time.Now())
+	assert.False(t, retry)
+}
+
+func TestRetryDependenciesResolutionExhausted(t *testing.T) {
+	integration := newIntegrationWithUnmetDependencies(time.Now().Add(-time.Hour))
+	integration.Status.DependenciesResolutionAttempts = dependenciesResolutionMaxAttempts
+
+	_, retry := dependenciesResolutionDelay(integration, time.Now())
+	assert.False(t, retry)
+
+	failed := retryDependenciesResolution(integration)
+	assert.NotNil(t, failed)
+	assert.Equal(t, v1.IntegrationPhaseError, failed.Status.Phase)
+	cond := failed.Status.GetCondition(v1.IntegrationConditionDependenciesResolved)
+	assert.NotNil(t, cond)
+	assert.Equal(t, v1.IntegrationConditionDependenciesResolutionFailedReason, cond.Reason)
+	assert.Equal(t, "dependencies still unmet after 10 attempts: kamelets [] found, [my-source] not found in repositories: none", cond.Message)
+
+	// The terminal condition is only reported once
+	assert.Nil(t, retryDependenciesResolution(failed))
+}
+
+func TestRetryDependenciesResolutionWithoutUnmetDependencies(t *testing.T) {
+	integration := newIntegrationWithUnmetDependencies(time.Now().Add(-time.Hour))
+	integration.Status.SetCondition(v1.IntegrationConditionKameletsAvailable, corev1.ConditionTrue,
+		v1.IntegrationConditionKameletsAvailableReason, "kamelets [my-source] found in repositories: none")
+
+	assert.Nil(t, retryDependenciesResolution(integration))
+	_, retry := dependenciesResolutionDelay(integration, time.Now())
+	assert.False(t, retry)
+}
+
+func newIntegrationWithUnmetDependencies(failure time.Time) *v1.Integration {
+	timestamp := metav1.NewTime(failure)
+	return &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-integration",
+			Namespace: "ns",
+		},
+		Status: v1.IntegrationStatus{
+			Phase: v1.IntegrationPhaseError,
+			Conditions: []v1.IntegrationCondition{
+				{
+					Type:           v1.IntegrationConditionKameletsAvailable,
+					Status:         corev1.ConditionFalse,
+					Reason:         v1.IntegrationConditionKameletsAvailableReason,
+					Message:        "kamelets [] found, [my-source] not found in repositories: none",
+					LastUpdateTime: timestamp,
+				},
+				{
+					Type:           v1.IntegrationConditionReady,
+					Status:         corev1.ConditionFalse,
+					Reason:         v1.IntegrationConditionInitializationFailedReason,
+					Message:        "kamelets [] found, [my-source] not found in repositories: none",
+					LastUpdateTime: timestamp,
+				},
+			},
+		},
+	}
+}
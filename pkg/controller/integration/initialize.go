@@ -19,6 +19,7 @@ package integration
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -79,6 +80,16 @@ func (action *initializeAction) Handle(ctx context.Context, integration *v1.Inte
 		}
 	}
 
+	if integration.Status.GetCondition(v1.IntegrationConditionDependenciesResolved) != nil {
+		integration.Status.SetCondition(
+			v1.IntegrationConditionDependenciesResolved,
+			corev1.ConditionTrue,
+			v1.IntegrationConditionDependenciesResolvedReason,
+			fmt.Sprintf("dependencies resolved after %d attempts", integration.Status.DependenciesResolutionAttempts),
+		)
+		integration.Status.DependenciesResolutionAttempts = 0
+	}
+
 	integration.Status.Phase = v1.IntegrationPhaseBuildingKit
 	integration.Status.Version = defaults.Version
 	if timestamp := integration.Status.InitializationTimestamp; timestamp == nil || timestamp.IsZero() {
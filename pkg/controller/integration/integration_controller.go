@@ -31,18 +31,22 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
 
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"knative.dev/serving/pkg/apis/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/client"
 	camelevent "github.com/apache/camel-k/pkg/event"
@@ -52,10 +56,15 @@ import (
 	"github.com/apache/camel-k/pkg/util/kubernetes"
 	"github.com/apache/camel-k/pkg/util/log"
 	"github.com/apache/camel-k/pkg/util/monitoring"
+	utilResource "github.com/apache/camel-k/pkg/util/resource"
+	"github.com/apache/camel-k/pkg/util/workqueue"
 )
 
-func Add(mgr manager.Manager, c client.Client) error {
-	return add(mgr, c, newReconciler(mgr, c))
+func Add(mgr manager.Manager, c client.Client, options workqueue.Options) error {
+	if err := metrics.Registry.Register(&phaseCollector{reader: c}); err != nil {
+		return err
+	}
+	return add(mgr, c, newReconciler(mgr, c), options)
 }
 
 func newReconciler(mgr manager.Manager, c client.Client) reconcile.Reconciler {
@@ -158,6 +167,129 @@ func integrationKitEnqueueRequestsFromMapFunc(c client.Client, kit *v1.Integrati
 	return requests
 }
 
+// configMapEnqueueRequestsFromMapFunc returns the integrations referencing the ConfigMap, that's only watched
+// by its metadata, so that the ConfigMaps content isn't cached.
+func configMapEnqueueRequestsFromMapFunc(c client.Client, cm ctrl.Object) []reconcile.Request {
+	var requests []reconcile.Request
+
+	list := &v1.IntegrationList{}
+	if err := c.List(context.Background(), list, ctrl.InNamespace(cm.GetNamespace())); err != nil {
+		log.Error(err, "Failed to list integrations")
+		return requests
+	}
+
+	for _, integration := range list.Items {
+		if integration.Status.Phase != v1.IntegrationPhaseRunning && integration.Status.Phase != v1.IntegrationPhaseDeploying {
+			continue
+		}
+		if isSourceReferenced(&integration, cm.GetName()) {
+			log.Infof("Source ConfigMap %s changed, reconciling integration: %s", cm.GetName(), integration.Name)
+		} else if isMountHotReloaded(&integration, utilResource.StorageTypeConfigmap, cm.GetName()) {
+			log.Infof("Mounted ConfigMap %s changed, reconciling integration: %s", cm.GetName(), integration.Name)
+		} else {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: integration.Namespace,
+				Name:      integration.Name,
+			},
+		})
+	}
+
+	return requests
+}
+
+// nodeEnqueueRequestsFromMapFunc returns the integrations whose pods are scheduled on the node,
+// and have their readiness gated on the node preemption.
+func nodeEnqueueRequestsFromMapFunc(c client.Client, node ctrl.Object) []reconcile.Request {
+	var requests []reconcile.Request
+
+	pods := &corev1.PodList{}
+	if err := c.List(context.Background(), pods, ctrl.HasLabels{v1.IntegrationLabel}); err != nil {
+		log.Error(err, "Failed to list integration pods")
+		return requests
+	}
+
+	integrations := make(map[types.NamespacedName]bool)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName != node.GetName() || !hasReadinessGate(pod, trait.SpotNodeNotPreemptedCondition) {
+			continue
+		}
+		integration := types.NamespacedName{
+			Namespace: pod.Namespace,
+			Name:      pod.Labels[v1.IntegrationLabel],
+		}
+		if integrations[integration] {
+			continue
+		}
+		integrations[integration] = true
+		log.Infof("Node %s changed, reconciling integration: %s", node.GetName(), integration.Name)
+		requests = append(requests, reconcile.Request{NamespacedName: integration})
+	}
+
+	return requests
+}
+
+func isSourceReferenced(integration *v1.Integration, name string) bool {
+	for _, source := range integration.Spec.Sources {
+		if source.ContentRef == name {
+			return true
+		}
+	}
+	return false
+}
+
+// secretEnqueueRequestsFromMapFunc returns the integrations hot reloading the mounted Secret, that's only watched
+// by its metadata, so that the Secrets content isn't cached.
+func secretEnqueueRequestsFromMapFunc(c client.Client, secret ctrl.Object) []reconcile.Request {
+	var requests []reconcile.Request
+
+	list := &v1.IntegrationList{}
+	if err := c.List(context.Background(), list, ctrl.InNamespace(secret.GetNamespace())); err != nil {
+		log.Error(err, "Failed to list integrations")
+		return requests
+	}
+
+	for _, integration := range list.Items {
+		if integration.Status.Phase != v1.IntegrationPhaseRunning && integration.Status.Phase != v1.IntegrationPhaseDeploying {
+			continue
+		}
+		if isMountHotReloaded(&integration, utilResource.StorageTypeSecret, secret.GetName()) {
+			log.Infof("Mounted Secret %s changed, reconciling integration: %s", secret.GetName(), integration.Name)
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: integration.Namespace,
+					Name:      integration.Name,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// isMountHotReloaded returns whether the integration hot reloads the given ConfigMap or Secret,
+// mounted as a config or a resource by the mount trait.
+func isMountHotReloaded(integration *v1.Integration, storageType utilResource.StorageType, name string) bool {
+	mount := integration.Spec.Traits.Mount
+	if mount == nil || !pointer.BoolDeref(mount.HotReload, false) {
+		return false
+	}
+	for _, c := range mount.Configs {
+		if conf, err := utilResource.ParseConfig(c); err == nil && conf.StorageType() == storageType && conf.Name() == name {
+			return true
+		}
+	}
+	for _, r := range mount.Resources {
+		if res, err := utilResource.ParseResource(r); err == nil && res.StorageType() == storageType && res.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
 func integrationPlatformEnqueueRequestsFromMapFunc(c client.Client, p *v1.IntegrationPlatform) []reconcile.Request {
 	var requests []reconcile.Request
 
@@ -191,9 +323,10 @@ func integrationPlatformEnqueueRequestsFromMapFunc(c client.Client, p *v1.Integr
 	return requests
 }
 
-func add(mgr manager.Manager, c client.Client, r reconcile.Reconciler) error {
+func add(mgr manager.Manager, c client.Client, r reconcile.Reconciler, options workqueue.Options) error {
 	b := builder.ControllerManagedBy(mgr).
 		Named("integration-controller").
+		WithOptions(options.ControllerOptions(v1.IntegrationKind, r)).
 		// Watch for changes to primary resource Integration
 		For(&v1.Integration{}, builder.WithPredicates(
 			platform.FilteringFuncs{
@@ -239,8 +372,26 @@ func add(mgr manager.Manager, c client.Client, r reconcile.Reconciler) error {
 
 				return integrationPlatformEnqueueRequestsFromMapFunc(c, p)
 			})).
+		// Watch for the ConfigMaps the integration sources and mounts reference, so that the integrations
+		// reloading their sources or mounts are rolled out when the ConfigMaps content changes
+		Watches(&source.Kind{Type: &corev1.ConfigMap{}},
+			handler.EnqueueRequestsFromMapFunc(func(a ctrl.Object) []reconcile.Request {
+				return configMapEnqueueRequestsFromMapFunc(c, a)
+			}),
+			builder.OnlyMetadata,
+			builder.WithPredicates(ConfigMapContentChangedPredicate{})).
+		// Watch for the Secrets the integration mounts reference, so that the integrations
+		// reloading their mounts are rolled out when the Secrets content changes
+		Watches(&source.Kind{Type: &corev1.Secret{}},
+			handler.EnqueueRequestsFromMapFunc(func(a ctrl.Object) []reconcile.Request {
+				return secretEnqueueRequestsFromMapFunc(c, a)
+			}),
+			builder.OnlyMetadata,
+			builder.WithPredicates(SecretContentChangedPredicate{})).
 		// Watch for the owned Deployments
 		Owns(&appsv1.Deployment{}, builder.WithPredicates(StatusChangedPredicate{})).
+		// Watch for the owned StatefulSets
+		Owns(&appsv1.StatefulSet{}, builder.WithPredicates(StatusChangedPredicate{})).
 		// Watch for the Integration Pods
 		Watches(&source.Kind{Type: &corev1.Pod{}},
 			handler.EnqueueRequestsFromMapFunc(func(a ctrl.Object) []reconcile.Request {
@@ -271,13 +422,40 @@ func add(mgr manager.Manager, c client.Client, r reconcile.Reconciler) error {
 		// Check for permission to watch the ConsoleCLIDownload resource
 		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 		defer cancel()
-		if ok, err = kubernetes.CheckPermission(ctx, c, serving.GroupName, "services", platform.GetOperatorWatchNamespace(), "", "watch"); err != nil {
+		if ok, err = platform.CheckPermissionInWatchNamespaces(ctx, c, serving.GroupName, "services", "watch"); err != nil {
 			return err
 		} else if ok {
 			b.Owns(&servingv1.Service{}, builder.WithPredicates(StatusChangedPredicate{}))
 		}
 	}
 
+	// Watch for the owned PodMonitors conditionally
+	if ok, err := kubernetes.IsAPIResourceInstalled(c, monitoringv1.SchemeGroupVersion.String(), monitoringv1.PodMonitorsKind); err != nil {
+		return err
+	} else if ok {
+		// Check for permission to watch the PodMonitor resource, that's granted separately
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if ok, err = platform.CheckPermissionInWatchNamespaces(ctx, c, monitoringv1.SchemeGroupVersion.Group, monitoringv1.PodMonitorName, "watch"); err != nil {
+			return err
+		} else if ok {
+			b.Owns(&monitoringv1.PodMonitor{})
+		}
+	}
+
+	// Watch for the preemption of the nodes, when the operator is granted the permission
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if ok, err := kubernetes.CheckPermission(ctx, c, corev1.GroupName, "nodes", "", "", "watch"); err != nil {
+		return err
+	} else if ok {
+		b.Watches(&source.Kind{Type: &corev1.Node{}},
+			handler.EnqueueRequestsFromMapFunc(func(a ctrl.Object) []reconcile.Request {
+				return nodeEnqueueRequestsFromMapFunc(c, a)
+			}),
+			builder.WithPredicates(NodePreemptionChangedPredicate{}))
+	}
+
 	return b.Complete(r)
 }
 
@@ -316,6 +494,7 @@ func (r *reconcileIntegration) Reconcile(ctx context.Context, request reconcile.
 		if k8serrors.IsNotFound(err) {
 			// Request object not found, could have been deleted after reconcile request.
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			deleteIntegrationMetrics(request.Namespace, request.Name)
 			// Return and don't requeue
 			return reconcile.Result{}, nil
 		}
@@ -366,10 +545,22 @@ func (r *reconcileIntegration) Reconcile(ctx context.Context, request reconcile.
 			// handle one action at time so the resource
 			// is always at its latest state
 			camelevent.NotifyIntegrationUpdated(ctx, r.client, r.recorder, &instance, newTarget)
+			if newTarget != nil {
+				target = newTarget
+			}
 			break
 		}
 	}
 
+	// Requeue the integration to re-attempt resolving its unmet dependencies
+	if delay, retry := dependenciesResolutionDelay(target, time.Now()); retry {
+		return reconcile.Result{Requeue: delay == 0, RequeueAfter: delay}, nil
+	}
+	// Requeue the integration to resume its reconciliation once the deployer trait paused-until deadline is reached
+	if delay, paused := pausedUntilDelay(target, time.Now()); paused {
+		return reconcile.Result{Requeue: delay == 0, RequeueAfter: delay}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
@@ -387,6 +578,7 @@ func (r *reconcileIntegration) update(ctx context.Context, base *v1.Integration,
 	}
 
 	if target.Status.Phase != base.Status.Phase {
+		observePhaseTransition(target, base.Status.Phase, target.Status.Phase)
 		log.Info(
 			"state transition",
 			"phase-from", base.Status.Phase,
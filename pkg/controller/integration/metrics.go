@@ -18,11 +18,17 @@ limitations under the License.
 package integration
 
 import (
+	"context"
 	"time"
 
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/prometheus/client_golang/prometheus"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/platform"
+	"github.com/apache/camel-k/pkg/trait"
 )
 
 var timeToFirstReadiness = prometheus.NewHistogram(
@@ -39,7 +45,70 @@ var timeToFirstReadiness = prometheus.NewHistogram(
 	},
 )
 
+var phaseTransitions = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "camel_k_integration_phase_transitions_total",
+		Help: "Camel K integration phase transitions",
+	},
+	[]string{
+		"namespace",
+		"integration",
+		"from",
+		"to",
+	},
+)
+
+var integrationsByPhase = prometheus.NewDesc(
+	"camel_k_integrations",
+	"Camel K integrations per phase",
+	[]string{"namespace", "phase"},
+	nil,
+)
+
+// phaseCollector reports the number of integrations in each phase,
+// listed from the informers cache when the metrics are scraped.
+type phaseCollector struct {
+	reader ctrl.Reader
+}
+
+func (c *phaseCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- integrationsByPhase
+}
+
+func (c *phaseCollector) Collect(ch chan<- prometheus.Metric) {
+	integrations := &v1.IntegrationList{}
+	if err := c.reader.List(context.Background(), integrations); err != nil {
+		Log.Error(err, "Unable to list the integrations")
+		return
+	}
+
+	type key struct {
+		namespace string
+		phase     v1.IntegrationPhase
+	}
+	counts := make(map[key]int)
+	for i := range integrations.Items {
+		integration := &integrations.Items[i]
+		if platform.IsOperatorHandler(integration) {
+			counts[key{integration.Namespace, integration.Status.Phase}]++
+		}
+	}
+	for k, count := range counts {
+		ch <- prometheus.MustNewConstMetric(integrationsByPhase, prometheus.GaugeValue, float64(count), k.namespace, string(k.phase))
+	}
+}
+
 func init() {
 	// Register custom metrics with the global prometheus registry
-	metrics.Registry.MustRegister(timeToFirstReadiness)
+	metrics.Registry.MustRegister(timeToFirstReadiness, phaseTransitions)
+}
+
+func observePhaseTransition(integration *v1.Integration, from v1.IntegrationPhase, to v1.IntegrationPhase) {
+	phaseTransitions.WithLabelValues(integration.Namespace, integration.Name, string(from), string(to)).Inc()
+}
+
+// deleteIntegrationMetrics deletes the metrics labelled with the name of a deleted integration.
+func deleteIntegrationMetrics(namespace string, name string) {
+	phaseTransitions.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "integration": name})
+	trait.DeleteIntegrationMetrics(namespace, name)
 }
@@ -24,11 +24,13 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 
@@ -64,8 +66,12 @@ func (action *monitorAction) Handle(ctx context.Context, integration *v1.Integra
 	// When in InitializationFailed condition a kit is not available for the integration
 	// so handle it differently from the rest
 	if isInInitializationFailed(integration.Status) {
-		// Only check if the Integration requires a rebuild
-		return action.checkDigestAndRebuild(integration, nil)
+		// Check if the Integration requires a rebuild
+		if changed, err := action.checkDigestAndRebuild(integration, nil); err != nil || changed != nil {
+			return changed, err
+		}
+		// Otherwise re-attempt the initialization, in case the unmet dependencies have become available
+		return retryDependenciesResolution(integration), nil
 	}
 
 	// At that staged the Integration must have a Kit
@@ -117,6 +123,14 @@ func (action *monitorAction) Handle(ctx context.Context, integration *v1.Integra
 		return nil, err
 	}
 
+	// The integration resources have not been deployed, when the image signature verification failed
+	if verified := integration.Status.GetCondition(v1.IntegrationConditionImageSignatureVerified); verified != nil &&
+		verified.Status == corev1.ConditionFalse {
+		integration.Status.Phase = v1.IntegrationPhaseError
+		integration.SetReadyConditionError(verified.Message)
+		return integration, nil
+	}
+
 	// Enforce the scale sub-resource label selector.
 	// It is used by the HPA that queries the scale sub-resource endpoint,
 	// to list the pods owned by the integration.
@@ -139,6 +153,9 @@ func (action *monitorAction) Handle(ctx context.Context, integration *v1.Integra
 	if err != nil {
 		return nil, err
 	}
+	if err = action.updateSpotReadinessGates(ctx, environment, runningPods.Items); err != nil {
+		return nil, err
+	}
 	nonTerminatingPods := 0
 	for _, pod := range runningPods.Items {
 		if pod.DeletionTimestamp != nil {
@@ -162,6 +179,110 @@ func (action *monitorAction) Handle(ctx context.Context, integration *v1.Integra
 	return integration, nil
 }
 
+// updateSpotReadinessGates sets the readiness gate condition of the pods scheduled on spot nodes, so that they're
+// removed from the Service endpoints as soon as their node is preempted, before they're evicted.
+func (action *monitorAction) updateSpotReadinessGates(ctx context.Context, environment *trait.Environment, pods []corev1.Pod) error {
+	conditions := make(map[string]corev1.PodCondition)
+	for i := range pods {
+		pod := &pods[i]
+		if pod.DeletionTimestamp != nil || pod.Spec.NodeName == "" || !hasReadinessGate(pod, trait.SpotNodeNotPreemptedCondition) {
+			continue
+		}
+
+		condition, ok := conditions[pod.Spec.NodeName]
+		if !ok {
+			condition = corev1.PodCondition{
+				Type:   trait.SpotNodeNotPreemptedCondition,
+				Status: corev1.ConditionTrue,
+				Reason: "NodeNotPreempted",
+			}
+			node, err := action.client.CoreV1().Nodes().Get(ctx, pod.Spec.NodeName, metav1.GetOptions{})
+			switch {
+			case k8serrors.IsForbidden(err):
+				// Do not prevent the pods from being ready, when the operator can't get the nodes
+				condition.Message = "the operator is not granted the permission to get the nodes"
+			case k8serrors.IsNotFound(err):
+				condition.Status = corev1.ConditionFalse
+				condition.Reason = "NodePreempted"
+				condition.Message = fmt.Sprintf("node %s not found", pod.Spec.NodeName)
+			case err != nil:
+				return err
+			default:
+				if preemption := trait.SpotNodePreemption(environment, node); preemption != "" {
+					condition.Status = corev1.ConditionFalse
+					condition.Reason = "NodePreempted"
+					condition.Message = preemption
+				}
+			}
+			conditions[pod.Spec.NodeName] = condition
+		}
+
+		target := pod.DeepCopy()
+		if !setPodCondition(target, condition) {
+			continue
+		}
+		if err := action.client.Status().Patch(ctx, target, ctrl.StrategicMergeFrom(pod)); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func hasReadinessGate(pod *corev1.Pod, conditionType corev1.PodConditionType) bool {
+	for _, gate := range pod.Spec.ReadinessGates {
+		if gate.ConditionType == conditionType {
+			return true
+		}
+	}
+	return false
+}
+
+// setPodCondition sets the given condition on the pod, and returns false when it's already set.
+func setPodCondition(pod *corev1.Pod, condition corev1.PodCondition) bool {
+	for i, c := range pod.Status.Conditions {
+		if c.Type != condition.Type {
+			continue
+		}
+		if c.Status == condition.Status && c.Reason == condition.Reason && c.Message == condition.Message {
+			return false
+		}
+		if c.Status == condition.Status {
+			condition.LastTransitionTime = c.LastTransitionTime
+		} else {
+			condition.LastTransitionTime = metav1.Now()
+		}
+		pod.Status.Conditions[i] = condition
+		return true
+	}
+	condition.LastTransitionTime = metav1.Now()
+	pod.Status.Conditions = append(pod.Status.Conditions, condition)
+	return true
+}
+
+// pausedUntilDelay returns the remaining delay before the reconciliation, paused by the deployer trait
+// until a given time, resumes, and false when the integration reconciliation is not paused until a deadline.
+func pausedUntilDelay(integration *v1.Integration, now time.Time) (time.Duration, bool) {
+	cond := integration.Status.GetCondition(v1.IntegrationConditionReconciliationPaused)
+	if cond == nil || cond.Status != corev1.ConditionTrue {
+		return 0, false
+	}
+	deployer := integration.Spec.Traits.Deployer
+	if deployer == nil || deployer.PausedUntil == "" || deployer.Paused != nil && *deployer.Paused {
+		return 0, false
+	}
+	until, err := time.Parse(time.RFC3339, deployer.PausedUntil)
+	if err != nil {
+		return 0, false
+	}
+
+	delay := until.Sub(now)
+	if delay < 0 {
+		delay = 0
+	}
+	return delay, true
+}
+
 func isInInitializationFailed(status v1.IntegrationStatus) bool {
 	if status.Phase != v1.IntegrationPhaseError {
 		return false
@@ -245,6 +366,16 @@ func (action *monitorAction) newController(env *trait.Environment, integration *
 			integration: integration,
 			client:      action.client,
 		}
+	case integration.IsConditionTrue(v1.IntegrationConditionStatefulSetAvailable):
+		obj = getUpdatedController(env, &appsv1.StatefulSet{})
+		set, ok := obj.(*appsv1.StatefulSet)
+		if !ok {
+			return nil, fmt.Errorf("type assertion failed: %v", obj)
+		}
+		controller = &statefulSetController{
+			obj:         set,
+			integration: integration,
+		}
 	default:
 		return nil, fmt.Errorf("unsupported controller for integration %s", integration.Name)
 	}
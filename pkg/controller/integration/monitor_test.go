@@ -0,0 +1,135 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/trait"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestPausedUntilDelay(t *testing.T) {
+	now := time.Now()
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Deployer: &traitv1.DeployerTrait{
+					PausedUntil: now.Add(time.Hour).UTC().Format(time.RFC3339),
+				},
+			},
+		},
+	}
+
+	// The reconciliation is not paused yet
+	_, paused := pausedUntilDelay(integration, now)
+	assert.False(t, paused)
+
+	integration.Status.SetCondition(v1.IntegrationConditionReconciliationPaused, corev1.ConditionTrue,
+		v1.IntegrationConditionReconciliationPausedReason, "")
+	delay, paused := pausedUntilDelay(integration, now)
+	assert.True(t, paused)
+	assert.True(t, delay > 59*time.Minute && delay <= time.Hour)
+
+	// The deadline is reached
+	delay, paused = pausedUntilDelay(integration, now.Add(2*time.Hour))
+	assert.True(t, paused)
+	assert.Equal(t, time.Duration(0), delay)
+
+	// The reconciliation is paused indefinitely
+	integration.Spec.Traits.Deployer.Paused = pointer.Bool(true)
+	_, paused = pausedUntilDelay(integration, now)
+	assert.False(t, paused)
+}
+
+func TestUpdateSpotReadinessGates(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node",
+		},
+		Spec: corev1.NodeSpec{
+			Taints: []corev1.Taint{
+				{Key: "aws-node-termination-handler/spot-itn", Effect: corev1.TaintEffectNoSchedule},
+			},
+		},
+	}
+	newPod := func(name string, nodeName string, gated bool) *corev1.Pod {
+		pod := &corev1.Pod{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: corev1.SchemeGroupVersion.String(),
+				Kind:       "Pod",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+			},
+			Spec: corev1.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{
+					{Type: corev1.ContainersReady, Status: corev1.ConditionTrue},
+				},
+			},
+		}
+		if gated {
+			pod.Spec.ReadinessGates = []corev1.PodReadinessGate{{ConditionType: trait.SpotNodeNotPreemptedCondition}}
+		}
+		return pod
+	}
+	preempted := newPod("preempted", "node", true)
+	removed := newPod("removed", "removed-node", true)
+	ungated := newPod("ungated", "node", false)
+
+	c, err := test.NewFakeClient(node, preempted, removed, ungated)
+	assert.Nil(t, err)
+	action := NewMonitorAction()
+	action.InjectClient(c)
+
+	monitor, _ := action.(*monitorAction)
+	err = monitor.updateSpotReadinessGates(context.TODO(), &trait.Environment{},
+		[]corev1.Pod{*preempted, *removed, *ungated})
+	assert.Nil(t, err)
+
+	pod := &corev1.Pod{}
+	assert.Nil(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(preempted), pod))
+	assert.Len(t, pod.Status.Conditions, 2)
+	assert.Equal(t, corev1.ContainersReady, pod.Status.Conditions[0].Type)
+	assert.Equal(t, trait.SpotNodeNotPreemptedCondition, pod.Status.Conditions[1].Type)
+	assert.Equal(t, corev1.ConditionFalse, pod.Status.Conditions[1].Status)
+	assert.Equal(t, "node node is tainted with aws-node-termination-handler/spot-itn", pod.Status.Conditions[1].Message)
+
+	assert.Nil(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(removed), pod))
+	assert.Equal(t, corev1.ConditionFalse, pod.Status.Conditions[1].Status)
+	assert.Equal(t, "node removed-node not found", pod.Status.Conditions[1].Message)
+
+	assert.Nil(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(ungated), pod))
+	assert.Len(t, pod.Status.Conditions, 1)
+}
@@ -20,6 +20,7 @@ package integration
 import (
 	"reflect"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -55,3 +56,92 @@ func (StatusChangedPredicate) Update(e event.UpdateEvent) bool {
 
 	return !equality.Semantic.DeepDerivative(s1.Interface(), s2.Interface())
 }
+
+// ConfigMapContentChangedPredicate implements a ConfigMap predicate function on content change.
+type ConfigMapContentChangedPredicate struct {
+	predicate.Funcs
+}
+
+// Create implements CreateEvent filter, that ignores the ConfigMaps creation.
+func (ConfigMapContentChangedPredicate) Create(e event.CreateEvent) bool {
+	return false
+}
+
+// Delete implements DeleteEvent filter, that ignores the ConfigMaps deletion.
+func (ConfigMapContentChangedPredicate) Delete(e event.DeleteEvent) bool {
+	return false
+}
+
+// Generic implements GenericEvent filter, that ignores the generic events.
+func (ConfigMapContentChangedPredicate) Generic(e event.GenericEvent) bool {
+	return false
+}
+
+// Update implements UpdateEvent filter for validating ConfigMap content change.
+// The ConfigMaps are only watched by their metadata, so that any change to a ConfigMap is considered.
+func (ConfigMapContentChangedPredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
+	}
+
+	return e.ObjectOld.GetResourceVersion() != e.ObjectNew.GetResourceVersion()
+}
+
+// SecretContentChangedPredicate implements a Secret predicate function on content change.
+type SecretContentChangedPredicate struct {
+	predicate.Funcs
+}
+
+// Create implements CreateEvent filter, that ignores the Secrets creation.
+func (SecretContentChangedPredicate) Create(e event.CreateEvent) bool {
+	return false
+}
+
+// Delete implements DeleteEvent filter, that ignores the Secrets deletion.
+func (SecretContentChangedPredicate) Delete(e event.DeleteEvent) bool {
+	return false
+}
+
+// Generic implements GenericEvent filter, that ignores the generic events.
+func (SecretContentChangedPredicate) Generic(e event.GenericEvent) bool {
+	return false
+}
+
+// Update implements UpdateEvent filter for validating Secret content change.
+// The Secrets are only watched by their metadata, so that any change to a Secret is considered.
+func (SecretContentChangedPredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
+	}
+
+	return e.ObjectOld.GetResourceVersion() != e.ObjectNew.GetResourceVersion()
+}
+
+// NodePreemptionChangedPredicate implements a Node predicate function on cordon, or taints, change.
+type NodePreemptionChangedPredicate struct {
+	predicate.Funcs
+}
+
+// Create implements CreateEvent filter, that ignores the Nodes creation.
+func (NodePreemptionChangedPredicate) Create(e event.CreateEvent) bool {
+	return false
+}
+
+// Generic implements GenericEvent filter, that ignores the generic events.
+func (NodePreemptionChangedPredicate) Generic(e event.GenericEvent) bool {
+	return false
+}
+
+// Update implements UpdateEvent filter for validating the Node is cordoned, or its taints changed.
+func (NodePreemptionChangedPredicate) Update(e event.UpdateEvent) bool {
+	old, ok := e.ObjectOld.(*corev1.Node)
+	if !ok {
+		return false
+	}
+	node, ok := e.ObjectNew.(*corev1.Node)
+	if !ok {
+		return false
+	}
+
+	return old.Spec.Unschedulable != node.Spec.Unschedulable || !equality.Semantic.DeepEqual(old.Spec.Taints, node.Spec.Taints)
+}
@@ -0,0 +1,75 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestConfigMapContentChangedPredicate(t *testing.T) {
+	p := ConfigMapContentChangedPredicate{}
+	old := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "cm", ResourceVersion: "1"}}
+
+	assert.False(t, p.Create(event.CreateEvent{Object: old}))
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: old.DeepCopy()}))
+
+	updated := old.DeepCopy()
+	updated.ResourceVersion = "2"
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated}))
+}
+
+func TestSecretContentChangedPredicate(t *testing.T) {
+	p := SecretContentChangedPredicate{}
+	old := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "secret", ResourceVersion: "1"}}
+
+	assert.False(t, p.Create(event.CreateEvent{Object: old}))
+	assert.False(t, p.Delete(event.DeleteEvent{Object: old}))
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: old.DeepCopy()}))
+
+	updated := old.DeepCopy()
+	updated.ResourceVersion = "2"
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated}))
+}
+
+func TestNodePreemptionChangedPredicate(t *testing.T) {
+	p := NodePreemptionChangedPredicate{}
+	old := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node", ResourceVersion: "1"}}
+
+	assert.False(t, p.Create(event.CreateEvent{Object: old}))
+
+	// The node status changes are ignored
+	updated := old.DeepCopy()
+	updated.ResourceVersion = "2"
+	updated.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated}))
+
+	tainted := old.DeepCopy()
+	tainted.Spec.Taints = []corev1.Taint{{Key: "aws-node-termination-handler/spot-itn", Effect: corev1.TaintEffectNoSchedule}}
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: tainted}))
+
+	cordoned := old.DeepCopy()
+	cordoned.Spec.Unschedulable = true
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: cordoned}))
+}
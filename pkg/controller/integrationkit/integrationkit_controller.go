@@ -42,12 +42,13 @@ import (
 	"github.com/apache/camel-k/pkg/util/digest"
 	"github.com/apache/camel-k/pkg/util/log"
 	"github.com/apache/camel-k/pkg/util/monitoring"
+	"github.com/apache/camel-k/pkg/util/workqueue"
 )
 
 // Add creates a new IntegrationKit Controller and adds it to the Manager. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
-func Add(mgr manager.Manager, c client.Client) error {
-	return add(mgr, newReconciler(mgr, c))
+func Add(mgr manager.Manager, c client.Client, options workqueue.Options) error {
+	return add(mgr, newReconciler(mgr, c), options)
 }
 
 func newReconciler(mgr manager.Manager, c client.Client) reconcile.Reconciler {
@@ -65,8 +66,8 @@ func newReconciler(mgr manager.Manager, c client.Client) reconcile.Reconciler {
 	)
 }
 
-func add(mgr manager.Manager, r reconcile.Reconciler) error {
-	c, err := controller.New("integrationkit-controller", mgr, controller.Options{Reconciler: r})
+func add(mgr manager.Manager, r reconcile.Reconciler, options workqueue.Options) error {
+	c, err := controller.New("integrationkit-controller", mgr, options.ControllerOptions(v1.IntegrationKitKind, r))
 	if err != nil {
 		return err
 	}
@@ -20,6 +20,8 @@ package integrationplatform
 import (
 	"context"
 
+	"github.com/pkg/errors"
+
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -117,9 +119,13 @@ func (action *initializeAction) isPrimaryDuplicate(ctx context.Context, thisPlat
 }
 
 func createPersistentVolumeClaim(ctx context.Context, client client.Client, platform *v1.IntegrationPlatform) error {
-	volumeSize, err := resource.ParseQuantity("1Gi")
+	size := builder.KanikoDefaultPVCSize
+	if s, found := platform.Status.Build.PublishStrategyOptions[builder.KanikoPVCSize]; found && s != "" {
+		size = s
+	}
+	volumeSize, err := resource.ParseQuantity(size)
 	if err != nil {
-		return err
+		return errors.Wrapf(err, "invalid %s publish strategy option", builder.KanikoPVCSize)
 	}
 	pvcName := platform.Status.Build.PublishStrategyOptions[builder.KanikoPVCName]
 	pvc := &corev1.PersistentVolumeClaim{
@@ -146,6 +152,10 @@ func createPersistentVolumeClaim(ctx context.Context, client client.Client, plat
 		},
 	}
 
+	if storageClass := platform.Status.Build.PublishStrategyOptions[builder.KanikoPVCStorageClass]; storageClass != "" {
+		pvc.Spec.StorageClassName = &storageClass
+	}
+
 	err = client.Create(ctx, pvc)
 	// Skip the error in case the PVC already exists
 	if err != nil && !k8serrors.IsAlreadyExists(err) {
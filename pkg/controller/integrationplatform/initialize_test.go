@@ -26,11 +26,17 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/builder"
 	"github.com/apache/camel-k/pkg/platform"
 	"github.com/apache/camel-k/pkg/util/log"
 	"github.com/apache/camel-k/pkg/util/test"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestTimeouts_Default(t *testing.T) {
@@ -115,3 +121,36 @@ func TestTimeouts_Truncated(t *testing.T) {
 
 	assert.Equal(t, 5*time.Minute, answer.Status.Build.GetTimeout().Duration)
 }
+
+func TestKanikoCache_PersistentVolumeClaim(t *testing.T) {
+	ip := v1.IntegrationPlatform{}
+	ip.Namespace = "ns"
+	ip.Name = xid.New().String()
+	ip.Spec.Cluster = v1.IntegrationPlatformClusterKubernetes
+	ip.Spec.Profile = v1.TraitProfileKubernetes
+	ip.Spec.Build.PublishStrategy = v1.IntegrationPlatformBuildPublishStrategyKaniko
+	ip.Spec.Build.Registry.Address = "registry"
+	ip.Spec.Build.AddOption(builder.KanikoBuildCacheEnabled, "true")
+	ip.Spec.Build.AddOption(builder.KanikoPVCSize, "5Gi")
+	ip.Spec.Build.AddOption(builder.KanikoPVCStorageClass, "fast")
+
+	c, err := test.NewFakeClient(&ip)
+	assert.Nil(t, err)
+
+	assert.Nil(t, platform.ConfigureDefaults(context.TODO(), c, &ip, false))
+
+	h := NewInitializeAction()
+	h.InjectLogger(log.Log)
+	h.InjectClient(c)
+
+	answer, err := h.Handle(context.TODO(), &ip)
+	assert.Nil(t, err)
+	assert.NotNil(t, answer)
+	assert.Equal(t, v1.IntegrationPlatformPhaseWarming, answer.Status.Phase)
+
+	pvc := corev1.PersistentVolumeClaim{}
+	err = c.Get(context.TODO(), ctrl.ObjectKey{Namespace: ip.Namespace, Name: ip.Name}, &pvc)
+	assert.Nil(t, err)
+	assert.Equal(t, resource.MustParse("5Gi"), pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+	assert.Equal(t, "fast", *pvc.Spec.StorageClassName)
+}
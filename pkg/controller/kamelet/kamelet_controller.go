@@ -29,7 +29,6 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -39,12 +38,13 @@ import (
 	camelevent "github.com/apache/camel-k/pkg/event"
 	"github.com/apache/camel-k/pkg/platform"
 	"github.com/apache/camel-k/pkg/util/monitoring"
+	"github.com/apache/camel-k/pkg/util/workqueue"
 )
 
 // Add creates a new Kamelet Controller and adds it to the Manager. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
-func Add(mgr manager.Manager, c client.Client) error {
-	return add(mgr, newReconciler(mgr, c))
+func Add(mgr manager.Manager, c client.Client, options workqueue.Options) error {
+	return add(mgr, newReconciler(mgr, c), options)
 }
 
 func newReconciler(mgr manager.Manager, c client.Client) reconcile.Reconciler {
@@ -62,9 +62,16 @@ func newReconciler(mgr manager.Manager, c client.Client) reconcile.Reconciler {
 	)
 }
 
-func add(mgr manager.Manager, r reconcile.Reconciler) error {
+func add(mgr manager.Manager, r reconcile.Reconciler, options workqueue.Options) error {
+	controllerOptions := options.ControllerOptions(v1alpha1.KameletKind, r)
+	if controllerOptions.MaxConcurrentReconciles == 0 {
+		// The Kamelets reconciles are cheap, so that they run concurrently by default
+		controllerOptions.MaxConcurrentReconciles = goruntime.GOMAXPROCS(0)
+	}
+
 	return builder.ControllerManagedBy(mgr).
 		Named("kamelet-controller").
+		WithOptions(controllerOptions).
 		// Watch for changes to primary resource Kamelet
 		For(&v1alpha1.Kamelet{}, builder.WithPredicates(
 			platform.FilteringFuncs{
@@ -88,9 +95,6 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 					return !e.DeleteStateUnknown
 				},
 			})).
-		WithOptions(controller.Options{
-			MaxConcurrentReconciles: goruntime.GOMAXPROCS(0),
-		}).
 		Complete(r)
 }
 
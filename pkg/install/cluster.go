@@ -100,6 +100,13 @@ func installClusterRoles(
 		_ = installResource(ctx, c, collection, "/rbac/operator-cluster-role-local-registry.yaml")
 	}
 
+	// ClusterRole: camel-k-operator-nodes
+	ok, err = isClusterRoleInstalled(ctx, c, "camel-k-operator-nodes")
+	if err == nil && !ok {
+		// ignore errors
+		_ = installResource(ctx, c, collection, "/rbac/operator-cluster-role-nodes.yaml")
+	}
+
 	// === For OpenShift ===
 	// ClusterRole: camel-k-operator-console-openshift
 	isOpenShift, err := isOpenShift(c, clusterType)
@@ -20,7 +20,9 @@ package install
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -53,9 +55,12 @@ type OperatorConfiguration struct {
 	CustomImagePullPolicy string
 	Namespace             string
 	Global                bool
+	WatchNamespaces       []string
 	ClusterType           string
 	Health                OperatorHealthConfiguration
 	Monitoring            OperatorMonitoringConfiguration
+	Webhook               OperatorWebhookConfiguration
+	WorkQueue             OperatorWorkQueueConfiguration
 	Tolerations           []string
 	NodeSelectors         []string
 	ResourcesRequirements []string
@@ -71,6 +76,45 @@ type OperatorMonitoringConfiguration struct {
 	Port    int32
 }
 
+type OperatorWebhookConfiguration struct {
+	Enabled bool
+	Port    int32
+}
+
+// OperatorWorkQueueConfiguration holds the controllers work queues settings, the operator defaults are used for the unset ones.
+type OperatorWorkQueueConfiguration struct {
+	MaxConcurrentReconciles []string
+	RateLimiterBaseDelay    time.Duration
+	RateLimiterMaxDelay     time.Duration
+	RateLimiterQPS          float64
+	RateLimiterBurst        int
+	SyncPeriod              time.Duration
+}
+
+// args returns the operator arguments of the set work queues settings.
+func (c OperatorWorkQueueConfiguration) args() []string {
+	var args []string
+	for _, value := range c.MaxConcurrentReconciles {
+		args = append(args, fmt.Sprintf("--max-concurrent-reconciles=%s", value))
+	}
+	if c.RateLimiterBaseDelay > 0 {
+		args = append(args, fmt.Sprintf("--rate-limiter-base-delay=%s", c.RateLimiterBaseDelay))
+	}
+	if c.RateLimiterMaxDelay > 0 {
+		args = append(args, fmt.Sprintf("--rate-limiter-max-delay=%s", c.RateLimiterMaxDelay))
+	}
+	if c.RateLimiterQPS > 0 {
+		args = append(args, fmt.Sprintf("--rate-limiter-qps=%s", strconv.FormatFloat(c.RateLimiterQPS, 'f', -1, 64)))
+	}
+	if c.RateLimiterBurst > 0 {
+		args = append(args, fmt.Sprintf("--rate-limiter-burst=%d", c.RateLimiterBurst))
+	}
+	if c.SyncPeriod > 0 {
+		args = append(args, fmt.Sprintf("--sync-period=%s", c.SyncPeriod))
+	}
+	return args
+}
+
 // OperatorOrCollect installs the operator resources or adds them to the collector if present.
 // nolint: maintidx // TODO: refactor the code
 func OperatorOrCollect(ctx context.Context, cmd *cobra.Command, c client.Client, cfg OperatorConfiguration, collection *kubernetes.Collection, force bool) error {
@@ -158,14 +202,47 @@ func OperatorOrCollect(ctx context.Context, cmd *cobra.Command, c client.Client,
 				d.Spec.Template.Spec.Containers[0].Args = append(d.Spec.Template.Spec.Containers[0].Args,
 					fmt.Sprintf("--health-port=%d", cfg.Health.Port))
 				d.Spec.Template.Spec.Containers[0].LivenessProbe.HTTPGet.Port = intstr.FromInt(int(cfg.Health.Port))
+				d.Spec.Template.Spec.Containers[0].ReadinessProbe.HTTPGet.Port = intstr.FromInt(int(cfg.Health.Port))
+				// Controllers work queues
+				d.Spec.Template.Spec.Containers[0].Args = append(d.Spec.Template.Spec.Containers[0].Args, cfg.WorkQueue.args()...)
+			}
+		}
+
+		if cfg.Webhook.Enabled {
+			if d, ok := o.(*appsv1.Deployment); ok {
+				if d.Labels["camel.apache.org/component"] == "operator" {
+					// Validating admission webhooks port, and serving certificate
+					container := &d.Spec.Template.Spec.Containers[0]
+					container.Args = append(container.Args,
+						fmt.Sprintf("--webhook-port=%d", cfg.Webhook.Port),
+						fmt.Sprintf("--webhook-cert-dir=%s", WebhookCertDir))
+					container.Ports = append(container.Ports, corev1.ContainerPort{
+						Name:          "webhook",
+						ContainerPort: cfg.Webhook.Port,
+						Protocol:      corev1.ProtocolTCP,
+					})
+					container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+						Name:      "webhook-cert",
+						MountPath: WebhookCertDir,
+						ReadOnly:  true,
+					})
+					d.Spec.Template.Spec.Volumes = append(d.Spec.Template.Spec.Volumes, corev1.Volume{
+						Name: "webhook-cert",
+						VolumeSource: corev1.VolumeSource{
+							Secret: &corev1.SecretVolumeSource{
+								SecretName: WebhookCertSecretName,
+							},
+						},
+					})
+				}
 			}
 		}
 
 		if cfg.Global {
 			if d, ok := o.(*appsv1.Deployment); ok {
 				if d.Labels["camel.apache.org/component"] == "operator" {
-					// Make the operator watch all namespaces
-					envvar.SetVal(&d.Spec.Template.Spec.Containers[0].Env, "WATCH_NAMESPACE", "")
+					// Make the operator watch all namespaces, or the given list of namespaces
+					envvar.SetVal(&d.Spec.Template.Spec.Containers[0].Env, "WATCH_NAMESPACE", strings.Join(cfg.WatchNamespaces, ","))
 				}
 			}
 
@@ -241,6 +318,16 @@ func OperatorOrCollect(ctx context.Context, cmd *cobra.Command, c client.Client,
 		return err
 	}
 
+	if cfg.Webhook.Enabled {
+		if err := installWebhooks(ctx, c, cfg, customizer, collection, force); err != nil {
+			if k8serrors.IsForbidden(err) {
+				fmt.Fprintln(cmd.ErrOrStderr(), "Warning: the creation of the validating admission webhooks is not allowed. Try installing the operator as cluster-admin.")
+			} else {
+				return err
+			}
+		}
+	}
+
 	// Additionally, install Knative resources (roles and bindings)
 	isKnative, err := knative.IsInstalled(c)
 	if err != nil {
@@ -302,6 +389,10 @@ func OperatorOrCollect(ctx context.Context, cmd *cobra.Command, c client.Client,
 		fmt.Fprintln(cmd.ErrOrStderr(), "Warning: the operator won't be able to detect a local image registry via KEP-1755")
 	}
 
+	if err = installClusterRoleBinding(ctx, c, collection, cfg.Namespace, "camel-k-operator-nodes", "/rbac/operator-cluster-role-binding-nodes.yaml"); err != nil {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Warning: the operator will not be able to gate the readiness of the integrations running on spot nodes on their preemption. Try installing the operator as cluster-admin.")
+	}
+
 	if cfg.Monitoring.Enabled {
 		if err := installMonitoringResources(ctx, c, cfg.Namespace, customizer, collection, force); err != nil {
 			switch {
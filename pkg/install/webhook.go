@@ -0,0 +1,209 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/resources"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+const (
+	// WebhookServiceName is the name of the Service the validating admission webhooks are served with.
+	WebhookServiceName = "camel-k-operator-webhook"
+	// WebhookCertSecretName is the name of the Secret holding the validating admission webhooks serving certificate.
+	WebhookCertSecretName = "camel-k-operator-webhook-cert"
+	// WebhookCertDir is the directory the validating admission webhooks serving certificate is mounted into the operator container.
+	WebhookCertDir = "/etc/camel-k/webhook/certs"
+
+	webhookCertValidity = 10 * 365 * 24 * time.Hour
+)
+
+// WebhookConfigurationName returns the name of the cluster-wide ValidatingWebhookConfiguration
+// of the operator installed in the given namespace.
+func WebhookConfigurationName(namespace string) string {
+	return fmt.Sprintf("camel-k-operator-%s", namespace)
+}
+
+// installWebhooks installs the Service, the serving certificate Secret, and the ValidatingWebhookConfiguration
+// of the operator validating admission webhooks. The serving certificate is self-signed, and kept
+// when the operator is re-installed, so that the CA bundle of the webhooks remains valid.
+func installWebhooks(ctx context.Context, c client.Client, cfg OperatorConfiguration, customizer ResourceCustomizer,
+	collection *kubernetes.Collection, force bool) error {
+	caBundle, err := webhookCertSecretOrCollect(ctx, c, cfg.Namespace, collection)
+	if err != nil {
+		return err
+	}
+
+	if err := ResourceOrCollect(ctx, c, cfg.Namespace, collection, force, customizer, "/webhook/operator-webhook-service.yaml"); err != nil {
+		return err
+	}
+
+	path := "/webhook/operator-validating-webhook-configuration.yaml"
+	content, err := resources.ResourceAsString(path)
+	if err != nil {
+		return err
+	}
+	obj, err := kubernetes.LoadResourceFromYaml(c.GetScheme(), content)
+	if err != nil {
+		return err
+	}
+	wc, ok := obj.(*admissionregistrationv1.ValidatingWebhookConfiguration)
+	if !ok {
+		return fmt.Errorf("file %s does not contain a ValidatingWebhookConfiguration resource", path)
+	}
+	wc.Name = WebhookConfigurationName(cfg.Namespace)
+	for i := range wc.Webhooks {
+		wc.Webhooks[i].ClientConfig.Service.Namespace = cfg.Namespace
+		wc.Webhooks[i].ClientConfig.CABundle = caBundle
+		wc.Webhooks[i].NamespaceSelector = webhookNamespaceSelector(cfg)
+	}
+
+	// The ValidatingWebhookConfiguration is cluster-scoped, so that it's not created in the operator namespace
+	if collection != nil {
+		collection.Add(wc)
+		return nil
+	}
+	if force {
+		_, err := kubernetes.ReplaceResource(ctx, c, wc)
+		return err
+	}
+	return c.Create(ctx, wc)
+}
+
+// webhookNamespaceSelector restricts the validating admission webhooks to the namespaces the operator watches.
+func webhookNamespaceSelector(cfg OperatorConfiguration) *metav1.LabelSelector {
+	namespaces := cfg.WatchNamespaces
+	if !cfg.Global {
+		namespaces = []string{cfg.Namespace}
+	}
+	if len(namespaces) == 0 {
+		return &metav1.LabelSelector{}
+	}
+	return &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      corev1.LabelMetadataName,
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   namespaces,
+			},
+		},
+	}
+}
+
+// webhookCertSecretOrCollect returns the CA bundle of the existing serving certificate Secret, or generates
+// a new serving certificate, creates its Secret on the cluster (or appends it to the collection), and returns its CA bundle.
+func webhookCertSecretOrCollect(ctx context.Context, c client.Client, namespace string, collection *kubernetes.Collection) ([]byte, error) {
+	if collection == nil {
+		secret := corev1.Secret{}
+		err := c.Get(ctx, ctrl.ObjectKey{Namespace: namespace, Name: WebhookCertSecretName}, &secret)
+		if err == nil && len(secret.Data[corev1.ServiceAccountRootCAKey]) > 0 {
+			return secret.Data[corev1.ServiceAccountRootCAKey], nil
+		} else if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	cert, key, err := newWebhookCert(fmt.Sprintf("%s.%s.svc", WebhookServiceName, namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	secret := corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      WebhookCertSecretName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app": "camel-k",
+			},
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:              cert,
+			corev1.TLSPrivateKeyKey:        key,
+			corev1.ServiceAccountRootCAKey: cert,
+		},
+	}
+
+	// The Secret is replaced, as it may exist without the CA certificate
+	if err := ObjectOrCollect(ctx, c, namespace, collection, true, &secret); err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
+
+// newWebhookCert generates a self-signed serving certificate for the given DNS name,
+// and returns the PEM encoded certificate and private key.
+func newWebhookCert(dnsName string) ([]byte, []byte, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, err
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: dnsName},
+		DNSNames:              []string{dnsName},
+		NotBefore:             now,
+		NotAfter:              now.Add(webhookCertValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var cert, privateKey bytes.Buffer
+	if err := pem.Encode(&cert, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+		return nil, nil, err
+	}
+	if err := pem.Encode(&privateKey, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}); err != nil {
+		return nil, nil, err
+	}
+	return cert.Bytes(), privateKey.Bytes(), nil
+}
@@ -0,0 +1,87 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestInstallWebhooks(t *testing.T) {
+	c, err := test.NewFakeClient()
+	assert.Nil(t, err)
+	cfg := OperatorConfiguration{
+		Namespace: "camel-k",
+		Webhook: OperatorWebhookConfiguration{
+			Enabled: true,
+			Port:    9443,
+		},
+	}
+
+	err = installWebhooks(context.TODO(), c, cfg, IdentityResourceCustomizer, nil, false)
+	assert.Nil(t, err)
+
+	secret := corev1.Secret{}
+	err = c.Get(context.TODO(), ctrl.ObjectKey{Namespace: "camel-k", Name: WebhookCertSecretName}, &secret)
+	assert.Nil(t, err)
+	block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+	assert.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"camel-k-operator-webhook.camel-k.svc"}, cert.DNSNames)
+
+	service := corev1.Service{}
+	err = c.Get(context.TODO(), ctrl.ObjectKey{Namespace: "camel-k", Name: WebhookServiceName}, &service)
+	assert.Nil(t, err)
+
+	configuration := admissionregistrationv1.ValidatingWebhookConfiguration{}
+	err = c.Get(context.TODO(), ctrl.ObjectKey{Name: WebhookConfigurationName("camel-k")}, &configuration)
+	assert.Nil(t, err)
+	assert.Len(t, configuration.Webhooks, 2)
+	for _, webhook := range configuration.Webhooks {
+		assert.Equal(t, "camel-k", webhook.ClientConfig.Service.Namespace)
+		assert.Equal(t, secret.Data[corev1.ServiceAccountRootCAKey], webhook.ClientConfig.CABundle)
+		assert.Equal(t, []string{"camel-k"}, webhook.NamespaceSelector.MatchExpressions[0].Values)
+	}
+
+	// The serving certificate is kept when the operator is re-installed
+	err = installWebhooks(context.TODO(), c, cfg, IdentityResourceCustomizer, nil, true)
+	assert.Nil(t, err)
+	err = c.Get(context.TODO(), ctrl.ObjectKey{Name: WebhookConfigurationName("camel-k")}, &configuration)
+	assert.Nil(t, err)
+	assert.Equal(t, secret.Data[corev1.ServiceAccountRootCAKey], configuration.Webhooks[0].ClientConfig.CABundle)
+}
+
+func TestWebhookNamespaceSelector(t *testing.T) {
+	selector := webhookNamespaceSelector(OperatorConfiguration{Namespace: "camel-k", Global: true})
+	assert.Empty(t, selector.MatchExpressions)
+
+	selector = webhookNamespaceSelector(OperatorConfiguration{Namespace: "camel-k", Global: true, WatchNamespaces: []string{"ns1", "ns2"}})
+	assert.Equal(t, []string{"ns1", "ns2"}, selector.MatchExpressions[0].Values)
+}
@@ -54,6 +54,14 @@ func merge(m1 src.Metadata, m2 src.Metadata) src.Metadata {
 	t = append(t, m1.ToURIs...)
 	t = append(t, m2.ToURIs...)
 
+	p := make([]src.ConsumerPort, 0, len(m1.ConsumerPorts)+len(m2.ConsumerPorts))
+	p = append(p, m1.ConsumerPorts...)
+	for _, port := range m2.ConsumerPorts {
+		if !containsConsumerPort(p, port.Name) {
+			p = append(p, port)
+		}
+	}
+
 	return src.Metadata{
 		FromURIs:             f,
 		ToURIs:               t,
@@ -61,7 +69,17 @@ func merge(m1 src.Metadata, m2 src.Metadata) src.Metadata {
 		RequiredCapabilities: strset.Union(m1.RequiredCapabilities, m2.RequiredCapabilities),
 		ExposesHTTPServices:  m1.ExposesHTTPServices || m2.ExposesHTTPServices,
 		PassiveEndpoints:     m1.PassiveEndpoints && m2.PassiveEndpoints,
+		ConsumerPorts:        p,
+	}
+}
+
+func containsConsumerPort(ports []src.ConsumerPort, name string) bool {
+	for _, port := range ports {
+		if port.Name == name {
+			return true
+		}
 	}
+	return false
 }
 
 // Extract returns metadata information from the source code.
@@ -25,6 +25,7 @@ import (
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/util/camel"
+	src "github.com/apache/camel-k/pkg/util/source"
 )
 
 func TestHttpJavaSource(t *testing.T) {
@@ -282,3 +283,33 @@ func TestMultilangHTTPSource(t *testing.T) {
 	assert.True(t, meta.ExposesHTTPServices)
 	assert.False(t, meta.PassiveEndpoints)
 }
+
+func TestConsumerPorts(t *testing.T) {
+	code := v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name: "Request.java",
+			Content: `
+			from("netty-http:http://0.0.0.0:8080/test").to("log:stash");
+			from("netty:tcp://0.0.0.0:5150?sync=false").to("log:stash");
+			from("netty:udp://0.0.0.0:5150").to("log:stash");
+			from("grpc://0.0.0.0:50051/org.acme.Greeter").to("log:stash");
+			from("grpc:localhost:50051/org.acme.Greeter").to("log:stash");
+			from("netty:tcp://0.0.0.0:{{port}}").to("log:stash");
+			from("netty:tcp://0.0.0.0:5150").to("netty:tcp://remote:5151");
+		`,
+		},
+		Language: v1.LanguageJavaSource,
+	}
+
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	meta, err := Extract(catalog, code)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []src.ConsumerPort{
+		{Name: "netty-5150", Port: 5150, Protocol: "TCP"},
+		{Name: "netty-udp-5150", Port: 5150, Protocol: "UDP"},
+		{Name: "grpc-50051", Port: 50051, Protocol: "TCP"},
+	}, meta.ConsumerPorts)
+}
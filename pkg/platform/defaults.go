@@ -233,6 +233,15 @@ func setPlatformDefaults(p *v1.IntegrationPlatform, verbose bool) error {
 			Duration: 5 * time.Minute,
 		}
 	}
+	if p.Status.Build.MaxRunningBuilds == nil {
+		log.Debugf("Integration Platform [%s]: setting max running builds", p.Namespace)
+		// Routine builds run in the operator Pod, so they are more constrained than Pod builds
+		maxRunningBuilds := int32(10)
+		if p.Status.Build.BuildStrategy == v1.BuildStrategyRoutine {
+			maxRunningBuilds = 3
+		}
+		p.Status.Build.MaxRunningBuilds = &maxRunningBuilds
+	}
 	_, cacheEnabled := p.Status.Build.PublishStrategyOptions[builder.KanikoBuildCacheEnabled]
 	if p.Status.Build.PublishStrategy == v1.IntegrationPlatformBuildPublishStrategyKaniko && !cacheEnabled {
 		// Default to disabling Kaniko cache warmer
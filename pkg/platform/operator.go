@@ -24,7 +24,9 @@ import (
 	"strings"
 
 	camelv1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/client"
 	"github.com/apache/camel-k/pkg/util/defaults"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
 	coordination "k8s.io/api/coordination/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -44,12 +46,17 @@ const OperatorLockName = "camel-k-lock"
 
 var OperatorImage string
 
-// IsCurrentOperatorGlobal returns true if the operator is configured to watch all namespaces.
+// IsCurrentOperatorGlobal returns true if the operator is configured to watch all namespaces,
+// or a list of namespaces.
 func IsCurrentOperatorGlobal() bool {
 	if watchNamespace, envSet := os.LookupEnv(OperatorWatchNamespaceEnvVariable); !envSet || strings.TrimSpace(watchNamespace) == "" {
 		log.Debug("Operator is global to all namespaces")
 		return true
 	}
+	if len(GetOperatorWatchNamespaces()) > 1 {
+		log.Debug("Operator is global to a list of namespaces")
+		return true
+	}
 
 	log.Debug("Operator is local to namespace")
 	return false
@@ -63,6 +70,33 @@ func GetOperatorWatchNamespace() string {
 	return ""
 }
 
+// GetOperatorWatchNamespaces returns the list of namespaces the operator watches,
+// set as a comma separated list. It returns nil when the operator watches all namespaces.
+func GetOperatorWatchNamespaces() []string {
+	var namespaces []string
+	for _, namespace := range strings.Split(GetOperatorWatchNamespace(), ",") {
+		if namespace = strings.TrimSpace(namespace); namespace != "" {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces
+}
+
+// CheckPermissionInWatchNamespaces checks the operator is granted the permission in all the namespaces it watches.
+func CheckPermissionInWatchNamespaces(ctx context.Context, c client.Client, group, resource, verb string) (bool, error) {
+	namespaces := GetOperatorWatchNamespaces()
+	if len(namespaces) == 0 {
+		// Cluster-wide permission
+		namespaces = []string{""}
+	}
+	for _, namespace := range namespaces {
+		if ok, err := kubernetes.CheckPermission(ctx, c, group, resource, namespace, "", verb); err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
 // GetOperatorNamespace returns the namespace where the current operator is located (if set).
 func GetOperatorNamespace() string {
 	if podNamespace, envSet := os.LookupEnv(operatorNamespaceEnvVariable); envSet {
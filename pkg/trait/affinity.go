@@ -20,17 +20,44 @@ package trait
 import (
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/pointer"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util"
+)
+
+const (
+	integrationsAntiAffinityRequired  = "required"
+	integrationsAntiAffinityPreferred = "preferred"
+
+	integrationsAntiAffinityNamespaceScope = "namespace"
+	integrationsAntiAffinityClusterScope   = "cluster"
+
+	nodeArchitectureLabel = "kubernetes.io/arch"
+
+	imageArchitecturesCacheSize = 512
+	imageArchitecturesCacheTTL  = 24 * time.Hour
 )
 
+// The architectures the integration pods can be constrained to.
+var affinityArchitectures = []string{"amd64", "arm64", "arm", "ppc64le", "s390x"}
+
+// The architectures of the single-arch images, or empty for the multi-arch ones, that are inspected by digest.
+// The cache is bounded, as the operator may inspect many images over time.
+var imageArchitectures = cache.NewLRUExpireCache(imageArchitecturesCacheSize)
+
 type affinityTrait struct {
 	BaseTrait
 	traitv1.AffinityTrait `property:",squash"`
@@ -55,6 +82,40 @@ func (t *affinityTrait) Configure(e *Environment) (bool, error) {
 		return false, fmt.Errorf("both pod affinity and pod anti-affinity can't be set simultaneously")
 	}
 
+	if pointer.BoolDeref(t.IntegrationsAntiAffinity, false) {
+		if pointer.BoolDeref(t.PodAffinity, false) {
+			return false, fmt.Errorf("both pod affinity and integrations anti-affinity can't be set simultaneously")
+		}
+		switch t.IntegrationsAntiAffinityType {
+		case "", integrationsAntiAffinityRequired, integrationsAntiAffinityPreferred:
+		default:
+			return false, fmt.Errorf("unsupported integrations anti-affinity type: %s", t.IntegrationsAntiAffinityType)
+		}
+		switch t.IntegrationsAntiAffinityScope {
+		case "", integrationsAntiAffinityNamespaceScope, integrationsAntiAffinityClusterScope:
+		default:
+			return false, fmt.Errorf("unsupported integrations anti-affinity scope: %s", t.IntegrationsAntiAffinityScope)
+		}
+	}
+
+	if t.Architecture != "" && !util.StringSliceExists(affinityArchitectures, t.Architecture) {
+		return false, fmt.Errorf("unsupported architecture %q, expected one of %s", t.Architecture, strings.Join(affinityArchitectures, ", "))
+	}
+
+	for _, key := range t.TopologySpreadKeys {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return false, fmt.Errorf("invalid topology spread key %q: %s", key, strings.Join(errs, ", "))
+		}
+	}
+	if t.TopologySpreadMaxSkew != nil && *t.TopologySpreadMaxSkew < 1 {
+		return false, fmt.Errorf("invalid topology spread max skew %d: it must be positive", *t.TopologySpreadMaxSkew)
+	}
+	switch corev1.UnsatisfiableConstraintAction(t.TopologySpreadWhenUnsatisfiable) {
+	case "", corev1.DoNotSchedule, corev1.ScheduleAnyway:
+	default:
+		return false, fmt.Errorf("unsupported topology spread when unsatisfiable action: %s", t.TopologySpreadWhenUnsatisfiable)
+	}
+
 	return e.IntegrationInRunningPhases(), nil
 }
 
@@ -76,9 +137,151 @@ func (t *affinityTrait) Apply(e *Environment) error {
 	if err := t.addPodAntiAffinity(e, podSpec); err != nil {
 		return err
 	}
+	t.addIntegrationsAntiAffinity(podSpec)
+	t.addTopologySpreadConstraints(e, podSpec)
+
+	if pointer.BoolDeref(t.ArchitectureAffinity, false) || t.Architecture != "" {
+		// The integration container image is only known once all the traits are applied
+		e.PostProcessors = append(e.PostProcessors, t.addArchitectureAffinity)
+	} else {
+		e.Integration.Status.RemoveCondition(v1.IntegrationConditionArchitectureAffinity)
+	}
 	return nil
 }
 
+// addArchitectureAffinity constrains the integration pods to the nodes of the architecture of the integration image,
+// unless it's a multi-arch image, and reports the constraint with the ArchitectureAffinity condition.
+func (t *affinityTrait) addArchitectureAffinity(e *Environment) error {
+	podSpec := e.GetIntegrationPodSpec()
+	container := e.GetIntegrationContainer()
+	if podSpec == nil || container == nil {
+		return nil
+	}
+
+	architecture := t.Architecture
+	message := fmt.Sprintf("pods constrained to the %s nodes", architecture)
+	if architecture == "" {
+		var err error
+		if architecture, err = inspectImageArchitecture(e, container.Image); err != nil {
+			// The constraint is best effort, and doesn't prevent the integration from being deployed
+			t.L.ForIntegration(e.Integration).Errorf(err, "Unable to inspect the architecture of image %s", container.Image)
+			e.Integration.Status.SetCondition(
+				v1.IntegrationConditionArchitectureAffinity,
+				corev1.ConditionFalse,
+				v1.IntegrationConditionArchitectureInspectionFailedReason,
+				fmt.Sprintf("unable to inspect the architecture of image %s: %v", container.Image, err),
+			)
+			return nil
+		}
+		if architecture == "" {
+			e.Integration.Status.SetCondition(
+				v1.IntegrationConditionArchitectureAffinity,
+				corev1.ConditionFalse,
+				v1.IntegrationConditionMultiArchImageReason,
+				fmt.Sprintf("image %s is built for multiple architectures", container.Image),
+			)
+			return nil
+		}
+		message = fmt.Sprintf("pods constrained to the %s nodes, as image %s is built for this architecture only",
+			architecture, container.Image)
+	}
+
+	requirement := corev1.NodeSelectorRequirement{
+		Key:      nodeArchitectureLabel,
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   []string{architecture},
+	}
+	if podSpec.Affinity == nil {
+		podSpec.Affinity = &corev1.Affinity{}
+	}
+	if podSpec.Affinity.NodeAffinity == nil {
+		podSpec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	nodeAffinity := podSpec.Affinity.NodeAffinity
+	if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+	}
+	selector := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(selector.NodeSelectorTerms) == 0 {
+		selector.NodeSelectorTerms = []corev1.NodeSelectorTerm{{}}
+	}
+	// The node selector terms are ORed, so the requirement must be added to each of them
+	for i := range selector.NodeSelectorTerms {
+		term := &selector.NodeSelectorTerms[i]
+		term.MatchExpressions = append(term.MatchExpressions, requirement)
+	}
+
+	e.Integration.Status.SetCondition(
+		v1.IntegrationConditionArchitectureAffinity,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionArchitectureAffinityReason,
+		message,
+	)
+	return nil
+}
+
+// inspectImageArchitecture returns the architecture of the given image, or an empty string if it's a multi-arch image.
+func inspectImageArchitecture(e *Environment, image string) (string, error) {
+	ref, options, err := remoteImageReference(e, image)
+	if err != nil {
+		return "", err
+	}
+
+	// Only the images referenced by digest are immutable, while a tag may be moved to an image built
+	// for another architecture after the pods have been constrained
+	if _, ok := ref.(name.Digest); !ok {
+		return "", fmt.Errorf("image %s is not referenced by digest", image)
+	}
+	if architecture, ok := imageArchitectures.Get(image); ok {
+		return architecture.(string), nil
+	}
+
+	descriptor, err := remote.Get(ref, options...)
+	if err != nil {
+		return "", err
+	}
+
+	var architecture string
+	if descriptor.MediaType.IsIndex() {
+		index, err := descriptor.ImageIndex()
+		if err != nil {
+			return "", err
+		}
+		manifest, err := index.IndexManifest()
+		if err != nil {
+			return "", err
+		}
+		architectures := make(map[string]bool)
+		for _, m := range manifest.Manifests {
+			// Skip the attestation manifests, that have an unknown platform
+			if m.Platform != nil && m.Platform.Architecture != "" && m.Platform.Architecture != "unknown" {
+				architectures[m.Platform.Architecture] = true
+			}
+		}
+		if len(architectures) == 1 {
+			for a := range architectures {
+				architecture = a
+			}
+		}
+	} else {
+		img, err := descriptor.Image()
+		if err != nil {
+			return "", err
+		}
+		config, err := img.ConfigFile()
+		if err != nil {
+			return "", err
+		}
+		if config.Architecture == "" {
+			return "", fmt.Errorf("image %s has no architecture", image)
+		}
+		architecture = config.Architecture
+	}
+
+	imageArchitectures.Add(image, architecture, imageArchitecturesCacheTTL)
+	return architecture, nil
+}
+
 func (t *affinityTrait) addNodeAffinity(_ *Environment, podSpec *corev1.PodSpec) error {
 	if len(t.NodeAffinityLabels) == 0 {
 		return nil
@@ -219,6 +422,62 @@ func (t *affinityTrait) addPodAntiAffinity(e *Environment, podSpec *corev1.PodSp
 	return nil
 }
 
+func (t *affinityTrait) addIntegrationsAntiAffinity(podSpec *corev1.PodSpec) {
+	if !pointer.BoolDeref(t.IntegrationsAntiAffinity, false) {
+		return
+	}
+
+	term := corev1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{
+					Key:      v1.IntegrationLabel,
+					Operator: metav1.LabelSelectorOpExists,
+				},
+			},
+		},
+		TopologyKey: "kubernetes.io/hostname",
+	}
+	if t.IntegrationsAntiAffinityScope == integrationsAntiAffinityClusterScope {
+		// An empty namespace selector matches all the namespaces
+		term.NamespaceSelector = &metav1.LabelSelector{}
+	}
+
+	if podSpec.Affinity.PodAntiAffinity == nil {
+		podSpec.Affinity.PodAntiAffinity = &corev1.PodAntiAffinity{}
+	}
+	podAntiAffinity := podSpec.Affinity.PodAntiAffinity
+	if t.IntegrationsAntiAffinityType == integrationsAntiAffinityPreferred {
+		podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+			corev1.WeightedPodAffinityTerm{
+				Weight:          100,
+				PodAffinityTerm: term,
+			})
+	} else {
+		podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, term)
+	}
+}
+
+func (t *affinityTrait) addTopologySpreadConstraints(e *Environment, podSpec *corev1.PodSpec) {
+	whenUnsatisfiable := corev1.UnsatisfiableConstraintAction(t.TopologySpreadWhenUnsatisfiable)
+	if whenUnsatisfiable == "" {
+		whenUnsatisfiable = corev1.DoNotSchedule
+	}
+
+	for _, key := range t.TopologySpreadKeys {
+		podSpec.TopologySpreadConstraints = append(podSpec.TopologySpreadConstraints, corev1.TopologySpreadConstraint{
+			MaxSkew:           pointer.Int32Deref(t.TopologySpreadMaxSkew, 1),
+			TopologyKey:       key,
+			WhenUnsatisfiable: whenUnsatisfiable,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					v1.IntegrationLabel: e.Integration.Name,
+				},
+			},
+		})
+	}
+}
+
 func operatorToNodeSelectorOperator(operator selection.Operator) (corev1.NodeSelectorOperator, error) {
 	switch operator {
 	case selection.In, selection.Equals, selection.DoubleEquals:
@@ -18,10 +18,22 @@ limitations under the License.
 package trait
 
 import (
+	"context"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
@@ -188,6 +200,368 @@ func testApplyPodAffinityLabelsDoesSucceed(t *testing.T, trait *affinityTrait, e
 	assert.ElementsMatch(t, [1]string{"integration-name"}, integrationRequirement.Values)
 }
 
+func TestConfigureIntegrationsAntiAffinityWithPodAffinityFails(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	environment, _ := createNominalDeploymentTraitTest()
+	affinityTrait.PodAffinity = pointer.Bool(true)
+	affinityTrait.IntegrationsAntiAffinity = pointer.Bool(true)
+	configured, err := affinityTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureIntegrationsAntiAffinityWithUnsupportedScopeFails(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	environment, _ := createNominalDeploymentTraitTest()
+	affinityTrait.IntegrationsAntiAffinity = pointer.Bool(true)
+	affinityTrait.IntegrationsAntiAffinityScope = "node"
+	configured, err := affinityTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.EqualError(t, err, "unsupported integrations anti-affinity scope: node")
+}
+
+func TestConfigureIntegrationsAntiAffinityWithUnsupportedTypeFails(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	environment, _ := createNominalDeploymentTraitTest()
+	affinityTrait.IntegrationsAntiAffinity = pointer.Bool(true)
+	affinityTrait.IntegrationsAntiAffinityType = "always"
+	configured, err := affinityTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.EqualError(t, err, "unsupported integrations anti-affinity type: always")
+}
+
+func TestApplyIntegrationsAntiAffinityDoesSucceed(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.PodAntiAffinity = pointer.Bool(true)
+	affinityTrait.IntegrationsAntiAffinity = pointer.Bool(true)
+
+	environment, deployment := createNominalDeploymentTraitTest()
+	configured, err := affinityTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+	err = affinityTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	podAntiAffinity := deployment.Spec.Template.Spec.Affinity.PodAntiAffinity
+	assert.NotNil(t, podAntiAffinity)
+	assert.Len(t, podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, 2)
+	assert.Empty(t, podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+	term := podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution[1]
+	assert.Equal(t, "kubernetes.io/hostname", term.TopologyKey)
+	assert.Nil(t, term.NamespaceSelector)
+	assert.Equal(t, []metav1.LabelSelectorRequirement{
+		{
+			Key:      v1.IntegrationLabel,
+			Operator: metav1.LabelSelectorOpExists,
+		},
+	}, term.LabelSelector.MatchExpressions)
+}
+
+func TestApplyPreferredClusterIntegrationsAntiAffinityDoesSucceed(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.IntegrationsAntiAffinity = pointer.Bool(true)
+	affinityTrait.IntegrationsAntiAffinityType = "preferred"
+	affinityTrait.IntegrationsAntiAffinityScope = "cluster"
+
+	environment, deployment := createNominalDeploymentTraitTest()
+	err := affinityTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	podAntiAffinity := deployment.Spec.Template.Spec.Affinity.PodAntiAffinity
+	assert.NotNil(t, podAntiAffinity)
+	assert.Empty(t, podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+	assert.Len(t, podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, 1)
+	term := podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0]
+	assert.Equal(t, int32(100), term.Weight)
+	assert.Equal(t, &metav1.LabelSelector{}, term.PodAffinityTerm.NamespaceSelector)
+	assert.Equal(t, v1.IntegrationLabel, term.PodAffinityTerm.LabelSelector.MatchExpressions[0].Key)
+	assert.Equal(t, metav1.LabelSelectorOpExists, term.PodAffinityTerm.LabelSelector.MatchExpressions[0].Operator)
+}
+
+func TestConfigureAffinityTraitWithUnsupportedArchitectureFails(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.Architecture = "riscv64"
+	environment, _ := createNominalDeploymentTraitTest()
+	configured, err := affinityTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.EqualError(t, err, `unsupported architecture "riscv64", expected one of amd64, arm64, arm, ppc64le, s390x`)
+}
+
+func TestApplyArchitectureAffinityForSingleArchImage(t *testing.T) {
+	host := startAffinityTestRegistry(t)
+	image := pushAffinityTestImage(t, host+"/test/single", "arm64")
+
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.ArchitectureAffinity = pointer.Bool(true)
+	affinityTrait.NodeAffinityLabels = []string{"zone in (a, b)"}
+	environment, deployment := createArchitectureAffinityTest(host, image)
+
+	applyArchitectureAffinity(t, affinityTrait, environment)
+
+	terms := deployment.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	assert.Len(t, terms, 1)
+	assert.Equal(t, []corev1.NodeSelectorRequirement{
+		{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a", "b"}},
+		{Key: "kubernetes.io/arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"arm64"}},
+	}, terms[0].MatchExpressions)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionArchitectureAffinity)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionArchitectureAffinityReason, condition.Reason)
+	assert.Equal(t, "pods constrained to the arm64 nodes, as image "+image+" is built for this architecture only", condition.Message)
+}
+
+func TestApplyArchitectureAffinityForMultiArchImage(t *testing.T) {
+	host := startAffinityTestRegistry(t)
+	ref, err := name.ParseReference(host+"/test/multi:latest", name.Insecure)
+	require.NoError(t, err)
+	var index ggcrv1.ImageIndex = empty.Index
+	for _, architecture := range []string{"amd64", "arm64"} {
+		img, err := random.Image(64, 1)
+		require.NoError(t, err)
+		index = mutate.AppendManifests(index, mutate.IndexAddendum{
+			Add: img,
+			Descriptor: ggcrv1.Descriptor{
+				Platform: &ggcrv1.Platform{OS: "linux", Architecture: architecture},
+			},
+		})
+	}
+	require.NoError(t, remote.WriteIndex(ref, index))
+	digest, err := index.Digest()
+	require.NoError(t, err)
+
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.ArchitectureAffinity = pointer.Bool(true)
+	environment, deployment := createArchitectureAffinityTest(host, host+"/test/multi@"+digest.String())
+
+	applyArchitectureAffinity(t, affinityTrait, environment)
+
+	assert.Nil(t, deployment.Spec.Template.Spec.Affinity.NodeAffinity)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionArchitectureAffinity)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionMultiArchImageReason, condition.Reason)
+}
+
+func TestApplyArchitectureAffinityOverride(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.Architecture = "amd64"
+	// The image is not inspected
+	environment, deployment := createArchitectureAffinityTest("localhost:1", "localhost:1/test/unreachable:latest")
+
+	applyArchitectureAffinity(t, affinityTrait, environment)
+
+	terms := deployment.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	assert.Equal(t, []corev1.NodeSelectorTerm{{
+		MatchExpressions: []corev1.NodeSelectorRequirement{
+			{Key: "kubernetes.io/arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"amd64"}},
+		},
+	}}, terms)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionArchitectureAffinity)
+	assert.NotNil(t, condition)
+	assert.Equal(t, "pods constrained to the amd64 nodes", condition.Message)
+}
+
+func TestApplyArchitectureAffinityInspectionFailure(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.ArchitectureAffinity = pointer.Bool(true)
+	environment, deployment := createArchitectureAffinityTest("localhost:1",
+		"localhost:1/test/unreachable@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+
+	applyArchitectureAffinity(t, affinityTrait, environment)
+
+	assert.Nil(t, deployment.Spec.Template.Spec.Affinity.NodeAffinity)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionArchitectureAffinity)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionArchitectureInspectionFailedReason, condition.Reason)
+}
+
+func TestApplyArchitectureAffinityForTaggedImage(t *testing.T) {
+	host := startAffinityTestRegistry(t)
+	image := pushAffinityTestImage(t, host+"/test/single", "arm64")
+	ref, err := name.ParseReference(image, name.Insecure)
+	require.NoError(t, err)
+	img, err := remote.Image(ref)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref.Context().Tag("latest"), img))
+
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.ArchitectureAffinity = pointer.Bool(true)
+	environment, deployment := createArchitectureAffinityTest(host, host+"/test/single:latest")
+
+	applyArchitectureAffinity(t, affinityTrait, environment)
+
+	// The tag may be moved to an image built for another architecture
+	assert.Nil(t, deployment.Spec.Template.Spec.Affinity.NodeAffinity)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionArchitectureAffinity)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionArchitectureInspectionFailedReason, condition.Reason)
+	assert.Contains(t, condition.Message, "is not referenced by digest")
+}
+
+func TestApplyWithoutArchitectureAffinityRemovesCondition(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	environment, _ := createNominalDeploymentTraitTest()
+	environment.Integration.Status.SetCondition(v1.IntegrationConditionArchitectureAffinity, corev1.ConditionTrue,
+		v1.IntegrationConditionArchitectureAffinityReason, "pods constrained to the amd64 nodes")
+
+	err := affinityTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Empty(t, environment.PostProcessors)
+	assert.Nil(t, environment.Integration.Status.GetCondition(v1.IntegrationConditionArchitectureAffinity))
+}
+
+func startAffinityTestRegistry(t *testing.T) string {
+	t.Helper()
+
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+	return strings.TrimPrefix(server.URL, "http://")
+}
+
+func pushAffinityTestImage(t *testing.T, repository string, architecture string) string {
+	t.Helper()
+
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	config, err := img.ConfigFile()
+	require.NoError(t, err)
+	config.Architecture = architecture
+	config.OS = "linux"
+	img, err = mutate.ConfigFile(img, config)
+	require.NoError(t, err)
+	digest, err := img.Digest()
+	require.NoError(t, err)
+
+	ref, err := name.ParseReference(repository+"@"+digest.String(), name.Insecure)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	return ref.String()
+}
+
+func createArchitectureAffinityTest(registryAddress string, image string) (*Environment, *appsv1.Deployment) {
+	environment, deployment := createNominalDeploymentTraitTest()
+	environment.Ctx = context.TODO()
+	environment.Catalog = NewCatalog(nil)
+	environment.Platform = &v1.IntegrationPlatform{
+		Status: v1.IntegrationPlatformStatus{
+			IntegrationPlatformSpec: v1.IntegrationPlatformSpec{
+				Build: v1.IntegrationPlatformBuildSpec{
+					Registry: v1.RegistrySpec{
+						Address:  registryAddress,
+						Insecure: true,
+					},
+				},
+			},
+		},
+	}
+	deployment.Spec.Template.Spec.Containers = []corev1.Container{
+		{
+			Name:  defaultContainerName,
+			Image: image,
+		},
+	}
+	return environment, deployment
+}
+
+func applyArchitectureAffinity(t *testing.T, trait *affinityTrait, environment *Environment) {
+	t.Helper()
+
+	configured, err := trait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+	err = trait.Apply(environment)
+	assert.Nil(t, err)
+	for _, processor := range environment.PostProcessors {
+		assert.Nil(t, processor(environment))
+	}
+}
+
+func TestApplyTopologySpreadConstraintsDoesSucceed(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.TopologySpreadKeys = []string{"topology.kubernetes.io/zone", "kubernetes.io/hostname"}
+	affinityTrait.TopologySpreadMaxSkew = pointer.Int32(2)
+	affinityTrait.TopologySpreadWhenUnsatisfiable = "ScheduleAnyway"
+
+	environment, deployment := createNominalDeploymentTraitTest()
+	configured, err := affinityTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+	err = affinityTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	constraints := deployment.Spec.Template.Spec.TopologySpreadConstraints
+	assert.Len(t, constraints, 2)
+	assert.Equal(t, "topology.kubernetes.io/zone", constraints[0].TopologyKey)
+	assert.Equal(t, "kubernetes.io/hostname", constraints[1].TopologyKey)
+	for _, constraint := range constraints {
+		assert.Equal(t, int32(2), constraint.MaxSkew)
+		assert.Equal(t, corev1.ScheduleAnyway, constraint.WhenUnsatisfiable)
+		assert.Equal(t, map[string]string{v1.IntegrationLabel: "integration-name"}, constraint.LabelSelector.MatchLabels)
+	}
+}
+
+func TestApplyTopologySpreadConstraintsOnKnativeService(t *testing.T) {
+	affinityTrait := createNominalAffinityTest()
+	affinityTrait.TopologySpreadKeys = []string{"topology.kubernetes.io/zone"}
+
+	environment, knativeService := createNominalKnativeServiceTraitTest()
+	configured, err := affinityTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+	err = affinityTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	constraints := knativeService.Spec.Template.Spec.TopologySpreadConstraints
+	assert.Len(t, constraints, 1)
+	assert.Equal(t, int32(1), constraints[0].MaxSkew)
+	assert.Equal(t, corev1.DoNotSchedule, constraints[0].WhenUnsatisfiable)
+}
+
+func TestConfigureInvalidTopologySpreadConstraintsFails(t *testing.T) {
+	testCases := []struct {
+		name  string
+		setup func(trait *affinityTrait)
+		err   string
+	}{
+		{
+			name:  "key",
+			setup: func(trait *affinityTrait) { trait.TopologySpreadKeys = []string{"zone/"} },
+			err:   `invalid topology spread key "zone/"`,
+		},
+		{
+			name:  "max skew",
+			setup: func(trait *affinityTrait) { trait.TopologySpreadMaxSkew = pointer.Int32(0) },
+			err:   "invalid topology spread max skew 0: it must be positive",
+		},
+		{
+			name:  "when unsatisfiable",
+			setup: func(trait *affinityTrait) { trait.TopologySpreadWhenUnsatisfiable = "Never" },
+			err:   "unsupported topology spread when unsatisfiable action: Never",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			affinityTrait := createNominalAffinityTest()
+			tc.setup(affinityTrait)
+
+			environment, _ := createNominalDeploymentTraitTest()
+			configured, err := affinityTrait.Configure(environment)
+			assert.False(t, configured)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
 func createNominalAffinityTest() *affinityTrait {
 	trait, _ := newAffinityTrait().(*affinityTrait)
 	trait.Enabled = pointer.Bool(true)
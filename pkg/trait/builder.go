@@ -18,8 +18,13 @@ limitations under the License.
 package trait
 
 import (
+	"errors"
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/pointer"
@@ -27,6 +32,7 @@ import (
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/pkg/builder"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
 	mvn "github.com/apache/camel-k/pkg/util/maven"
 	"github.com/apache/camel-k/pkg/util/property"
 )
@@ -61,6 +67,55 @@ func (t *builderTrait) Configure(e *Environment) (bool, error) {
 }
 
 func (t *builderTrait) Apply(e *Environment) error {
+	if t.BaseImage != "" {
+		if _, err := name.ParseReference(t.BaseImage); err != nil {
+			e.IntegrationKit.Status.Phase = v1.IntegrationKitPhaseError
+			e.IntegrationKit.Status.SetCondition("IntegrationKitBaseImageValid", corev1.ConditionFalse,
+				"IntegrationKitBaseImageValid", fmt.Sprintf("The base image is not a valid image reference: %s", err.Error()))
+			if err := e.Client.Status().Update(e.Ctx, e.IntegrationKit); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+
+	if err := validateMavenProfiles(t.MavenProfiles); err != nil {
+		e.IntegrationKit.Status.Phase = v1.IntegrationKitPhaseError
+		e.IntegrationKit.Status.SetCondition("IntegrationKitMavenProfilesValid", corev1.ConditionFalse,
+			"IntegrationKitMavenProfilesValid", err.Error())
+		if err := e.Client.Status().Update(e.Ctx, e.IntegrationKit); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	if err := t.validatePlatforms(e); err != nil {
+		e.IntegrationKit.Status.Phase = v1.IntegrationKitPhaseError
+		e.IntegrationKit.Status.SetCondition("IntegrationKitPlatformsValid", corev1.ConditionFalse,
+			"IntegrationKitPlatformsValid", err.Error())
+		if err := e.Client.Status().Update(e.Ctx, e.IntegrationKit); err != nil {
+			return err
+		}
+		return nil
+	}
+	masterPassword, err := t.validateMavenMasterPasswordSecret(e)
+	if err != nil {
+		e.IntegrationKit.Status.Phase = v1.IntegrationKitPhaseError
+		e.IntegrationKit.Status.SetCondition("IntegrationKitMavenMasterPasswordSecretValid", corev1.ConditionFalse,
+			"IntegrationKitMavenMasterPasswordSecretValid", err.Error())
+		if err := e.Client.Status().Update(e.Ctx, e.IntegrationKit); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	if len(t.Platforms) > 0 {
+		// Surface the build duration impact, as each platform is built in turn
+		e.IntegrationKit.Status.SetCondition("IntegrationKitPlatformsValid", corev1.ConditionTrue,
+			"IntegrationKitPlatformsValid", fmt.Sprintf("The image is built for %d platforms (%s), which multiplies the build duration",
+				len(t.Platforms), strings.Join(t.Platforms, ", ")))
+	}
+
 	builderTask, err := t.builderTask(e)
 	if err != nil {
 		e.IntegrationKit.Status.Phase = v1.IntegrationKitPhaseError
@@ -72,6 +127,8 @@ func (t *builderTrait) Apply(e *Environment) error {
 		return nil
 	}
 
+	builderTask.Maven.MasterPassword = masterPassword
+
 	e.BuildTasks = append(e.BuildTasks, v1.Task{Builder: builderTask})
 
 	switch e.Platform.Status.Build.PublishStrategy {
@@ -81,7 +138,7 @@ func (t *builderTrait) Apply(e *Environment) error {
 				Name: "spectrum",
 			},
 			PublishTask: v1.PublishTask{
-				BaseImage: e.Platform.Status.Build.BaseImage,
+				BaseImage: t.baseImage(e),
 				Image:     getImageName(e),
 				Registry:  e.Platform.Status.Build.Registry,
 			},
@@ -109,8 +166,21 @@ func (t *builderTrait) Apply(e *Environment) error {
 			executorImage = image
 			t.L.Infof("User defined executor image %s will be used for buildah", image)
 		}
+		if len(t.Platforms) > 0 {
+			t.L.Infof("Multi-arch image will be built by buildah for platforms %s", strings.Join(t.Platforms, ", "))
+		}
+		rootless := e.Platform.Status.Build.IsOptionEnabled(builder.BuildahRootless)
+		var runAsUser *int64
+		if user, found := e.Platform.Status.Build.PublishStrategyOptions[builder.BuildahUser]; found && rootless {
+			uid, err := strconv.ParseInt(user, 10, 64)
+			if err != nil || uid <= 0 {
+				return fmt.Errorf("invalid %s publish strategy option %q: it must be the UID of a non-root user", builder.BuildahUser, user)
+			}
+			runAsUser = &uid
+		}
 		e.BuildTasks = append(e.BuildTasks, v1.Task{Buildah: &v1.BuildahTask{
-			Platform: platform,
+			Platform:  platform,
+			Platforms: t.Platforms,
 			BaseTask: v1.BaseTask{
 				Name: "buildah",
 			},
@@ -120,6 +190,8 @@ func (t *builderTrait) Apply(e *Environment) error {
 			},
 			Verbose:       t.Verbose,
 			ExecutorImage: executorImage,
+			Rootless:      &rootless,
+			RunAsUser:     runAsUser,
 		}})
 	//nolint: staticcheck,nolintlint
 	case v1.IntegrationPlatformBuildPublishStrategyKaniko:
@@ -152,6 +224,79 @@ func (t *builderTrait) Apply(e *Environment) error {
 	return nil
 }
 
+// validateMavenProfiles checks the Maven profile names can be passed to the Maven commands.
+func validateMavenProfiles(profiles []string) error {
+	for _, p := range profiles {
+		if strings.TrimSpace(p) == "" {
+			return errors.New("invalid empty Maven profile name")
+		}
+		if strings.ContainsAny(p, ", \t\n") {
+			return fmt.Errorf("invalid Maven profile name %q: it must not contain commas or whitespaces", p)
+		}
+	}
+	return nil
+}
+
+// validatePlatforms checks the platforms of the multi-arch image are supported by the publish strategy.
+func (t *builderTrait) validatePlatforms(e *Environment) error {
+	if len(t.Platforms) == 0 {
+		return nil
+	}
+	if strategy := e.Platform.Status.Build.PublishStrategy; strategy != v1.IntegrationPlatformBuildPublishStrategyBuildah {
+		return fmt.Errorf("multi-arch images are not supported by the %s publish strategy, only by the %s one",
+			strategy, v1.IntegrationPlatformBuildPublishStrategyBuildah)
+	}
+
+	platforms := make(map[string]bool, len(t.Platforms))
+	for _, p := range t.Platforms {
+		if !builder.BuildahSupportedPlatforms[p] {
+			return fmt.Errorf("unsupported platform %q, expected one of %s", p, strings.Join(builder.BuildahSupportedPlatformList(), ", "))
+		}
+		if platforms[p] {
+			return fmt.Errorf("duplicate platform %q", p)
+		}
+		platforms[p] = true
+	}
+	if len(t.Platforms) > 1 && e.IntegrationKit.Labels[v1.IntegrationKitLayoutLabel] == v1.IntegrationKitLayoutNative {
+		return fmt.Errorf("multi-arch images are not supported by the Quarkus %s package type, "+
+			"as the native executable is only compiled for the build platform", traitv1.NativePackageType)
+	}
+
+	return nil
+}
+
+// validateMavenMasterPasswordSecret checks the Maven master password Secret key exists, and holds an encrypted password,
+// and that it does not conflict with the settings security of the integration platform.
+func (t *builderTrait) validateMavenMasterPasswordSecret(e *Environment) (*corev1.SecretKeySelector, error) {
+	if t.MavenMasterPasswordSecret == "" {
+		return nil, nil
+	}
+	if security := e.Platform.Status.Build.Maven.SettingsSecurity; security.ConfigMapKeyRef != nil || security.SecretKeyRef != nil {
+		return nil, errors.New("the Maven master password can't be set together with the settings security of the integration platform")
+	}
+	selector, err := decodeSecretKeySelector(t.MavenMasterPasswordSecret)
+	if err != nil {
+		return nil, fmt.Errorf("the Maven master password Secret %q is not a valid Secret key, syntax: secret-name/key", t.MavenMasterPasswordSecret)
+	}
+	master, err := kubernetes.GetSecretRefValue(e.Ctx, e.Client, e.IntegrationKit.Namespace, selector)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the Maven master password: %s", err.Error())
+	}
+	// Maven only supports encrypted master passwords, that are enclosed in curly braces
+	if master = strings.TrimSpace(master); !strings.HasPrefix(master, "{") || !strings.HasSuffix(master, "}") {
+		return nil, fmt.Errorf("the Maven master password of Secret %s is not encrypted, it must be encrypted with mvn --encrypt-master-password",
+			t.MavenMasterPasswordSecret)
+	}
+	return selector, nil
+}
+
+func (t *builderTrait) baseImage(e *Environment) string {
+	if t.BaseImage != "" {
+		return t.BaseImage
+	}
+	return e.Platform.Status.Build.BaseImage
+}
+
 func (t *builderTrait) builderTask(e *Environment) (*v1.BuilderTask, error) {
 	maven := v1.MavenBuildSpec{
 		MavenSpec: e.Platform.Status.Build.Maven,
@@ -165,7 +310,7 @@ func (t *builderTrait) builderTask(e *Environment) (*v1.BuilderTask, error) {
 		BaseTask: v1.BaseTask{
 			Name: "builder",
 		},
-		BaseImage:    e.Platform.Status.Build.BaseImage,
+		BaseImage:    t.baseImage(e),
 		Runtime:      e.CamelCatalog.Runtime,
 		Dependencies: e.IntegrationKit.Spec.Dependencies,
 		Maven:        maven,
@@ -186,6 +331,13 @@ func (t *builderTrait) builderTask(e *Environment) (*v1.BuilderTask, error) {
 		}
 	}
 
+	if len(t.MavenProfiles) > 0 {
+		// Copy the CLI options, so that the platform ones are not modified
+		options := make([]string, 0, len(task.Maven.CLIOptions)+1)
+		options = append(options, task.Maven.CLIOptions...)
+		task.Maven.CLIOptions = append(options, "-P"+strings.Join(t.MavenProfiles, ","))
+	}
+
 	steps := make([]builder.Step, 0)
 	steps = append(steps, builder.Project.CommonSteps...)
 
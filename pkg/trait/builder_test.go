@@ -28,9 +28,11 @@ import (
 	"k8s.io/utils/pointer"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/builder"
 	"github.com/apache/camel-k/pkg/util/camel"
 	"github.com/apache/camel-k/pkg/util/defaults"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/apache/camel-k/pkg/util/test"
 )
 
 func TestBuilderTraitNotAppliedBecauseOfNilKit(t *testing.T) {
@@ -165,9 +167,277 @@ func TestMavenPropertyBuilderTrait(t *testing.T) {
 	assert.Equal(t, "build-time-value1", env.BuildTasks[0].Builder.Maven.Properties["build-time-prop1"])
 }
 
+func TestBaseImageBuilderTrait(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategySpectrum)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.BaseImage = "registry.example.com/jdk/openjdk-11@sha256:6e6a6a2c9a7f5d5cc3c2d0ba7c0f3c66d15f414d8a6e7ac9d1e4b0a5e5d5c5e5"
+
+	err := builderTrait.Apply(env)
+
+	assert.Nil(t, err)
+	assert.Equal(t, builderTrait.BaseImage, env.BuildTasks[0].Builder.BaseImage)
+	assert.Equal(t, builderTrait.BaseImage, env.BuildTasks[1].Spectrum.BaseImage)
+}
+
+func TestInvalidBaseImageBuilderTrait(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+	env.IntegrationKit.Name = "test-kit"
+	env.IntegrationKit.Namespace = "ns"
+	client, err := test.NewFakeClient(env.IntegrationKit)
+	assert.Nil(t, err)
+	env.Client = client
+
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.BaseImage = "registry.example.com/Invalid Image:latest"
+
+	err = builderTrait.Apply(env)
+
+	assert.Nil(t, err)
+	assert.Empty(t, env.BuildTasks)
+	assert.Equal(t, v1.IntegrationKitPhaseError, env.IntegrationKit.Status.Phase)
+	condition := env.IntegrationKit.Status.GetCondition("IntegrationKitBaseImageValid")
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+}
+
 func createNominalBuilderTraitTest() *builderTrait {
 	builderTrait, _ := newBuilderTrait().(*builderTrait)
 	builderTrait.Enabled = pointer.Bool(true)
 
 	return builderTrait
 }
+
+func TestMultiArchBuilderTrait(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyBuildah)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.Platforms = []string{"linux/amd64", "linux/arm64"}
+
+	err := builderTrait.Apply(env)
+
+	assert.Nil(t, err)
+	assert.Len(t, env.BuildTasks, 2)
+	assert.Equal(t, []string{"linux/amd64", "linux/arm64"}, env.BuildTasks[1].Buildah.Platforms)
+	condition := env.IntegrationKit.Status.GetCondition("IntegrationKitPlatformsValid")
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "The image is built for 2 platforms (linux/amd64, linux/arm64), which multiplies the build duration", condition.Message)
+}
+
+func TestRootlessBuildahBuilderTrait(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyBuildah)
+	env.Platform.Status.Build.PublishStrategyOptions[builder.BuildahRootless] = "true"
+	builderTrait := createNominalBuilderTraitTest()
+
+	err := builderTrait.Apply(env)
+
+	assert.Nil(t, err)
+	assert.Len(t, env.BuildTasks, 2)
+	assert.NotNil(t, env.BuildTasks[1].Buildah)
+	assert.True(t, *env.BuildTasks[1].Buildah.Rootless)
+	assert.Nil(t, env.BuildTasks[1].Buildah.RunAsUser)
+}
+
+func TestRootlessBuildahBuilderTraitWithUser(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyBuildah)
+	env.Platform.Status.Build.PublishStrategyOptions[builder.BuildahRootless] = "true"
+	env.Platform.Status.Build.PublishStrategyOptions[builder.BuildahUser] = "1001"
+	builderTrait := createNominalBuilderTraitTest()
+
+	err := builderTrait.Apply(env)
+
+	assert.Nil(t, err)
+	assert.Len(t, env.BuildTasks, 2)
+	assert.Equal(t, pointer.Int64(1001), env.BuildTasks[1].Buildah.RunAsUser)
+
+	env = createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyBuildah)
+	env.Platform.Status.Build.PublishStrategyOptions[builder.BuildahRootless] = "true"
+	env.Platform.Status.Build.PublishStrategyOptions[builder.BuildahUser] = "0"
+
+	err = createNominalBuilderTraitTest().Apply(env)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, `invalid BuildahUser publish strategy option "0": it must be the UID of a non-root user`, err.Error())
+}
+
+func TestInvalidMultiArchBuilderTrait(t *testing.T) {
+	for _, tc := range []struct {
+		strategy  v1.IntegrationPlatformBuildPublishStrategy
+		platforms []string
+		layout    string
+		message   string
+	}{
+		{
+			strategy:  v1.IntegrationPlatformBuildPublishStrategyKaniko,
+			platforms: []string{"linux/amd64", "linux/arm64"},
+			message:   "multi-arch images are not supported by the Kaniko publish strategy, only by the Buildah one",
+		},
+		{
+			strategy:  v1.IntegrationPlatformBuildPublishStrategyBuildah,
+			platforms: []string{"linux/amd64", "windows/amd64"},
+			message:   `unsupported platform "windows/amd64", expected one of linux/amd64, linux/arm/v7, linux/arm64, linux/ppc64le, linux/s390x`,
+		},
+		{
+			strategy:  v1.IntegrationPlatformBuildPublishStrategyBuildah,
+			platforms: []string{"linux/arm64", "linux/arm64"},
+			message:   `duplicate platform "linux/arm64"`,
+		},
+		{
+			strategy:  v1.IntegrationPlatformBuildPublishStrategyBuildah,
+			platforms: []string{"linux/amd64", "linux/arm64"},
+			layout:    v1.IntegrationKitLayoutNative,
+			message:   "multi-arch images are not supported by the Quarkus native package type, as the native executable is only compiled for the build platform",
+		},
+	} {
+		env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, tc.strategy)
+		env.IntegrationKit.Name = "test-kit"
+		env.IntegrationKit.Namespace = "ns"
+		env.IntegrationKit.Labels = map[string]string{v1.IntegrationKitLayoutLabel: tc.layout}
+		client, err := test.NewFakeClient(env.IntegrationKit)
+		assert.Nil(t, err)
+		env.Client = client
+
+		builderTrait := createNominalBuilderTraitTest()
+		builderTrait.Platforms = tc.platforms
+
+		err = builderTrait.Apply(env)
+
+		assert.Nil(t, err)
+		assert.Empty(t, env.BuildTasks)
+		assert.Equal(t, v1.IntegrationKitPhaseError, env.IntegrationKit.Status.Phase)
+		condition := env.IntegrationKit.Status.GetCondition("IntegrationKitPlatformsValid")
+		assert.NotNil(t, condition)
+		assert.Equal(t, corev1.ConditionFalse, condition.Status)
+		assert.Equal(t, tc.message, condition.Message)
+	}
+}
+
+func TestMavenProfilesBuilderTrait(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+	env.Platform.Status.Build.Maven.CLIOptions = []string{"-V", "--no-transfer-progress"}
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.MavenProfiles = []string{"production", "eu-west"}
+
+	err := builderTrait.Apply(env)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"-V", "--no-transfer-progress", "-Pproduction,eu-west"}, env.BuildTasks[0].Builder.Maven.CLIOptions)
+	// The platform options are not modified
+	assert.Equal(t, []string{"-V", "--no-transfer-progress"}, env.Platform.Status.Build.Maven.CLIOptions)
+}
+
+func TestInvalidMavenProfilesBuilderTrait(t *testing.T) {
+	for _, profiles := range [][]string{{""}, {"production", " "}, {"production,eu-west"}, {"eu west"}} {
+		env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+		env.IntegrationKit.Name = "test-kit"
+		env.IntegrationKit.Namespace = "ns"
+		client, err := test.NewFakeClient(env.IntegrationKit)
+		assert.Nil(t, err)
+		env.Client = client
+
+		builderTrait := createNominalBuilderTraitTest()
+		builderTrait.MavenProfiles = profiles
+
+		err = builderTrait.Apply(env)
+
+		assert.Nil(t, err)
+		assert.Empty(t, env.BuildTasks)
+		assert.Equal(t, v1.IntegrationKitPhaseError, env.IntegrationKit.Status.Phase)
+		condition := env.IntegrationKit.Status.GetCondition("IntegrationKitMavenProfilesValid")
+		assert.NotNil(t, condition, "profiles %v", profiles)
+		assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	}
+}
+
+func TestMavenMasterPasswordSecretBuilderTrait(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+	env.IntegrationKit.Namespace = "ns"
+	client, err := test.NewFakeClient(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "maven-security",
+			Namespace: "ns",
+		},
+		Data: map[string][]byte{
+			"master": []byte("{jSMOWnoPFgsHVpMvz5VrIt5kRbzGpI8u+9EF1iFQyJQ=}\n"),
+		},
+	})
+	assert.Nil(t, err)
+	env.Client = client
+
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.MavenMasterPasswordSecret = "maven-security/master"
+
+	err = builderTrait.Apply(env)
+
+	assert.Nil(t, err)
+	assert.Len(t, env.BuildTasks, 2)
+	assert.Equal(t, &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "maven-security"},
+		Key:                  "master",
+	}, env.BuildTasks[0].Builder.Maven.MasterPassword)
+}
+
+func TestInvalidMavenMasterPasswordSecretBuilderTrait(t *testing.T) {
+	for _, tc := range []struct {
+		secret   string
+		security v1.ValueSource
+		message  string
+	}{
+		{
+			secret:  "maven-security",
+			message: `the Maven master password Secret "maven-security" is not a valid Secret key, syntax: secret-name/key`,
+		},
+		{
+			secret:  "missing/master",
+			message: `unable to get the Maven master password: secrets "missing" not found`,
+		},
+		{
+			secret:  "maven-security/missing",
+			message: "unable to get the Maven master password: key missing not found in secret maven-security",
+		},
+		{
+			secret: "maven-security/plain",
+			message: "the Maven master password of Secret maven-security/plain is not encrypted, " +
+				"it must be encrypted with mvn --encrypt-master-password",
+		},
+		{
+			secret: "maven-security/master",
+			security: v1.ValueSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "maven-settings-security"},
+					Key:                  "settings-security.xml",
+				},
+			},
+			message: "the Maven master password can't be set together with the settings security of the integration platform",
+		},
+	} {
+		env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+		env.Platform.Status.Build.Maven.SettingsSecurity = tc.security
+		env.IntegrationKit.Name = "test-kit"
+		env.IntegrationKit.Namespace = "ns"
+		client, err := test.NewFakeClient(env.IntegrationKit, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "maven-security",
+				Namespace: "ns",
+			},
+			Data: map[string][]byte{
+				"master": []byte("{jSMOWnoPFgsHVpMvz5VrIt5kRbzGpI8u+9EF1iFQyJQ=}"),
+				"plain":  []byte("changeit"),
+			},
+		})
+		assert.Nil(t, err)
+		env.Client = client
+
+		builderTrait := createNominalBuilderTraitTest()
+		builderTrait.MavenMasterPasswordSecret = tc.secret
+
+		err = builderTrait.Apply(env)
+
+		assert.Nil(t, err)
+		assert.Empty(t, env.BuildTasks)
+		assert.Equal(t, v1.IntegrationKitPhaseError, env.IntegrationKit.Status.Phase)
+		condition := env.IntegrationKit.Status.GetCondition("IntegrationKitMavenMasterPasswordSecretValid")
+		assert.NotNil(t, condition, "secret %s", tc.secret)
+		assert.Equal(t, corev1.ConditionFalse, condition.Status)
+		assert.Equal(t, tc.message, condition.Message)
+	}
+}
@@ -19,11 +19,15 @@ package trait
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -34,10 +38,15 @@ import (
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
 	"github.com/apache/camel-k/pkg/util/maven"
 	"github.com/apache/camel-k/pkg/util/property"
 )
 
+// sourcesChecksumAnnotation is the pod template annotation holding the checksum of the ConfigMaps,
+// that the integration sources reference, so that the integration is rolled out when they change.
+const sourcesChecksumAnnotation = "camel.apache.org/sources.checksum"
+
 type camelTrait struct {
 	BaseTrait
 	traitv1.CamelTrait `property:",squash"`
@@ -97,11 +106,95 @@ func (t *camelTrait) Apply(e *Environment) error {
 		// Get all resources
 		maps := t.computeConfigMaps(e)
 		e.Resources.AddAll(maps)
+
+		if pointer.BoolDeref(t.ReloadSources, false) {
+			checksum, err := t.computeSourcesChecksum(e)
+			if err != nil {
+				return err
+			}
+			if checksum != "" {
+				// The pod templates are created by the traits that are applied afterwards
+				e.PostProcessors = append(e.PostProcessors, func(env *Environment) error {
+					env.Resources.VisitPodTemplateMeta(func(meta *metav1.ObjectMeta) {
+						if meta.Annotations == nil {
+							meta.Annotations = make(map[string]string)
+						}
+						meta.Annotations[sourcesChecksumAnnotation] = checksum
+					})
+					return nil
+				})
+			}
+		}
 	}
 
 	return nil
 }
 
+// computeSourcesChecksum validates the sources held by the ConfigMaps the integration sources reference,
+// and returns the checksum of their content, or an empty string if no source references a ConfigMap.
+func (t *camelTrait) computeSourcesChecksum(e *Environment) (string, error) {
+	sources := make([]v1.SourceSpec, 0, len(e.Integration.Spec.Sources))
+	for _, s := range e.Integration.Spec.Sources {
+		if s.ContentRef != "" {
+			sources = append(sources, s)
+		}
+	}
+	if len(sources) == 0 {
+		return "", nil
+	}
+	// The checksum must not depend on the sources order
+	sort.SliceStable(sources, func(i, j int) bool {
+		return sources[i].ContentRef+"/"+sources[i].ContentKey < sources[j].ContentRef+"/"+sources[j].ContentKey
+	})
+
+	hash := sha256.New()
+	for _, s := range sources {
+		content, err := t.getSourceContent(e, s)
+		if err != nil {
+			return "", err
+		}
+		for _, data := range [][]byte{[]byte(s.ContentRef), []byte(s.ContentKey), content} {
+			if _, err := hash.Write(data); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return base64.RawURLEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (t *camelTrait) getSourceContent(e *Environment, s v1.SourceSpec) ([]byte, error) {
+	cm, err := kubernetes.GetConfigMap(e.Ctx, e.Client, s.ContentRef, e.Integration.Namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get ConfigMap %s of source %s", s.ContentRef, s.Name)
+	}
+	key := s.ContentKey
+	if key == "" {
+		key = "content"
+	}
+
+	var content []byte
+	if data, ok := cm.Data[key]; ok {
+		content = []byte(data)
+	} else if data, ok := cm.BinaryData[key]; ok {
+		content = data
+	} else {
+		return nil, fmt.Errorf("ConfigMap %s of source %s has no %s key", s.ContentRef, s.Name, key)
+	}
+	if len(content) == 0 {
+		return nil, fmt.Errorf("ConfigMap %s of source %s has an empty %s key", s.ContentRef, s.Name, key)
+	}
+
+	if s.InferLanguage() == v1.LanguageYaml && !s.Compression {
+		var flows []interface{}
+		if err := yaml.Unmarshal(content, &flows); err != nil {
+			return nil, fmt.Errorf("ConfigMap %s of source %s does not hold a valid YAML source: %w", s.ContentRef, s.Name, err)
+		}
+	}
+
+	return content, nil
+}
+
 func (t *camelTrait) loadOrCreateCatalog(e *Environment, runtimeVersion string) error {
 	ns := e.DetermineCatalogNamespace()
 	if ns == "" {
@@ -21,6 +21,7 @@ import (
 	"context"
 	"testing"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
@@ -145,3 +146,105 @@ func TestApplyCamelTraitWithProperties(t *testing.T) {
 		"application.properties": "a=b\nc=d\n",
 	}, userPropertiesCm.Data)
 }
+
+func TestApplyCamelTraitWithReloadSources(t *testing.T) {
+	trait, environment := createNominalCamelTest()
+	trait.ReloadSources = pointer.Bool(true)
+	environment.Integration.Spec.Sources = []v1.SourceSpec{
+		{
+			DataSpec: v1.DataSpec{
+				Name:       "routes.yaml",
+				ContentRef: "routes",
+				ContentKey: "routes.yaml",
+			},
+		},
+		{
+			DataSpec: v1.DataSpec{
+				Name:    "inline.yaml",
+				Content: "- from:\n    uri: timer:tick\n",
+			},
+		},
+	}
+	environment.Resources.Add(&appsv1.Deployment{})
+
+	checksum := func(content string) string {
+		routes := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "namespace",
+				Name:      "routes",
+			},
+			Data: map[string]string{
+				"routes.yaml": content,
+			},
+		}
+		environment.Client, _ = test.NewFakeClient(routes)
+		environment.PostProcessors = nil
+
+		_, err := trait.Configure(environment)
+		assert.Nil(t, err)
+		err = trait.Apply(environment)
+		assert.Nil(t, err)
+		assert.Len(t, environment.PostProcessors, 1)
+		assert.Nil(t, environment.PostProcessors[0](environment))
+
+		deployment := environment.Resources.GetDeployment(func(*appsv1.Deployment) bool { return true })
+		return deployment.Spec.Template.Annotations["camel.apache.org/sources.checksum"]
+	}
+
+	first := checksum("- from:\n    uri: timer:tick\n")
+	assert.NotEmpty(t, first)
+	assert.Equal(t, first, checksum("- from:\n    uri: timer:tick\n"))
+	assert.NotEqual(t, first, checksum("- from:\n    uri: timer:tock\n"))
+}
+
+func TestApplyCamelTraitWithReloadSourcesInvalidConfigMap(t *testing.T) {
+	testCases := []struct {
+		name string
+		data map[string]string
+		err  string
+	}{
+		{
+			name: "missing key",
+			data: map[string]string{"other.yaml": "- from: {}"},
+			err:  "ConfigMap routes of source routes.yaml has no content key",
+		},
+		{
+			name: "empty source",
+			data: map[string]string{"content": ""},
+			err:  "ConfigMap routes of source routes.yaml has an empty content key",
+		},
+		{
+			name: "invalid yaml",
+			data: map[string]string{"content": "from: ["},
+			err:  "ConfigMap routes of source routes.yaml does not hold a valid YAML source",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trait, environment := createNominalCamelTest()
+			trait.ReloadSources = pointer.Bool(true)
+			environment.Integration.Spec.Sources = []v1.SourceSpec{
+				{
+					DataSpec: v1.DataSpec{
+						Name:       "routes.yaml",
+						ContentRef: "routes",
+					},
+				},
+			}
+			environment.Client, _ = test.NewFakeClient(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "namespace",
+					Name:      "routes",
+				},
+				Data: tc.data,
+			})
+
+			_, err := trait.Configure(environment)
+			assert.Nil(t, err)
+			err = trait.Apply(environment)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
@@ -20,6 +20,9 @@ package trait
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
 
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -48,6 +51,35 @@ const (
 	containerTraitID         = "container"
 )
 
+// containerSizePreset defines the resources requests and limits of a container size preset.
+type containerSizePreset struct {
+	requestCPU    string
+	requestMemory string
+	limitCPU      string
+	limitMemory   string
+}
+
+var defaultContainerSizePresets = map[string]containerSizePreset{
+	"small": {
+		requestCPU:    "125m",
+		requestMemory: "256Mi",
+		limitCPU:      "500m",
+		limitMemory:   "512Mi",
+	},
+	"medium": {
+		requestCPU:    "250m",
+		requestMemory: "512Mi",
+		limitCPU:      "1",
+		limitMemory:   "1Gi",
+	},
+	"large": {
+		requestCPU:    "500m",
+		requestMemory: "1Gi",
+		limitCPU:      "2",
+		limitMemory:   "2Gi",
+	},
+}
+
 type containerTrait struct {
 	BaseTrait
 	traitv1.ContainerTrait `property:",squash"`
@@ -76,8 +108,12 @@ func (t *containerTrait) Configure(e *Environment) (bool, error) {
 
 	if pointer.BoolDeref(t.Auto, true) {
 		if t.Expose == nil {
-			e := e.Resources.GetServiceForIntegration(e.Integration) != nil
-			t.Expose = &e
+			expose := e.Resources.GetServiceForIntegration(e.Integration) != nil
+			// The HTTP port isn't exposed when the Service is only required by the consumer ports
+			if st, ok := e.GetTrait(serviceTraitID).(*serviceTrait); ok && !st.exposesHTTP {
+				expose = false
+			}
+			t.Expose = &expose
 		}
 	}
 
@@ -85,9 +121,91 @@ func (t *containerTrait) Configure(e *Environment) (bool, error) {
 		return false, fmt.Errorf("unsupported pull policy %s", t.ImagePullPolicy)
 	}
 
+	if t.ImageMirror != "" {
+		if err := validateImageMirror(t.ImageMirror); err != nil {
+			return false, err
+		}
+	}
+
+	if _, err := t.sizePreset(); err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
+// sizePreset returns the resources preset for the configured size, if any, taking into account
+// the predefined values overridden with the size presets.
+func (t *containerTrait) sizePreset() (*containerSizePreset, error) {
+	presets := make(map[string]containerSizePreset, len(defaultContainerSizePresets))
+	for size, preset := range defaultContainerSizePresets {
+		presets[size] = preset
+	}
+	for _, p := range t.SizePresets {
+		size, values, _ := strings.Cut(p, ":")
+		if _, ok := defaultContainerSizePresets[size]; !ok {
+			return nil, fmt.Errorf("unsupported container size preset %q, expected small, medium or large", size)
+		}
+		quantities := strings.Split(values, ",")
+		if len(quantities) != 4 {
+			return nil, fmt.Errorf("invalid container size preset %q, expected <size>:<request-cpu>,<request-memory>,<limit-cpu>,<limit-memory>", p)
+		}
+		for _, q := range quantities {
+			if _, err := resource.ParseQuantity(q); err != nil {
+				return nil, fmt.Errorf("invalid container size preset %q: %s: %w", p, q, err)
+			}
+		}
+		presets[size] = containerSizePreset{
+			requestCPU:    quantities[0],
+			requestMemory: quantities[1],
+			limitCPU:      quantities[2],
+			limitMemory:   quantities[3],
+		}
+	}
+
+	if t.Size == "" {
+		return nil, nil
+	}
+	preset, ok := presets[t.Size]
+	if !ok {
+		return nil, fmt.Errorf("unsupported container size %q, expected small, medium or large", t.Size)
+	}
+	return &preset, nil
+}
+
+func validateImageMirror(mirror string) error {
+	if strings.Contains(mirror, "://") {
+		return fmt.Errorf("invalid image mirror %s: it must not contain any scheme", mirror)
+	}
+	host, path, _ := strings.Cut(mirror, "/")
+	if _, err := name.NewRegistry(host, name.StrictValidation); err != nil {
+		return fmt.Errorf("invalid image mirror %s: %w", mirror, err)
+	}
+	if path != "" {
+		if _, err := name.NewRepository(mirror, name.StrictValidation); err != nil {
+			return fmt.Errorf("invalid image mirror %s: %w", mirror, err)
+		}
+	}
+
+	return nil
+}
+
+// mirrorImage replaces the registry of the image reference with the mirror, preserving the image repository,
+// and its digest, or its tag if the image is not addressed by digest.
+func mirrorImage(image string, mirror string) (string, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return "", fmt.Errorf("invalid image reference %s: %w", image, err)
+	}
+
+	separator := ":"
+	if _, ok := ref.(name.Digest); ok {
+		separator = "@"
+	}
+
+	return fmt.Sprintf("%s/%s%s%s", mirror, ref.Context().RepositoryStr(), separator, ref.Identifier()), nil
+}
+
 func isValidPullPolicy(policy corev1.PullPolicy) bool {
 	return policy == "" || policy == corev1.PullAlways || policy == corev1.PullIfNotPresent || policy == corev1.PullNever
 }
@@ -96,9 +214,59 @@ func (t *containerTrait) Apply(e *Environment) error {
 	if err := t.configureImageIntegrationKit(e); err != nil {
 		return err
 	}
+	if pointer.BoolDeref(t.ResourcesAdvisory, false) {
+		// Inspect the container once all the traits, including the pod trait, are applied
+		e.PostProcessors = append(e.PostProcessors, t.adviseResources)
+	} else {
+		e.Integration.Status.RemoveCondition(v1.IntegrationConditionResourcesConfigured)
+	}
 	return t.configureContainer(e)
 }
 
+// adviseResources sets the ResourcesConfigured condition according to the CPU and memory requests and limits
+// of the integration container. Unset resources are only reported, and don't affect the integration readiness.
+func (t *containerTrait) adviseResources(e *Environment) error {
+	container := e.GetIntegrationContainer()
+	if container == nil {
+		return nil
+	}
+
+	var missing []string
+	for _, r := range []struct {
+		name string
+		list corev1.ResourceList
+		key  corev1.ResourceName
+	}{
+		{"cpu request", container.Resources.Requests, corev1.ResourceCPU},
+		{"memory request", container.Resources.Requests, corev1.ResourceMemory},
+		{"cpu limit", container.Resources.Limits, corev1.ResourceCPU},
+		{"memory limit", container.Resources.Limits, corev1.ResourceMemory},
+	} {
+		if q, ok := r.list[r.key]; !ok || q.IsZero() {
+			missing = append(missing, r.name)
+		}
+	}
+
+	if len(missing) > 0 {
+		message := fmt.Sprintf("integration container has no %s configured", strings.Join(missing, ", "))
+		e.Integration.Status.SetCondition(
+			v1.IntegrationConditionResourcesConfigured,
+			corev1.ConditionFalse,
+			v1.IntegrationConditionResourcesUnsetReason,
+			message,
+		)
+		return nil
+	}
+
+	e.Integration.Status.SetCondition(
+		v1.IntegrationConditionResourcesConfigured,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionResourcesConfiguredReason,
+		"integration container resources configured",
+	)
+	return nil
+}
+
 // IsPlatformTrait overrides base class method.
 func (t *containerTrait) IsPlatformTrait() bool {
 	return true
@@ -146,9 +314,18 @@ func (t *containerTrait) configureContainer(e *Environment) error {
 		e.ApplicationProperties = make(map[string]string)
 	}
 
+	image := e.Integration.Status.Image
+	if t.ImageMirror != "" && image != "" {
+		mirrored, err := mirrorImage(image, t.ImageMirror)
+		if err != nil {
+			return err
+		}
+		image = mirrored
+	}
+
 	container := corev1.Container{
 		Name:  t.Name,
-		Image: e.Integration.Status.Image,
+		Image: image,
 		Env:   make([]corev1.EnvVar, 0),
 	}
 
@@ -231,6 +408,19 @@ func (t *containerTrait) configureContainer(e *Environment) error {
 		return err
 	}
 
+	// StatefulSet
+	if err := e.Resources.VisitStatefulSetE(func(statefulSet *appsv1.StatefulSet) error {
+		for _, envVar := range e.EnvVars {
+			envvar.SetVar(&container.Env, envVar)
+		}
+
+		containers = &statefulSet.Spec.Template.Spec.Containers
+		visited = true
+		return nil
+	}); err != nil {
+		return err
+	}
+
 	if visited {
 		*containers = append(*containers, container)
 	}
@@ -285,6 +475,18 @@ func (t *containerTrait) configureResources(_ *Environment, container *corev1.Co
 	if container.Resources.Requests == nil {
 		container.Resources.Requests = make(corev1.ResourceList)
 	}
+	// Limits
+	if container.Resources.Limits == nil {
+		container.Resources.Limits = make(corev1.ResourceList)
+	}
+
+	// The size preset is validated when the trait is configured
+	if preset, _ := t.sizePreset(); preset != nil {
+		container.Resources.Requests[corev1.ResourceCPU] = resource.MustParse(preset.requestCPU)
+		container.Resources.Requests[corev1.ResourceMemory] = resource.MustParse(preset.requestMemory)
+		container.Resources.Limits[corev1.ResourceCPU] = resource.MustParse(preset.limitCPU)
+		container.Resources.Limits[corev1.ResourceMemory] = resource.MustParse(preset.limitMemory)
+	}
 
 	if t.RequestCPU != "" {
 		v, err := resource.ParseQuantity(t.RequestCPU)
@@ -303,11 +505,6 @@ func (t *containerTrait) configureResources(_ *Environment, container *corev1.Co
 		}
 	}
 
-	// Limits
-	if container.Resources.Limits == nil {
-		container.Resources.Limits = make(corev1.ResourceList)
-	}
-
 	if t.LimitCPU != "" {
 		v, err := resource.ParseQuantity(t.LimitCPU)
 		if err != nil {
@@ -191,3 +191,235 @@ func TestProbesOnKnativeService(t *testing.T) {
 	assert.Equal(t, defaultReadinessProbePath, container.ReadinessProbe.HTTPGet.Path)
 	assert.Equal(t, int32(1234), container.LivenessProbe.TimeoutSeconds)
 }
+
+func TestStartupProbeOnDeployment(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Health: &traitv1.HealthTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					ReadinessProbeEnabled:   pointer.Bool(false),
+					StartupProbeEnabled:     pointer.Bool(true),
+					StartupPeriod:           5,
+					StartupFailureThreshold: 60,
+				},
+			},
+		},
+	}
+
+	env := newTestProbesEnv(t, integration)
+	env.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+
+	err := env.Catalog.apply(&env)
+	assert.Nil(t, err)
+
+	container := env.GetIntegrationContainer()
+
+	assert.Nil(t, container.LivenessProbe)
+	assert.Nil(t, container.ReadinessProbe)
+	assert.Equal(t, int32(defaultContainerPort), container.StartupProbe.HTTPGet.Port.IntVal)
+	assert.Equal(t, defaultStartupProbePath, container.StartupProbe.HTTPGet.Path)
+	assert.Equal(t, corev1.URISchemeHTTP, container.StartupProbe.HTTPGet.Scheme)
+	assert.Equal(t, int32(5), container.StartupProbe.PeriodSeconds)
+	assert.Equal(t, int32(60), container.StartupProbe.FailureThreshold)
+	// The success threshold of startup probes must be 1, that is the default
+	assert.Equal(t, int32(0), container.StartupProbe.SuccessThreshold)
+}
+
+func TestStartupProbeOnKnativeService(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Profile: v1.TraitProfileKnative,
+			Traits: v1.Traits{
+				KnativeService: &traitv1.KnativeServiceTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+				},
+				Health: &traitv1.HealthTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					StartupProbeEnabled: pointer.Bool(true),
+				},
+			},
+		},
+	}
+
+	env := newTestProbesEnv(t, integration)
+	env.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+
+	err := env.Catalog.apply(&env)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "the startup probe isn't supported by Knative Serving")
+}
+
+func TestProbesWithReadinessChecks(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Health: &traitv1.HealthTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					ReadinessChecks: []string{"context", "datasource"},
+				},
+			},
+		},
+	}
+
+	env := newTestProbesEnv(t, integration)
+	env.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+
+	err := env.Catalog.apply(&env)
+	assert.Nil(t, err)
+
+	container := env.GetIntegrationContainer()
+
+	assert.Equal(t, defaultReadinessProbePath, container.ReadinessProbe.HTTPGet.Path)
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "CAMEL_HEALTH_CONTEXT_ENABLED", Value: "true"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "CAMEL_HEALTH_ROUTES_ENABLED", Value: "false"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "CAMEL_HEALTH_CONSUMERS_ENABLED", Value: "false"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "CAMEL_HEALTH_REGISTRY_ENABLED", Value: "false"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "QUARKUS_DATASOURCE_HEALTH_ENABLED", Value: "true"})
+}
+
+func TestProbesWithUnsupportedReadinessCheck(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Health: &traitv1.HealthTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					ReadinessChecks: []string{"routes", "database"},
+				},
+			},
+		},
+	}
+
+	env := newTestProbesEnv(t, integration)
+	env.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+
+	err := env.Catalog.apply(&env)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `unsupported readiness health check "database"`)
+}
+
+func TestProbesWithLivenessChecks(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Health: &traitv1.HealthTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					LivenessProbeEnabled: pointer.Bool(true),
+					LivenessChecks:       []string{"context"},
+				},
+			},
+		},
+	}
+
+	env := newTestProbesEnv(t, integration)
+	env.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+
+	err := env.Catalog.apply(&env)
+	assert.Nil(t, err)
+
+	container := env.GetIntegrationContainer()
+
+	assert.Equal(t, defaultLivenessProbePath, container.LivenessProbe.HTTPGet.Path)
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "CAMEL_HEALTH_CONTEXT_ENABLED", Value: "true"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "CAMEL_HEALTH_ROUTES_ENABLED", Value: "true"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "CAMEL_HEALTH_CONSUMERS_ENABLED", Value: "true"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "CAMEL_HEALTH_REGISTRY_ENABLED", Value: "false"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "QUARKUS_DATASOURCE_HEALTH_ENABLED", Value: "true"})
+}
+
+func TestProbesWithLivenessAndReadinessChecks(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Health: &traitv1.HealthTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					LivenessProbeEnabled: pointer.Bool(true),
+					LivenessChecks:       []string{"context", "registry"},
+					ReadinessChecks:      []string{"context", "consumers"},
+				},
+			},
+		},
+	}
+
+	env := newTestProbesEnv(t, integration)
+	env.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+
+	err := env.Catalog.apply(&env)
+	assert.Nil(t, err)
+
+	container := env.GetIntegrationContainer()
+
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "CAMEL_HEALTH_CONTEXT_ENABLED", Value: "true"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "CAMEL_HEALTH_ROUTES_ENABLED", Value: "false"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "CAMEL_HEALTH_CONSUMERS_ENABLED", Value: "true"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "CAMEL_HEALTH_REGISTRY_ENABLED", Value: "true"})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "QUARKUS_DATASOURCE_HEALTH_ENABLED", Value: "false"})
+}
+
+func TestProbesWithInvalidLivenessChecks(t *testing.T) {
+	testCases := []struct {
+		name   string
+		health traitv1.HealthTrait
+		err    string
+	}{
+		{
+			name: "readiness only check",
+			health: traitv1.HealthTrait{
+				LivenessProbeEnabled: pointer.Bool(true),
+				LivenessChecks:       []string{"context", "routes"},
+			},
+			err: `unsupported liveness health check "routes"`,
+		},
+		{
+			name: "liveness probe disabled",
+			health: traitv1.HealthTrait{
+				LivenessChecks: []string{"context"},
+			},
+			err: "liveness-checks requires the liveness probe to be enabled",
+		},
+		{
+			name: "excluded readiness check",
+			health: traitv1.HealthTrait{
+				LivenessProbeEnabled: pointer.Bool(true),
+				LivenessChecks:       []string{"context"},
+				ReadinessChecks:      []string{"registry"},
+			},
+			err: `the "registry" health check can't be excluded from the liveness health checks`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			health := tc.health
+			health.Enabled = pointer.Bool(true)
+			integration := &v1.Integration{
+				Spec: v1.IntegrationSpec{
+					Traits: v1.Traits{
+						Health: &health,
+					},
+				},
+			}
+
+			env := newTestProbesEnv(t, integration)
+			env.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+
+			err := env.Catalog.apply(&env)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
@@ -25,8 +25,10 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -339,3 +341,224 @@ func TestContainerWithImagePullPolicy(t *testing.T) {
 
 	assert.Equal(t, corev1.PullAlways, container.ImagePullPolicy)
 }
+
+func TestContainerWithImageMirror(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	client, _ := test.NewFakeClient()
+	traitCatalog := NewCatalog(nil)
+
+	environment := Environment{
+		Ctx:          context.TODO(),
+		Client:       client,
+		CamelCatalog: catalog,
+		Catalog:      traitCatalog,
+		Integration: &v1.Integration{
+			Spec: v1.IntegrationSpec{
+				Profile: v1.TraitProfileKubernetes,
+				Traits: v1.Traits{
+					Container: &traitv1.ContainerTrait{
+						ImageMirror: "mirror.example.com:5000/cache",
+					},
+				},
+			},
+			Status: v1.IntegrationStatus{
+				Image: "registry.example.com/ns/camel-k-kit-abc@sha256:2d7a3e6e7a6c8c1ed1d6c608e55a0b6c3e8d4f3b0e0a1c7bd4b2cf76d2d7e1f0",
+			},
+		},
+		Platform: &v1.IntegrationPlatform{
+			Spec: v1.IntegrationPlatformSpec{
+				Build: v1.IntegrationPlatformBuildSpec{
+					RuntimeVersion: catalog.Runtime.Version,
+				},
+			},
+			Status: v1.IntegrationPlatformStatus{
+				Phase: v1.IntegrationPlatformPhaseReady,
+			},
+		},
+		Resources: kubernetes.NewCollection(),
+	}
+	environment.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+	environment.Platform.ResyncStatusFullConfig()
+
+	err = traitCatalog.apply(&environment)
+	assert.Nil(t, err)
+
+	container := environment.GetIntegrationContainer()
+
+	assert.Equal(t, "mirror.example.com:5000/cache/ns/camel-k-kit-abc@sha256:2d7a3e6e7a6c8c1ed1d6c608e55a0b6c3e8d4f3b0e0a1c7bd4b2cf76d2d7e1f0", container.Image)
+}
+
+func TestMirrorImage(t *testing.T) {
+	image, err := mirrorImage("registry.example.com/ns/camel-k-kit-abc:12345", "mirror.example.com")
+	assert.Nil(t, err)
+	assert.Equal(t, "mirror.example.com/ns/camel-k-kit-abc:12345", image)
+
+	image, err = mirrorImage("docker.io/apache/camel-k-kit@sha256:2d7a3e6e7a6c8c1ed1d6c608e55a0b6c3e8d4f3b0e0a1c7bd4b2cf76d2d7e1f0", "10.0.0.1:5000")
+	assert.Nil(t, err)
+	assert.Equal(t, "10.0.0.1:5000/apache/camel-k-kit@sha256:2d7a3e6e7a6c8c1ed1d6c608e55a0b6c3e8d4f3b0e0a1c7bd4b2cf76d2d7e1f0", image)
+
+	assert.Nil(t, validateImageMirror("mirror.example.com:5000/cache"))
+	assert.NotNil(t, validateImageMirror("https://mirror.example.com"))
+	assert.NotNil(t, validateImageMirror("mirror.example.com/Cache"))
+	assert.NotNil(t, validateImageMirror(""))
+}
+
+func TestContainerWithSizePreset(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	client, _ := test.NewFakeClient()
+	traitCatalog := NewCatalog(nil)
+
+	environment := Environment{
+		Ctx:          context.TODO(),
+		Client:       client,
+		CamelCatalog: catalog,
+		Catalog:      traitCatalog,
+		Integration: &v1.Integration{
+			Spec: v1.IntegrationSpec{
+				Profile: v1.TraitProfileKubernetes,
+				Traits: v1.Traits{
+					Container: &traitv1.ContainerTrait{
+						Size:        "medium",
+						LimitMemory: "2Gi",
+					},
+				},
+			},
+		},
+		Platform: &v1.IntegrationPlatform{
+			Spec: v1.IntegrationPlatformSpec{
+				Build: v1.IntegrationPlatformBuildSpec{
+					RuntimeVersion: catalog.Runtime.Version,
+				},
+			},
+			Status: v1.IntegrationPlatformStatus{
+				Phase: v1.IntegrationPlatformPhaseReady,
+			},
+		},
+		Resources: kubernetes.NewCollection(),
+	}
+	environment.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+	environment.Platform.ResyncStatusFullConfig()
+
+	err = traitCatalog.apply(&environment)
+	assert.Nil(t, err)
+
+	container := environment.GetIntegrationContainer()
+
+	assert.Equal(t, resource.MustParse("250m"), container.Resources.Requests[corev1.ResourceCPU])
+	assert.Equal(t, resource.MustParse("512Mi"), container.Resources.Requests[corev1.ResourceMemory])
+	assert.Equal(t, resource.MustParse("1"), container.Resources.Limits[corev1.ResourceCPU])
+	// The explicit limit overrides the preset
+	assert.Equal(t, resource.MustParse("2Gi"), container.Resources.Limits[corev1.ResourceMemory])
+}
+
+func TestContainerSizePresets(t *testing.T) {
+	trait, _ := newContainerTrait().(*containerTrait)
+
+	preset, err := trait.sizePreset()
+	assert.Nil(t, err)
+	assert.Nil(t, preset)
+
+	trait.Size = "small"
+	trait.SizePresets = []string{"small:100m,128Mi,200m,256Mi"}
+	preset, err = trait.sizePreset()
+	assert.Nil(t, err)
+	assert.Equal(t, &containerSizePreset{
+		requestCPU:    "100m",
+		requestMemory: "128Mi",
+		limitCPU:      "200m",
+		limitMemory:   "256Mi",
+	}, preset)
+
+	trait.SizePresets = nil
+	trait.Size = "huge"
+	_, err = trait.sizePreset()
+	assert.EqualError(t, err, `unsupported container size "huge", expected small, medium or large`)
+
+	trait.Size = "small"
+	trait.SizePresets = []string{"small:100m,128Mi"}
+	_, err = trait.sizePreset()
+	assert.EqualError(t, err, `invalid container size preset "small:100m,128Mi", expected <size>:<request-cpu>,<request-memory>,<limit-cpu>,<limit-memory>`)
+
+	trait.SizePresets = []string{"tiny:100m,128Mi,200m,256Mi"}
+	_, err = trait.sizePreset()
+	assert.EqualError(t, err, `unsupported container size preset "tiny", expected small, medium or large`)
+
+	trait.SizePresets = []string{"small:100m,128Mi,200m,lots"}
+	_, err = trait.sizePreset()
+	assert.NotNil(t, err)
+}
+
+func TestContainerWithResourcesAdvisory(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	newEnvironment := func(container *traitv1.ContainerTrait) *Environment {
+		client, _ := test.NewFakeClient()
+		environment := Environment{
+			Ctx:          context.TODO(),
+			Client:       client,
+			CamelCatalog: catalog,
+			Catalog:      NewCatalog(nil),
+			Integration: &v1.Integration{
+				Spec: v1.IntegrationSpec{
+					Profile: v1.TraitProfileKubernetes,
+					Traits: v1.Traits{
+						Container: container,
+					},
+				},
+			},
+			Platform: &v1.IntegrationPlatform{
+				Spec: v1.IntegrationPlatformSpec{
+					Build: v1.IntegrationPlatformBuildSpec{
+						RuntimeVersion: catalog.Runtime.Version,
+					},
+				},
+				Status: v1.IntegrationPlatformStatus{
+					Phase: v1.IntegrationPlatformPhaseReady,
+				},
+			},
+			Resources: kubernetes.NewCollection(),
+		}
+		environment.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+		environment.Platform.ResyncStatusFullConfig()
+		return &environment
+	}
+
+	environment := newEnvironment(&traitv1.ContainerTrait{
+		ResourcesAdvisory: pointer.Bool(true),
+		RequestMemory:     "256Mi",
+	})
+	err = environment.Catalog.apply(environment)
+	assert.Nil(t, err)
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionResourcesConfigured)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionResourcesUnsetReason, condition.Reason)
+	assert.Equal(t, "integration container has no cpu request, cpu limit, memory limit configured", condition.Message)
+	// The advisory doesn't prevent the integration from being deployed
+	assert.NotNil(t, environment.Resources.GetDeploymentForIntegration(environment.Integration))
+
+	environment = newEnvironment(&traitv1.ContainerTrait{
+		ResourcesAdvisory: pointer.Bool(true),
+		Size:              "small",
+	})
+	err = environment.Catalog.apply(environment)
+	assert.Nil(t, err)
+
+	condition = environment.Integration.Status.GetCondition(v1.IntegrationConditionResourcesConfigured)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionResourcesConfiguredReason, condition.Reason)
+
+	environment = newEnvironment(&traitv1.ContainerTrait{})
+	environment.Integration.Status.SetCondition(v1.IntegrationConditionResourcesConfigured, corev1.ConditionFalse,
+		v1.IntegrationConditionResourcesUnsetReason, "integration container has no cpu request configured")
+	err = environment.Catalog.apply(environment)
+	assert.Nil(t, err)
+	assert.Nil(t, environment.Integration.Status.GetCondition(v1.IntegrationConditionResourcesConfigured))
+}
@@ -22,41 +22,138 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"reflect"
 	"strings"
+	"time"
 
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	routev1 "github.com/openshift/api/route/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/pointer"
 
+	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
+	messaging "knative.dev/eventing/pkg/apis/messaging/v1"
+	sources "knative.dev/eventing/pkg/apis/sources/v1"
+	serving "knative.dev/serving/pkg/apis/serving/v1"
+
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util/cosign"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
 	"github.com/apache/camel-k/pkg/util/patch"
+	"github.com/apache/camel-k/pkg/util/registry"
 )
 
 type deployerTrait struct {
 	BaseTrait
 	traitv1.DeployerTrait `property:",squash"`
+	paused                bool
+	unverified            bool
 }
 
+const (
+	deployerTraitID = "deployer"
+
+	// imageSignatureKey is the key of the Secret holding the public key the image signatures are verified against.
+	imageSignatureKey = "cosign.pub"
+
+	// lastAppliedConfigurationAnnotation stores the configuration the three-way patches are computed against.
+	lastAppliedConfigurationAnnotation = "camel.apache.org/last-applied-configuration"
+)
+
 var _ ControllerStrategySelector = &deployerTrait{}
 
 var hasServerSideApply = true
 
 func newDeployerTrait() Trait {
 	return &deployerTrait{
-		BaseTrait: NewBaseTrait("deployer", 900),
+		BaseTrait: NewBaseTrait(deployerTraitID, 900),
 	}
 }
 
 func (t *deployerTrait) Configure(e *Environment) (bool, error) {
-	return e.Integration != nil && pointer.BoolDeref(t.Enabled, true), nil
+	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, true) {
+		return false, nil
+	}
+
+	switch ControllerStrategy(t.Kind) {
+	case "", ControllerStrategyDeployment, ControllerStrategyCronJob, ControllerStrategyKnativeService, ControllerStrategyStatefulSet:
+	default:
+		return false, fmt.Errorf("unsupported deployer kind %q, expected one of %s, %s, %s or %s", t.Kind,
+			ControllerStrategyDeployment, ControllerStrategyCronJob, ControllerStrategyKnativeService, ControllerStrategyStatefulSet)
+	}
+
+	t.paused = pointer.BoolDeref(t.Paused, false)
+	if t.PausedUntil != "" {
+		until, err := time.Parse(time.RFC3339, t.PausedUntil)
+		if err != nil {
+			return false, fmt.Errorf("invalid paused-until timestamp %q, expected RFC 3339 format: %w", t.PausedUntil, err)
+		}
+		t.paused = t.paused || time.Now().Before(until)
+	}
+
+	if pointer.BoolDeref(t.VerifyImageSignature, false) && t.ImageSignatureKeySecret == "" {
+		return false, errors.New("image signature verification requires the image-signature-key-secret option, keyless verification is not supported")
+	}
+
+	if t.NamePrefix != "" || t.NameSuffix != "" {
+		// The Service and the Knative Service names must be DNS labels
+		if errs := validation.IsDNS1035Label(t.resourceName(e.Integration.Name)); len(errs) > 0 {
+			return false, fmt.Errorf("invalid name-prefix or name-suffix, the %q resources name is invalid: %s",
+				t.resourceName(e.Integration.Name), strings.Join(errs, ", "))
+		}
+	}
+
+	return true, nil
 }
 
 func (t *deployerTrait) Apply(e *Environment) error {
+	if t.paused {
+		message := "reconciliation paused by the deployer trait"
+		if t.PausedUntil != "" && !pointer.BoolDeref(t.Paused, false) {
+			message = fmt.Sprintf("reconciliation paused by the deployer trait until %s", t.PausedUntil)
+		}
+		e.Integration.Status.SetCondition(
+			v1.IntegrationConditionReconciliationPaused,
+			corev1.ConditionTrue,
+			v1.IntegrationConditionReconciliationPausedReason,
+			message,
+		)
+		// The resources are computed, but neither patched nor garbage collected
+		return nil
+	}
+	e.Integration.Status.RemoveCondition(v1.IntegrationConditionReconciliationPaused)
+	if !pointer.BoolDeref(t.VerifyImageSignature, false) {
+		e.Integration.Status.RemoveCondition(v1.IntegrationConditionImageSignatureVerified)
+	}
+
 	// Register a post action that patches the resources generated by the traits
 	e.PostActions = append(e.PostActions, func(env *Environment) error {
+		if pointer.BoolDeref(t.VerifyImageSignature, false) && !t.verifyImageSignature(env) {
+			// Neither create nor patch the resources, so that the unverified image is not deployed
+			t.unverified = true
+			return nil
+		}
+		// The resources are renamed once all the traits have looked them up by the integration name
+		if err := t.renameResources(env); err != nil {
+			return err
+		}
+		var conflicts []string
 		for _, resource := range env.Resources.Items() {
 			// We assume that server-side apply is enabled by default.
 			// It is currently convoluted to check proactively whether server-side apply
@@ -73,15 +170,25 @@ func (t *deployerTrait) Apply(e *Environment) error {
 				case isIncompatibleServerError(err):
 					t.L.Info("Fallback to client-side apply to patch resources")
 					hasServerSideApply = false
+				case t.failOnConflict() && k8serrors.IsConflict(err):
+					t.L.Infof("Resource not patched to not overwrite conflicting changes: %v", err)
+					conflicts = append(conflicts, resourceKindAndName(resource))
+					continue
 				default:
 					// Keep server-side apply unless server is incompatible with it
 					return err
 				}
 			}
 			if err := t.clientSideApply(env, resource); err != nil {
+				if t.failOnConflict() && errors.Is(err, patch.ErrConflict) {
+					t.L.Infof("Resource not patched to not overwrite conflicting changes: %v", err)
+					conflicts = append(conflicts, resourceKindAndName(resource))
+					continue
+				}
 				return err
 			}
 		}
+		t.reportConflicts(env, conflicts)
 		return nil
 	})
 
@@ -93,7 +200,11 @@ func (t *deployerTrait) serverSideApply(env *Environment, resource ctrl.Object)
 	if err != nil {
 		return err
 	}
-	err = env.Client.Patch(env.Ctx, target, ctrl.Apply, ctrl.ForceOwnership, ctrl.FieldOwner("camel-k-operator"))
+	options := []ctrl.PatchOption{ctrl.FieldOwner("camel-k-operator")}
+	if !t.failOnConflict() {
+		options = append(options, ctrl.ForceOwnership)
+	}
+	err = env.Client.Patch(env.Ctx, target, ctrl.Apply, options...)
 	if err != nil {
 		return fmt.Errorf("error during apply resource: %s/%s: %w", resource.GetNamespace(), resource.GetName(), err)
 	}
@@ -102,6 +213,12 @@ func (t *deployerTrait) serverSideApply(env *Environment, resource ctrl.Object)
 }
 
 func (t *deployerTrait) clientSideApply(env *Environment, resource ctrl.Object) error {
+	threeWay := pointer.BoolDeref(t.ThreeWayPatch, false) || t.failOnConflict()
+	if threeWay {
+		if err := setLastAppliedConfiguration(resource); err != nil {
+			return err
+		}
+	}
 	err := env.Client.Create(env.Ctx, resource)
 	if err == nil {
 		return nil
@@ -116,20 +233,95 @@ func (t *deployerTrait) clientSideApply(env *Environment, resource ctrl.Object)
 	if err != nil {
 		return err
 	}
-	p, err := patch.MergePatch(object, resource)
+	var p []byte
+	patchType := types.MergePatchType
+	if threeWay {
+		original, err := getLastAppliedConfiguration(object)
+		if err != nil {
+			return err
+		}
+		patchType, p, err = patch.ThreeWayMergePatch(original, object, resource, t.failOnConflict())
+		if err != nil {
+			return fmt.Errorf("error during patch %s/%s: %w", resource.GetNamespace(), resource.GetName(), err)
+		}
+	} else {
+		p, err = patch.MergePatch(object, resource)
+	}
 	if err != nil {
 		return err
 	} else if len(p) == 0 {
 		// Update the resource with the object returned from the API server
 		return t.unstructuredToRuntimeObject(object, resource)
 	}
-	err = env.Client.Patch(env.Ctx, resource, ctrl.RawPatch(types.MergePatchType, p))
+	err = env.Client.Patch(env.Ctx, resource, ctrl.RawPatch(patchType, p))
 	if err != nil {
 		return fmt.Errorf("error during patch %s/%s: %w", resource.GetNamespace(), resource.GetName(), err)
 	}
 	return nil
 }
 
+func (t *deployerTrait) failOnConflict() bool {
+	return pointer.BoolDeref(t.FailOnConflict, false)
+}
+
+// reportConflicts reports the resources that have not been patched to not overwrite conflicting changes
+// with the ResourcesConflict condition.
+func (t *deployerTrait) reportConflicts(e *Environment, conflicts []string) {
+	if len(conflicts) == 0 {
+		e.Integration.Status.RemoveCondition(v1.IntegrationConditionResourcesConflict)
+		return
+	}
+	e.Integration.Status.SetCondition(
+		v1.IntegrationConditionResourcesConflict,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionResourcesConflictReason,
+		fmt.Sprintf("resources modified by other actors are not patched: %s", strings.Join(conflicts, ", ")),
+	)
+}
+
+func resourceKindAndName(resource ctrl.Object) string {
+	return fmt.Sprintf("%s %s", resource.GetObjectKind().GroupVersionKind().Kind, resource.GetName())
+}
+
+// setLastAppliedConfiguration stores the resource configuration, the next three-way patches are computed against,
+// into the resource annotations.
+func setLastAppliedConfiguration(resource ctrl.Object) error {
+	annotations := make(map[string]string, len(resource.GetAnnotations())+1)
+	for k, v := range resource.GetAnnotations() {
+		if k != lastAppliedConfigurationAnnotation {
+			annotations[k] = v
+		}
+	}
+	resource.SetAnnotations(annotations)
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+	annotations[lastAppliedConfigurationAnnotation] = string(data)
+	resource.SetAnnotations(annotations)
+	return nil
+}
+
+// getLastAppliedConfiguration returns the configuration the object has been last applied from, if any.
+// The annotation is set back into it, so that it's not detected as a change of the object.
+func getLastAppliedConfiguration(object *unstructured.Unstructured) ([]byte, error) {
+	value, ok := object.GetAnnotations()[lastAppliedConfigurationAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	original := unstructured.Unstructured{}
+	if err := json.Unmarshal([]byte(value), &original.Object); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", lastAppliedConfigurationAnnotation, err)
+	}
+	annotations := original.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[lastAppliedConfigurationAnnotation] = value
+	original.SetAnnotations(annotations)
+	return json.Marshal(original.Object)
+}
+
 func (t *deployerTrait) unstructuredToRuntimeObject(u *unstructured.Unstructured, obj ctrl.Object) error {
 	data, err := json.Marshal(u)
 	if err != nil {
@@ -155,6 +347,156 @@ func isIncompatibleServerError(err error) bool {
 	return false
 }
 
+// verifyImageSignature verifies the signature of the integration container image, and reports the outcome
+// with the ImageSignatureVerified condition. The container image is pinned to the digest of the verified image,
+// so that a tag that's moved afterwards can't deploy an unverified image.
+func (t *deployerTrait) verifyImageSignature(e *Environment) bool {
+	container := e.GetIntegrationContainer()
+	if container == nil {
+		return true
+	}
+
+	image, err := t.verifyImage(e, container.Image)
+	if err != nil {
+		t.L.ForIntegration(e.Integration).Errorf(err, "Image signature verification failed for integration %s", e.Integration.Name)
+		e.Integration.Status.SetCondition(
+			v1.IntegrationConditionImageSignatureVerified,
+			corev1.ConditionFalse,
+			v1.IntegrationConditionImageSignatureVerificationFailedReason,
+			err.Error(),
+		)
+		return false
+	}
+
+	container.Image = image
+	return true
+}
+
+// verifyImage returns the given image pinned to its digest, once its signature is verified.
+// A successful verification is not repeated for the same digest.
+func (t *deployerTrait) verifyImage(e *Environment, image string) (string, error) {
+	ref, options, err := remoteImageReference(e, image)
+	if err != nil {
+		return "", err
+	}
+	digest, ok := ref.(name.Digest)
+	if !ok {
+		descriptor, err := remote.Head(ref, options...)
+		if err != nil {
+			return "", fmt.Errorf("unable to get image %s: %w", image, err)
+		}
+		digest = ref.Context().Digest(descriptor.Digest.String())
+	}
+	pinned := pinImage(image, ref, digest)
+
+	message := fmt.Sprintf("image %s signature verified", pinned)
+	if condition := e.Integration.Status.GetCondition(v1.IntegrationConditionImageSignatureVerified); condition != nil &&
+		condition.Status == corev1.ConditionTrue && condition.Message == message {
+		return pinned, nil
+	}
+
+	secret, err := kubernetes.GetSecret(e.Ctx, e.Client, t.ImageSignatureKeySecret, e.Integration.Namespace)
+	if err != nil {
+		return "", fmt.Errorf("unable to get image signature key secret %s: %w", t.ImageSignatureKeySecret, err)
+	}
+	key, err := cosign.ParsePublicKey(secret.Data[imageSignatureKey])
+	if err != nil {
+		return "", fmt.Errorf("invalid %s key of image signature key secret %s: %w", imageSignatureKey, t.ImageSignatureKeySecret, err)
+	}
+
+	// The digest is verified, rather than the tag, that may have been moved since it's been resolved
+	if _, err := cosign.Verify(digest, key, options...); err != nil {
+		return "", fmt.Errorf("image signature verification failed: %w", err)
+	}
+
+	e.Integration.Status.SetCondition(
+		v1.IntegrationConditionImageSignatureVerified,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionImageSignatureVerifiedReason,
+		message,
+	)
+	return pinned, nil
+}
+
+// pinImage replaces the tag of the given image, if any, with the given digest.
+func pinImage(image string, ref name.Reference, digest name.Digest) string {
+	if tag, ok := ref.(name.Tag); ok {
+		return strings.TrimSuffix(image, ":"+tag.TagStr()) + "@" + digest.DigestStr()
+	}
+	return image
+}
+
+// remoteImageReference returns the reference of the given image, and the options to access its registry.
+func remoteImageReference(e *Environment, image string) (name.Reference, []remote.Option, error) {
+	var nameOptions []name.Option
+	options := []remote.Option{remote.WithContext(e.Ctx)}
+	// The integration images are usually pushed to the platform registry, that may be private or insecure
+	if e.Platform != nil && e.Platform.Status.Build.Registry.Address != "" &&
+		strings.HasPrefix(image, e.Platform.Status.Build.Registry.Address+"/") {
+		platformRegistry := e.Platform.Status.Build.Registry
+		if platformRegistry.Insecure {
+			nameOptions = append(nameOptions, name.Insecure)
+		}
+		if platformRegistry.Secret != "" {
+			auth, err := registryAuthenticator(e, platformRegistry.Secret, platformRegistry.Address)
+			if err != nil {
+				return nil, nil, err
+			}
+			options = append(options, remote.WithAuth(auth))
+		}
+	}
+
+	ref, err := name.ParseReference(image, nameOptions...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid image %s: %w", image, err)
+	}
+	return ref, options, nil
+}
+
+// registryAuthenticator returns the credentials for the registry from the Docker config Secret of the platform.
+func registryAuthenticator(e *Environment, secretName string, address string) (authn.Authenticator, error) {
+	secret, err := kubernetes.GetSecret(e.Ctx, e.Client, secretName, e.Platform.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get registry secret %s: %w", secretName, err)
+	}
+	data, ok := secret.Data[corev1.DockerConfigJsonKey]
+	if !ok {
+		return authn.Anonymous, nil
+	}
+	config := registry.DockerConfigList{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid registry secret %s: %w", secretName, err)
+	}
+	host := strings.SplitN(address, "/", 2)[0]
+	for server, auth := range config.Auths {
+		if server == host || strings.Contains(server, "://"+host) {
+			return authn.FromConfig(authn.AuthConfig{
+				Auth:     auth.Auth,
+				Username: auth.Username,
+				Password: auth.Password,
+			}), nil
+		}
+	}
+	return authn.Anonymous, nil
+}
+
+// isImageSignatureUnverified returns whether the deployer trait has rejected the integration image,
+// because its signature could not be verified.
+func isImageSignatureUnverified(e *Environment) bool {
+	if dt, ok := e.GetTrait(deployerTraitID).(*deployerTrait); ok {
+		return dt.unverified
+	}
+	return false
+}
+
+// isReconciliationPaused returns whether the deployer trait has paused the reconciliation of the integration resources.
+func isReconciliationPaused(e *Environment) bool {
+	if dt, ok := e.GetTrait(deployerTraitID).(*deployerTrait); ok {
+		return dt.paused
+	}
+	return false
+}
+
 func (t *deployerTrait) SelectControllerStrategy(e *Environment) (*ControllerStrategy, error) {
 	if !pointer.BoolDeref(t.Enabled, true) {
 		return nil, nil
@@ -179,3 +521,155 @@ func (t *deployerTrait) IsPlatformTrait() bool {
 func (t *deployerTrait) RequiresIntegrationPlatform() bool {
 	return false
 }
+
+// cronJobNameMaxLength is the maximum length of the CronJob names, so that the names of the Jobs they create are valid.
+const cronJobNameMaxLength = 52
+
+func (t *deployerTrait) resourceName(name string) string {
+	return t.NamePrefix + name + t.NameSuffix
+}
+
+// renameResources applies the name prefix and suffix to the resources named after the integration, and renames
+// the references between them accordingly. The other resources, e.g., the ones generated by the addons, are left unchanged.
+func (t *deployerTrait) renameResources(e *Environment) error {
+	if t.NamePrefix == "" && t.NameSuffix == "" {
+		return nil
+	}
+
+	configMaps := make(map[string]string)
+	secrets := make(map[string]string)
+	services := make(map[string]string)
+	controllers := make(map[string]string)
+	for _, resource := range e.Resources.Items() {
+		oldName := resource.GetName()
+		if oldName != e.Integration.Name && !strings.HasPrefix(oldName, e.Integration.Name+"-") {
+			continue
+		}
+		newName := t.resourceName(oldName)
+
+		var errs []string
+		switch resource.(type) {
+		case *corev1.ConfigMap:
+			configMaps[oldName] = newName
+		case *corev1.Secret:
+			secrets[oldName] = newName
+		case *corev1.Service:
+			services[oldName] = newName
+			errs = validation.IsDNS1035Label(newName)
+		case *serving.Service:
+			services[oldName] = newName
+			controllers[oldName] = newName
+			errs = validation.IsDNS1035Label(newName)
+		case *appsv1.Deployment, *appsv1.StatefulSet:
+			controllers[oldName] = newName
+		case *batchv1.CronJob:
+			controllers[oldName] = newName
+			if len(newName) > cronJobNameMaxLength {
+				errs = []string{validation.MaxLenError(cronJobNameMaxLength)}
+			}
+		case *networkingv1.Ingress, *routev1.Route, *policyv1.PodDisruptionBudget, *autoscalingv2.HorizontalPodAutoscaler:
+		default:
+			continue
+		}
+		if len(errs) == 0 {
+			errs = validation.IsDNS1123Subdomain(newName)
+		}
+		if len(errs) > 0 {
+			return fmt.Errorf("invalid name-prefix or name-suffix, the %s name %q is invalid: %s",
+				reflect.TypeOf(resource).Elem().Name(), newName, strings.Join(errs, ", "))
+		}
+		resource.SetName(newName)
+	}
+
+	rename := func(names map[string]string, name *string) {
+		if newName, ok := names[*name]; ok {
+			*name = newName
+		}
+	}
+	renamePodSpec := func(spec *corev1.PodSpec) {
+		for i := range spec.Volumes {
+			v := &spec.Volumes[i]
+			if v.ConfigMap != nil {
+				rename(configMaps, &v.ConfigMap.Name)
+			}
+			if v.Secret != nil {
+				rename(secrets, &v.Secret.SecretName)
+			}
+			if v.Projected != nil {
+				for _, source := range v.Projected.Sources {
+					if source.ConfigMap != nil {
+						rename(configMaps, &source.ConfigMap.Name)
+					} else if source.Secret != nil {
+						rename(secrets, &source.Secret.Name)
+					}
+				}
+			}
+		}
+		for _, containers := range [][]corev1.Container{spec.InitContainers, spec.Containers} {
+			for i := range containers {
+				for _, from := range containers[i].EnvFrom {
+					if from.ConfigMapRef != nil {
+						rename(configMaps, &from.ConfigMapRef.Name)
+					} else if from.SecretRef != nil {
+						rename(secrets, &from.SecretRef.Name)
+					}
+				}
+				for _, env := range containers[i].Env {
+					if from := env.ValueFrom; from != nil && from.ConfigMapKeyRef != nil {
+						rename(configMaps, &from.ConfigMapKeyRef.Name)
+					} else if from != nil && from.SecretKeyRef != nil {
+						rename(secrets, &from.SecretKeyRef.Name)
+					}
+				}
+			}
+		}
+	}
+
+	for _, resource := range e.Resources.Items() {
+		switch r := resource.(type) {
+		case *appsv1.Deployment:
+			renamePodSpec(&r.Spec.Template.Spec)
+		case *appsv1.StatefulSet:
+			rename(services, &r.Spec.ServiceName)
+			renamePodSpec(&r.Spec.Template.Spec)
+		case *batchv1.CronJob:
+			renamePodSpec(&r.Spec.JobTemplate.Spec.Template.Spec)
+		case *serving.Service:
+			renamePodSpec(&r.Spec.Template.Spec.PodSpec)
+		case *networkingv1.Ingress:
+			if b := r.Spec.DefaultBackend; b != nil && b.Service != nil {
+				rename(services, &b.Service.Name)
+			}
+			for i := range r.Spec.Rules {
+				if http := r.Spec.Rules[i].HTTP; http != nil {
+					for j := range http.Paths {
+						if b := http.Paths[j].Backend.Service; b != nil {
+							rename(services, &b.Name)
+						}
+					}
+				}
+			}
+			for i := range r.Spec.TLS {
+				rename(secrets, &r.Spec.TLS[i].SecretName)
+			}
+		case *routev1.Route:
+			if r.Spec.To.Kind == "Service" {
+				rename(services, &r.Spec.To.Name)
+			}
+		case *messaging.Subscription:
+			if d := r.Spec.Subscriber; d != nil && d.Ref != nil && d.Ref.Kind == "Service" {
+				rename(services, &d.Ref.Name)
+			}
+		case *eventing.Trigger:
+			if d := r.Spec.Subscriber.Ref; d != nil && d.Kind == "Service" {
+				rename(services, &d.Name)
+			}
+		case *sources.SinkBinding:
+			rename(controllers, &r.Spec.Subject.Name)
+		case *autoscalingv2.HorizontalPodAutoscaler:
+			rename(controllers, &r.Spec.ScaleTargetRef.Name)
+		}
+	}
+
+	return nil
+}
@@ -18,12 +18,41 @@ limitations under the License.
 package trait
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/apache/camel-k/pkg/util/test"
 	"github.com/stretchr/testify/assert"
 
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	serving "knative.dev/serving/pkg/apis/serving/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestConfigureDeployerTraitDoesSucceed(t *testing.T) {
@@ -64,6 +93,633 @@ func TestApplyDeployerTraitInInitializationPhaseDoesSucceed(t *testing.T) {
 	assert.Len(t, environment.PostActions, 1)
 }
 
+func TestApplyPausedDeployerTrait(t *testing.T) {
+	deployerTrait, environment := createNominalDeployerTest()
+	deployerTrait.Paused = pointer.Bool(true)
+
+	configured, err := deployerTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+	err = deployerTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Len(t, environment.PostActions, 0)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionReconciliationPaused)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "reconciliation paused by the deployer trait", condition.Message)
+
+	environment.ExecutedTraits = append(environment.ExecutedTraits, deployerTrait)
+	assert.True(t, isReconciliationPaused(environment))
+}
+
+func TestApplyDeployerTraitPausedUntil(t *testing.T) {
+	deployerTrait, environment := createNominalDeployerTest()
+	deployerTrait.PausedUntil = time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+
+	configured, err := deployerTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+	err = deployerTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Len(t, environment.PostActions, 0)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionReconciliationPaused)
+	assert.NotNil(t, condition)
+	assert.Equal(t, "reconciliation paused by the deployer trait until "+deployerTrait.PausedUntil, condition.Message)
+}
+
+func TestApplyDeployerTraitResumed(t *testing.T) {
+	deployerTrait, environment := createNominalDeployerTest()
+	deployerTrait.PausedUntil = time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	environment.Integration.Status.SetCondition(
+		v1.IntegrationConditionReconciliationPaused,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionReconciliationPausedReason,
+		"reconciliation paused by the deployer trait",
+	)
+
+	configured, err := deployerTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+	err = deployerTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Len(t, environment.PostActions, 1)
+	assert.Nil(t, environment.Integration.Status.GetCondition(v1.IntegrationConditionReconciliationPaused))
+}
+
+func TestConfigureDeployerTraitWithInvalidPausedUntil(t *testing.T) {
+	deployerTrait, environment := createNominalDeployerTest()
+	deployerTrait.PausedUntil = "tomorrow"
+
+	configured, err := deployerTrait.Configure(environment)
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `invalid paused-until timestamp "tomorrow"`)
+}
+
+func TestConfigureDeployerTraitWithUnsupportedKind(t *testing.T) {
+	deployerTrait, environment := createNominalDeployerTest()
+	deployerTrait.Kind = "daemon-set"
+
+	configured, err := deployerTrait.Configure(environment)
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `unsupported deployer kind "daemon-set"`)
+}
+
+func TestConfigureDeployerTraitVerifyImageSignatureWithoutKey(t *testing.T) {
+	deployerTrait, environment := createNominalDeployerTest()
+	deployerTrait.VerifyImageSignature = pointer.Bool(true)
+
+	configured, err := deployerTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestApplyDeployerTraitVerifyImageSignatureFailure(t *testing.T) {
+	deployerTrait, environment := createImageSignatureDeployerTest(t, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "cosign",
+		},
+		Data: map[string][]byte{
+			"cosign.pub": []byte("not a public key"),
+		},
+	})
+
+	configured, err := deployerTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+	err = deployerTrait.Apply(environment)
+	assert.Nil(t, err)
+	assert.Len(t, environment.PostActions, 1)
+
+	err = environment.PostActions[0](environment)
+	assert.Nil(t, err)
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionImageSignatureVerified)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionImageSignatureVerificationFailedReason, condition.Reason)
+	assert.Contains(t, condition.Message, "invalid cosign.pub key of image signature key secret cosign")
+
+	environment.ExecutedTraits = append(environment.ExecutedTraits, deployerTrait)
+	assert.True(t, isImageSignatureUnverified(environment))
+
+	// The resources must not have been created
+	deployment := &appsv1.Deployment{}
+	err = environment.Client.Get(environment.Ctx, ctrl.ObjectKey{Namespace: "ns", Name: "integration-name"}, deployment)
+	assert.NotNil(t, err)
+}
+
+func TestApplyDeployerTraitVerifyImageSignatureMissingSecret(t *testing.T) {
+	deployerTrait, environment := createImageSignatureDeployerTest(t)
+
+	err := deployerTrait.Apply(environment)
+	assert.Nil(t, err)
+	err = environment.PostActions[0](environment)
+	assert.Nil(t, err)
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionImageSignatureVerified)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Contains(t, condition.Message, "unable to get image signature key secret cosign")
+}
+
+func TestApplyDeployerTraitVerifyImageSignatureAlreadyVerified(t *testing.T) {
+	deployerTrait, environment := createImageSignatureDeployerTest(t)
+	environment.Integration.Status.SetCondition(
+		v1.IntegrationConditionImageSignatureVerified,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionImageSignatureVerifiedReason,
+		"image registry.example.com/test/integration@"+imageSignatureTestDigest+" signature verified",
+	)
+
+	err := deployerTrait.Apply(environment)
+	assert.Nil(t, err)
+	err = environment.PostActions[0](environment)
+	assert.Nil(t, err)
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionImageSignatureVerified)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.False(t, deployerTrait.unverified)
+}
+
+func TestApplyDeployerTraitVerifyImageSignaturePinsTheDigest(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+	image := strings.TrimPrefix(server.URL, "http://") + "/test/integration:latest"
+	ref, err := name.ParseReference(image)
+	assert.Nil(t, err)
+	img, err := random.Image(256, 1)
+	assert.Nil(t, err)
+	assert.Nil(t, remote.Write(ref, img))
+	digest, err := img.Digest()
+	assert.Nil(t, err)
+	pinned := strings.TrimSuffix(image, ":latest") + "@" + digest.String()
+
+	deployerTrait, environment := createImageSignatureDeployerTest(t)
+	container := environment.GetIntegrationContainer()
+	container.Image = image
+	// The digest the tag resolves to has already been verified
+	environment.Integration.Status.SetCondition(
+		v1.IntegrationConditionImageSignatureVerified,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionImageSignatureVerifiedReason,
+		"image "+pinned+" signature verified",
+	)
+
+	err = deployerTrait.Apply(environment)
+	assert.Nil(t, err)
+	err = environment.PostActions[0](environment)
+	assert.Nil(t, err)
+	assert.False(t, deployerTrait.unverified)
+	assert.Equal(t, pinned, container.Image)
+
+	// The tag is moved to another image, that must be verified
+	img, err = random.Image(256, 1)
+	assert.Nil(t, err)
+	assert.Nil(t, remote.Write(ref, img))
+	container.Image = image
+
+	deployerTrait.unverified = false
+	err = deployerTrait.Apply(environment)
+	assert.Nil(t, err)
+	err = environment.PostActions[1](environment)
+	assert.Nil(t, err)
+	assert.True(t, deployerTrait.unverified)
+	assert.Equal(t, image, container.Image)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionImageSignatureVerified)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Contains(t, condition.Message, "unable to get image signature key secret cosign")
+}
+
+func TestApplyDeployerTraitWithoutImageSignatureVerification(t *testing.T) {
+	deployerTrait, environment := createNominalDeployerTest()
+	environment.Integration.Status.SetCondition(
+		v1.IntegrationConditionImageSignatureVerified,
+		corev1.ConditionFalse,
+		v1.IntegrationConditionImageSignatureVerificationFailedReason,
+		"image signature verification failed",
+	)
+
+	err := deployerTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Nil(t, environment.Integration.Status.GetCondition(v1.IntegrationConditionImageSignatureVerified))
+}
+
+func TestConfigureDeployerTraitWithInvalidNamePrefix(t *testing.T) {
+	deployerTrait, environment := createNominalDeployerTest()
+	deployerTrait.NamePrefix = "Deploy_"
+
+	configured, err := deployerTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `invalid name-prefix or name-suffix, the "Deploy_integration-name" resources name is invalid`)
+}
+
+func TestApplyDeployerTraitWithNamePrefixAndSuffix(t *testing.T) {
+	deployerTrait, environment := createNominalDeployerTest()
+	deployerTrait.NamePrefix = "app-"
+	deployerTrait.NameSuffix = "-prod"
+	environment.Resources = kubernetes.NewCollection(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "integration-name"},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: defaultContainerName,
+								EnvFrom: []corev1.EnvFromSource{
+									{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "integration-name-env"}}},
+								},
+							},
+						},
+						Volumes: []corev1.Volume{
+							{
+								Name: "application-properties",
+								VolumeSource: corev1.VolumeSource{
+									ConfigMap: &corev1.ConfigMapVolumeSource{
+										LocalObjectReference: corev1.LocalObjectReference{Name: "integration-name-application-properties"},
+									},
+								},
+							},
+							{
+								Name: "user-config",
+								VolumeSource: corev1.VolumeSource{
+									ConfigMap: &corev1.ConfigMapVolumeSource{
+										LocalObjectReference: corev1.LocalObjectReference{Name: "user-config"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "integration-name-application-properties"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "integration-name-env"}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "integration-name"}},
+		&networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "integration-name"},
+			Spec: networkingv1.IngressSpec{
+				DefaultBackend: &networkingv1.IngressBackend{
+					Service: &networkingv1.IngressServiceBackend{Name: "integration-name"},
+				},
+			},
+		},
+		&autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "integration-name"},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", Name: "integration-name"},
+			},
+		},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "integration-name"}},
+	)
+
+	err := deployerTrait.renameResources(environment)
+	assert.Nil(t, err)
+
+	deployment := environment.Resources.GetDeployment(func(*appsv1.Deployment) bool { return true })
+	assert.Equal(t, "app-integration-name-prod", deployment.Name)
+	podSpec := deployment.Spec.Template.Spec
+	assert.Equal(t, "app-integration-name-env-prod", podSpec.Containers[0].EnvFrom[0].SecretRef.Name)
+	assert.Equal(t, "app-integration-name-application-properties-prod", podSpec.Volumes[0].ConfigMap.Name)
+	// The resources that are not generated are not renamed
+	assert.Equal(t, "user-config", podSpec.Volumes[1].ConfigMap.Name)
+	assert.NotNil(t, environment.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool {
+		return cm.Name == "app-integration-name-application-properties-prod"
+	}))
+	assert.NotNil(t, environment.Resources.GetService(func(s *corev1.Service) bool { return s.Name == "app-integration-name-prod" }))
+	for _, r := range environment.Resources.Items() {
+		switch o := r.(type) {
+		case *networkingv1.Ingress:
+			assert.Equal(t, "app-integration-name-prod", o.Name)
+			assert.Equal(t, "app-integration-name-prod", o.Spec.DefaultBackend.Service.Name)
+		case *autoscalingv2.HorizontalPodAutoscaler:
+			assert.Equal(t, "app-integration-name-prod", o.Name)
+			assert.Equal(t, "app-integration-name-prod", o.Spec.ScaleTargetRef.Name)
+		case *corev1.ServiceAccount:
+			// The resources of unsupported kinds are not renamed
+			assert.Equal(t, "integration-name", o.Name)
+		}
+	}
+}
+
+func TestApplyDeployerTraitWithTooLongCronJobName(t *testing.T) {
+	deployerTrait, environment := createNominalDeployerTest()
+	deployerTrait.NameSuffix = "-scheduled-with-a-much-much-longer-suffix"
+	environment.Resources = kubernetes.NewCollection(
+		&batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: "integration-name"}},
+	)
+
+	configured, err := deployerTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	err = deployerTrait.renameResources(environment)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `the CronJob name "integration-name-scheduled-with-a-much-much-longer-suffix" is invalid: must be no more than 52 characters`)
+}
+
+func TestApplyDeployerTraitWithThreeWayPatch(t *testing.T) {
+	original := newLabelledDeployment(map[string]string{"managed": "1", "removed": "1"})
+	current := newLabelledDeployment(map[string]string{"managed": "1", "removed": "1", "external": "1"})
+	current.Annotations = map[string]string{lastAppliedConfigurationAnnotation: toJSON(t, original)}
+	deployerTrait, environment, c := createPatchDeployerTest(t, current,
+		newLabelledDeployment(map[string]string{"managed": "2"}))
+	deployerTrait.ThreeWayPatch = pointer.Bool(true)
+	environment.Integration.Status.SetCondition(v1.IntegrationConditionResourcesConflict, corev1.ConditionTrue,
+		v1.IntegrationConditionResourcesConflictReason, "resources modified by other actors are not patched")
+
+	err := deployerTrait.Apply(environment)
+	assert.Nil(t, err)
+	err = environment.PostActions[0](environment)
+	assert.Nil(t, err)
+
+	assert.Len(t, c.patches, 1)
+	var p map[string]interface{}
+	assert.Nil(t, json.Unmarshal(c.patches[0], &p))
+	metadata, _ := p["metadata"].(map[string]interface{})
+	// The removed label is deleted, while the one added by another actor is preserved
+	assert.Equal(t, map[string]interface{}{"managed": "2", "removed": nil}, metadata["labels"])
+	assert.Contains(t, metadata["annotations"], lastAppliedConfigurationAnnotation)
+	assert.Nil(t, environment.Integration.Status.GetCondition(v1.IntegrationConditionResourcesConflict))
+
+	deployment := environment.Resources.GetDeployment(func(*appsv1.Deployment) bool { return true })
+	applied := appsv1.Deployment{}
+	assert.Nil(t, json.Unmarshal([]byte(deployment.Annotations[lastAppliedConfigurationAnnotation]), &applied))
+	assert.Equal(t, map[string]string{"managed": "2"}, applied.Labels)
+	assert.NotContains(t, applied.Annotations, lastAppliedConfigurationAnnotation)
+}
+
+func TestApplyDeployerTraitFailOnConflictWithClientSideApply(t *testing.T) {
+	original := newLabelledDeployment(map[string]string{"managed": "1"})
+	current := newLabelledDeployment(map[string]string{"managed": "edited"})
+	current.Annotations = map[string]string{lastAppliedConfigurationAnnotation: toJSON(t, original)}
+	deployerTrait, environment, c := createPatchDeployerTest(t, current,
+		newLabelledDeployment(map[string]string{"managed": "2"}))
+	deployerTrait.FailOnConflict = pointer.Bool(true)
+
+	err := deployerTrait.Apply(environment)
+	assert.Nil(t, err)
+	err = environment.PostActions[0](environment)
+	assert.Nil(t, err)
+
+	// The conflicting changes are not overwritten
+	assert.Len(t, c.patches, 0)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionResourcesConflict)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionResourcesConflictReason, condition.Reason)
+	assert.Equal(t, "resources modified by other actors are not patched: Deployment integration-name", condition.Message)
+}
+
+func TestApplyDeployerTraitFailOnConflictWithContainerImageChange(t *testing.T) {
+	original := newContainerDeployment("image:1")
+	current := newContainerDeployment("image:1")
+	current.Annotations = map[string]string{lastAppliedConfigurationAnnotation: toJSON(t, original)}
+	// Fields defaulted server-side, and a label added by another actor
+	current.Labels = map[string]string{"external": "1"}
+	current.Spec.Replicas = pointer.Int32(1)
+	current.Spec.Template.Spec.Containers[0].ImagePullPolicy = corev1.PullIfNotPresent
+	current.Spec.Template.Spec.Containers[0].TerminationMessagePath = corev1.TerminationMessagePathDefault
+	deployerTrait, environment, c := createPatchDeployerTest(t, current, newContainerDeployment("image:2"))
+	deployerTrait.FailOnConflict = pointer.Bool(true)
+
+	err := deployerTrait.Apply(environment)
+	assert.Nil(t, err)
+	err = environment.PostActions[0](environment)
+	assert.Nil(t, err)
+
+	assert.Nil(t, environment.Integration.Status.GetCondition(v1.IntegrationConditionResourcesConflict))
+	assert.Len(t, c.patches, 1)
+	assert.Equal(t, []types.PatchType{types.StrategicMergePatchType}, c.patchTypes)
+	var p map[string]interface{}
+	assert.Nil(t, json.Unmarshal(c.patches[0], &p))
+	spec, _ := p["spec"].(map[string]interface{})
+	template, _ := spec["template"].(map[string]interface{})
+	podSpec, _ := template["spec"].(map[string]interface{})
+	// The container is merged by name, so that the defaulted fields are preserved
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "integration", "image": "image:2"}}, podSpec["containers"])
+	assert.NotContains(t, spec, "replicas")
+	metadata, _ := p["metadata"].(map[string]interface{})
+	assert.NotContains(t, metadata, "labels")
+}
+
+func TestApplyDeployerTraitFailOnConflictWithContainerImageEdited(t *testing.T) {
+	original := newContainerDeployment("image:1")
+	current := newContainerDeployment("image:edited")
+	current.Annotations = map[string]string{lastAppliedConfigurationAnnotation: toJSON(t, original)}
+	deployerTrait, environment, c := createPatchDeployerTest(t, current, newContainerDeployment("image:2"))
+	deployerTrait.FailOnConflict = pointer.Bool(true)
+
+	err := deployerTrait.Apply(environment)
+	assert.Nil(t, err)
+	err = environment.PostActions[0](environment)
+	assert.Nil(t, err)
+
+	assert.Len(t, c.patches, 0)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionResourcesConflict)
+	assert.NotNil(t, condition)
+	assert.Equal(t, "resources modified by other actors are not patched: Deployment integration-name", condition.Message)
+}
+
+func TestApplyDeployerTraitFailOnConflictWithCustomResource(t *testing.T) {
+	original := newUnstructuredService("image:1", false)
+	current := newUnstructuredService("image:1", true)
+	current.SetAnnotations(map[string]string{lastAppliedConfigurationAnnotation: toJSON(t, original.Object)})
+	deployerTrait, environment, c := createPatchDeployerTest(t, current, newUnstructuredService("image:2", false))
+	deployerTrait.FailOnConflict = pointer.Bool(true)
+
+	err := deployerTrait.Apply(environment)
+	assert.Nil(t, err)
+	err = environment.PostActions[0](environment)
+	assert.Nil(t, err)
+
+	// The fields defaulted server-side are not detected as conflicting changes
+	assert.Nil(t, environment.Integration.Status.GetCondition(v1.IntegrationConditionResourcesConflict))
+	assert.Len(t, c.patches, 1)
+	assert.Equal(t, []types.PatchType{types.MergePatchType}, c.patchTypes)
+}
+
+func TestApplyDeployerTraitFailOnConflictWithServerSideApply(t *testing.T) {
+	deployerTrait, environment, c := createPatchDeployerTest(t, nil,
+		newLabelledDeployment(map[string]string{"managed": "2"}))
+	deployerTrait.UseSSA = nil
+	deployerTrait.FailOnConflict = pointer.Bool(true)
+	var force *bool
+	c.patch = func(obj ctrl.Object, options *ctrl.PatchOptions) error {
+		force = options.Force
+		return k8serrors.NewConflict(schema.GroupResource{Group: "apps", Resource: "deployments"}, obj.GetName(),
+			errors.New(`Apply failed with 1 conflict: conflict with "kubectl-edit": .metadata.labels.managed`))
+	}
+
+	err := deployerTrait.Apply(environment)
+	assert.Nil(t, err)
+	err = environment.PostActions[0](environment)
+	assert.Nil(t, err)
+
+	// The ownership of the fields managed by other field managers is not forced
+	assert.Len(t, c.patches, 1)
+	assert.Nil(t, force)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionResourcesConflict)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Contains(t, condition.Message, "Deployment integration-name")
+}
+
+func createPatchDeployerTest(t *testing.T, current ctrl.Object, resource ctrl.Object) (*deployerTrait, *Environment, *patchRecordingClient) {
+	t.Helper()
+
+	deployerTrait, environment := createNominalDeployerTest()
+	deployerTrait.UseSSA = pointer.Bool(false)
+
+	var objects []runtime.Object
+	if current != nil {
+		objects = append(objects, current)
+	}
+	fakeClient, err := test.NewFakeClient(objects...)
+	assert.Nil(t, err)
+	c := &patchRecordingClient{Client: fakeClient}
+	environment.Client = c
+	environment.Ctx = context.TODO()
+	environment.Integration.Namespace = "ns"
+	environment.Resources = kubernetes.NewCollection(resource)
+
+	return deployerTrait, environment, c
+}
+
+func newLabelledDeployment(labels map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "integration-name",
+			Labels:    labels,
+		},
+	}
+}
+
+func newContainerDeployment(image string) *appsv1.Deployment {
+	deployment := newLabelledDeployment(nil)
+	deployment.Spec.Template.Spec.Containers = []corev1.Container{
+		{
+			Name:  "integration",
+			Image: image,
+		},
+	}
+	return deployment
+}
+
+func newUnstructuredService(image string, defaulted bool) *unstructured.Unstructured {
+	container := map[string]interface{}{
+		"name":  "integration",
+		"image": image,
+	}
+	if defaulted {
+		container["imagePullPolicy"] = "IfNotPresent"
+	}
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": serving.SchemeGroupVersion.String(),
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"namespace": "ns",
+				"name":      "integration-name",
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{container},
+					},
+				},
+			},
+		},
+	}
+}
+
+func toJSON(t *testing.T, obj interface{}) string {
+	t.Helper()
+
+	data, err := json.Marshal(obj)
+	assert.Nil(t, err)
+	return string(data)
+}
+
+// patchRecordingClient records the patches, and returns the error the given function returns for them.
+type patchRecordingClient struct {
+	client.Client
+	patch      func(obj ctrl.Object, options *ctrl.PatchOptions) error
+	patches    [][]byte
+	patchTypes []types.PatchType
+}
+
+func (c *patchRecordingClient) Patch(ctx context.Context, obj ctrl.Object, patch ctrl.Patch, opts ...ctrl.PatchOption) error {
+	options := &ctrl.PatchOptions{}
+	options.ApplyOptions(opts)
+	data, err := patch.Data(obj)
+	if err != nil {
+		return err
+	}
+	c.patches = append(c.patches, data)
+	c.patchTypes = append(c.patchTypes, patch.Type())
+	if c.patch != nil {
+		return c.patch(obj, options)
+	}
+	return nil
+}
+
+const imageSignatureTestDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func createImageSignatureDeployerTest(t *testing.T, objects ...runtime.Object) (*deployerTrait, *Environment) {
+	t.Helper()
+
+	deployerTrait, environment := createNominalDeployerTest()
+	deployerTrait.VerifyImageSignature = pointer.Bool(true)
+	deployerTrait.ImageSignatureKeySecret = "cosign"
+	deployerTrait.UseSSA = pointer.Bool(false)
+
+	client, err := test.NewFakeClient(objects...)
+	assert.Nil(t, err)
+	environment.Client = client
+	environment.Ctx = context.TODO()
+	environment.Integration.Namespace = "ns"
+	environment.Resources = kubernetes.NewCollection(&appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "integration-name",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  defaultContainerName,
+							Image: "registry.example.com/test/integration@" + imageSignatureTestDigest,
+						},
+					},
+				},
+			},
+		},
+	})
+
+	return deployerTrait, environment
+}
+
 func createNominalDeployerTest() (*deployerTrait, *Environment) {
 	trait, _ := newDeployerTrait().(*deployerTrait)
 
@@ -39,6 +39,18 @@ type deploymentTrait struct {
 
 var _ ControllerStrategySelector = &deploymentTrait{}
 
+// defaultShutdownGracePeriodExtension is the time, in addition to the shutdown timeout,
+// granted to the pods to terminate, e.g., to account for the pre-stop hook and the JVM exit.
+const defaultShutdownGracePeriodExtension = int64(10)
+
+const (
+	// defaultConsumerPauseSeconds is the time granted to the outgoing pod to drain its paused consumers.
+	defaultConsumerPauseSeconds = int64(30)
+	// exclusiveConsumersMinReadySeconds is the time an incoming pod must have been ready for,
+	// before the rollout proceeds with pausing the consumers of the next outgoing pod.
+	exclusiveConsumersMinReadySeconds = int32(10)
+)
+
 func newDeploymentTrait() Trait {
 	return &deploymentTrait{
 		BaseTrait: NewBaseTrait("deployment", 1100),
@@ -60,6 +72,13 @@ func (t *deploymentTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
+	if err := t.validateExclusiveConsumers(); err != nil {
+		return false, err
+	}
+	if err := t.validateGracePeriod(); err != nil {
+		return false, err
+	}
+
 	if e.IntegrationInPhase(v1.IntegrationPhaseRunning, v1.IntegrationPhaseError) {
 		condition := e.Integration.Status.GetCondition(v1.IntegrationConditionDeploymentAvailable)
 		return condition != nil && condition.Status == corev1.ConditionTrue, nil
@@ -87,9 +106,86 @@ func (t *deploymentTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
+	if pointer.BoolDeref(t.ExclusiveConsumers, false) && !isReadinessProbeEnabled(e) {
+		return false, fmt.Errorf("exclusive consumers require the health trait readiness probe to gate the rollout")
+	}
+	if pointer.BoolDeref(t.ExclusiveConsumers, false) && t.Strategy != appsv1.RecreateDeploymentStrategyType &&
+		e.Integration.Spec.Replicas != nil && *e.Integration.Spec.Replicas > 1 {
+		return false, fmt.Errorf("exclusive consumers require a single replica (%d) with the rolling update strategy, "+
+			"use the Recreate strategy to run more replicas", *e.Integration.Spec.Replicas)
+	}
+
 	return e.IntegrationInPhase(v1.IntegrationPhaseDeploying), nil
 }
 
+func (t *deploymentTrait) validateExclusiveConsumers() error {
+	if !pointer.BoolDeref(t.ExclusiveConsumers, false) {
+		if t.ConsumerPauseSeconds != nil {
+			return fmt.Errorf("consumer pause can only be set when exclusive consumers are enabled")
+		}
+		return nil
+	}
+	if t.ConsumerPauseSeconds != nil && *t.ConsumerPauseSeconds < 0 {
+		return fmt.Errorf("consumer pause (%ds) must not be negative", *t.ConsumerPauseSeconds)
+	}
+	if pause := t.consumerPause(); t.ShutdownTimeout != nil && *t.ShutdownTimeout < pause {
+		return fmt.Errorf("shutdown timeout (%ds) must not be lower than the consumer pause (%ds)", *t.ShutdownTimeout, pause)
+	}
+	if t.Strategy == appsv1.RecreateDeploymentStrategyType {
+		// All the outgoing pods are terminated before any incoming pod is created
+		return nil
+	}
+	if t.RollingUpdateMaxSurge != nil && *t.RollingUpdateMaxSurge != 0 {
+		return fmt.Errorf("rolling update max surge (%d) must be 0 with exclusive consumers", *t.RollingUpdateMaxSurge)
+	}
+	if t.RollingUpdateMaxUnavailable != nil && *t.RollingUpdateMaxUnavailable < 1 {
+		return fmt.Errorf("rolling update max unavailable (%d) must be at least 1 with exclusive consumers", *t.RollingUpdateMaxUnavailable)
+	}
+	return nil
+}
+
+func (t *deploymentTrait) validateGracePeriod() error {
+	shutdownTimeout := t.shutdownTimeout()
+	if shutdownTimeout != nil && *shutdownTimeout < 0 {
+		return fmt.Errorf("shutdown timeout (%ds) must not be negative", *shutdownTimeout)
+	}
+	if t.TerminationGracePeriodSeconds == nil {
+		return nil
+	}
+	if *t.TerminationGracePeriodSeconds < 0 {
+		return fmt.Errorf("termination grace period (%ds) must not be negative", *t.TerminationGracePeriodSeconds)
+	}
+	if shutdownTimeout != nil && *shutdownTimeout >= *t.TerminationGracePeriodSeconds {
+		return fmt.Errorf("shutdown timeout (%ds) must be lower than the termination grace period (%ds)", *shutdownTimeout, *t.TerminationGracePeriodSeconds)
+	}
+	return nil
+}
+
+func (t *deploymentTrait) consumerPause() int64 {
+	if t.ConsumerPauseSeconds != nil {
+		return *t.ConsumerPauseSeconds
+	}
+	return defaultConsumerPauseSeconds
+}
+
+// shutdownTimeout returns the runtime shutdown timeout, that defaults to the consumer pause
+// when exclusive consumers are enabled, so that the outgoing pod drains its consumers before exiting.
+func (t *deploymentTrait) shutdownTimeout() *int64 {
+	if t.ShutdownTimeout == nil && pointer.BoolDeref(t.ExclusiveConsumers, false) {
+		return pointer.Int64(t.consumerPause())
+	}
+	return t.ShutdownTimeout
+}
+
+// isReadinessProbeEnabled returns whether the health trait configures the integration container readiness probe.
+func isReadinessProbeEnabled(e *Environment) bool {
+	if e.Catalog == nil {
+		return false
+	}
+	ht, ok := e.Catalog.GetTrait(healthTraitID).(*healthTrait)
+	return ok && pointer.BoolDeref(ht.Enabled, false) && pointer.BoolDeref(ht.ReadinessProbeEnabled, true)
+}
+
 func (t *deploymentTrait) SelectControllerStrategy(e *Environment) (*ControllerStrategy, error) {
 	if !pointer.BoolDeref(t.Enabled, true) {
 		return nil, nil
@@ -106,6 +202,15 @@ func (t *deploymentTrait) Apply(e *Environment) error {
 	deployment := t.getDeploymentFor(e)
 	e.Resources.Add(deployment)
 
+	if shutdownTimeout := t.shutdownTimeout(); shutdownTimeout != nil {
+		e.setShutdownTimeout(*shutdownTimeout)
+	}
+
+	if t.PreStopPath != "" {
+		// The integration container is only created by the container trait
+		e.PostProcessors = append(e.PostProcessors, t.addPreStopHook)
+	}
+
 	e.Integration.Status.SetCondition(
 		v1.IntegrationConditionDeploymentAvailable,
 		corev1.ConditionTrue,
@@ -198,6 +303,10 @@ func (t *deploymentTrait) getDeploymentFor(e *Environment) *appsv1.Deployment {
 		}
 	}
 
+	if pointer.BoolDeref(t.ExclusiveConsumers, false) {
+		t.configureExclusiveConsumers(&deployment)
+	}
+
 	// Reconcile the deployment replicas
 	replicas := e.Integration.Spec.Replicas
 	// Deployment replicas defaults to 1, so we avoid forcing
@@ -209,5 +318,57 @@ func (t *deploymentTrait) getDeploymentFor(e *Environment) *appsv1.Deployment {
 	}
 	deployment.Spec.Replicas = replicas
 
+	if t.TerminationGracePeriodSeconds != nil {
+		deployment.Spec.Template.Spec.TerminationGracePeriodSeconds = t.TerminationGracePeriodSeconds
+	} else if shutdownTimeout := t.shutdownTimeout(); shutdownTimeout != nil {
+		deployment.Spec.Template.Spec.TerminationGracePeriodSeconds = pointer.Int64(*shutdownTimeout + defaultShutdownGracePeriodExtension)
+	}
+
 	return &deployment
 }
+
+// configureExclusiveConsumers configures the rollout, so that an incoming pod is only created once an outgoing pod
+// has been terminated, and the next outgoing pod is only terminated once the incoming pod has been ready for a while.
+func (t *deploymentTrait) configureExclusiveConsumers(deployment *appsv1.Deployment) {
+	deployment.Spec.MinReadySeconds = exclusiveConsumersMinReadySeconds
+	if t.Strategy == appsv1.RecreateDeploymentStrategyType {
+		return
+	}
+
+	maxSurge := intstr.FromInt(0)
+	maxUnavailable := intstr.FromInt(1)
+	if t.RollingUpdateMaxUnavailable != nil {
+		maxUnavailable = intstr.FromInt(*t.RollingUpdateMaxUnavailable)
+	}
+	deployment.Spec.Strategy = appsv1.DeploymentStrategy{
+		Type: appsv1.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateDeployment{
+			MaxSurge:       &maxSurge,
+			MaxUnavailable: &maxUnavailable,
+		},
+	}
+}
+
+func (t *deploymentTrait) addPreStopHook(e *Environment) error {
+	container := e.GetIntegrationContainer()
+	if container == nil {
+		return fmt.Errorf("unable to find integration container: %s", e.Integration.Name)
+	}
+
+	port := intstr.FromInt(defaultContainerPort)
+	if containerPort := e.getIntegrationContainerPort(); containerPort != nil {
+		port = intstr.FromString(containerPort.Name)
+	}
+
+	if container.Lifecycle == nil {
+		container.Lifecycle = &corev1.Lifecycle{}
+	}
+	container.Lifecycle.PreStop = &corev1.LifecycleHandler{
+		HTTPGet: &corev1.HTTPGetAction{
+			Path: t.PreStopPath,
+			Port: port,
+		},
+	}
+
+	return nil
+}
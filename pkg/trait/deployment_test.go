@@ -25,6 +25,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
@@ -235,6 +236,225 @@ func TestApplyDeploymentTraitWitRollingUpdateStrategy(t *testing.T) {
 	})
 }
 
+func TestApplyDeploymentTraitWithGracefulShutdown(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	deploymentTrait.PreStopPath = "/shutdown"
+	deploymentTrait.ShutdownTimeout = pointer.Int64(30)
+
+	configured, err := deploymentTrait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+	err = deploymentTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	deployment := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool { return true })
+	assert.NotNil(t, deployment)
+	assert.Equal(t, pointer.Int64(40), deployment.Spec.Template.Spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, "30s", environment.ApplicationProperties["quarkus.shutdown.timeout"])
+	assert.Equal(t, "30", environment.ApplicationProperties["camel.main.shutdown-timeout"])
+
+	deployment.Spec.Template.Spec.Containers = []corev1.Container{
+		{
+			Name: defaultContainerName,
+			Ports: []corev1.ContainerPort{
+				{
+					Name:          defaultContainerPortName,
+					ContainerPort: defaultContainerPort,
+				},
+			},
+		},
+	}
+	assert.Len(t, environment.PostProcessors, 1)
+	err = environment.PostProcessors[0](environment)
+	assert.Nil(t, err)
+
+	container := deployment.Spec.Template.Spec.Containers[0]
+	assert.NotNil(t, container.Lifecycle)
+	assert.Equal(t, &corev1.HTTPGetAction{
+		Path: "/shutdown",
+		Port: intstr.FromString(defaultContainerPortName),
+	}, container.Lifecycle.PreStop.HTTPGet)
+}
+
+func TestApplyDeploymentTraitWithTerminationGracePeriod(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	deploymentTrait.ShutdownTimeout = pointer.Int64(30)
+	deploymentTrait.TerminationGracePeriodSeconds = pointer.Int64(60)
+
+	configured, err := deploymentTrait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+	err = deploymentTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	deployment := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool { return true })
+	assert.NotNil(t, deployment)
+	assert.Equal(t, pointer.Int64(60), deployment.Spec.Template.Spec.TerminationGracePeriodSeconds)
+	assert.Empty(t, environment.PostProcessors)
+}
+
+func TestConfigureDeploymentTraitWithShortTerminationGracePeriodFails(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	deploymentTrait.ShutdownTimeout = pointer.Int64(30)
+	deploymentTrait.TerminationGracePeriodSeconds = pointer.Int64(30)
+
+	configured, err := deploymentTrait.Configure(environment)
+	assert.False(t, configured)
+	assert.EqualError(t, err, "shutdown timeout (30s) must be lower than the termination grace period (30s)")
+}
+
+func TestApplyDeploymentTraitWithExclusiveConsumers(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	deploymentTrait.ExclusiveConsumers = pointer.Bool(true)
+	environment.Integration.Spec.Replicas = pointer.Int32(1)
+	enableExclusiveConsumersReadinessProbe(environment)
+
+	configured, err := deploymentTrait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+	err = deploymentTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	deployment := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool { return true })
+	assert.NotNil(t, deployment)
+	assert.Equal(t, appsv1.RollingUpdateDeploymentStrategyType, deployment.Spec.Strategy.Type)
+	assert.Equal(t, intstr.FromInt(0), *deployment.Spec.Strategy.RollingUpdate.MaxSurge)
+	assert.Equal(t, intstr.FromInt(1), *deployment.Spec.Strategy.RollingUpdate.MaxUnavailable)
+	assert.Equal(t, int32(10), deployment.Spec.MinReadySeconds)
+	assert.Equal(t, pointer.Int64(40), deployment.Spec.Template.Spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, "30s", environment.ApplicationProperties["quarkus.shutdown.timeout"])
+	assert.Equal(t, "30", environment.ApplicationProperties["camel.main.shutdown-timeout"])
+}
+
+func TestApplyDeploymentTraitWithExclusiveConsumersAndConsumerPause(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	deploymentTrait.ExclusiveConsumers = pointer.Bool(true)
+	deploymentTrait.ConsumerPauseSeconds = pointer.Int64(60)
+	deploymentTrait.RollingUpdateMaxUnavailable = pointer.Int(2)
+	environment.Integration.Spec.Replicas = pointer.Int32(1)
+	enableExclusiveConsumersReadinessProbe(environment)
+
+	configured, err := deploymentTrait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+	err = deploymentTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	deployment := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool { return true })
+	assert.NotNil(t, deployment)
+	assert.Equal(t, intstr.FromInt(2), *deployment.Spec.Strategy.RollingUpdate.MaxUnavailable)
+	assert.Equal(t, pointer.Int64(70), deployment.Spec.Template.Spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, "60", environment.ApplicationProperties["camel.main.shutdown-timeout"])
+}
+
+func TestApplyDeploymentTraitWithExclusiveConsumersAndRecreateStrategy(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	deploymentTrait.ExclusiveConsumers = pointer.Bool(true)
+	deploymentTrait.Strategy = appsv1.RecreateDeploymentStrategyType
+	enableExclusiveConsumersReadinessProbe(environment)
+
+	configured, err := deploymentTrait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+	err = deploymentTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	deployment := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool { return true })
+	assert.NotNil(t, deployment)
+	assert.Equal(t, appsv1.RecreateDeploymentStrategyType, deployment.Spec.Strategy.Type)
+	assert.Nil(t, deployment.Spec.Strategy.RollingUpdate)
+}
+
+func TestConfigureDeploymentTraitWithInvalidExclusiveConsumersFails(t *testing.T) {
+	testCases := []struct {
+		name      string
+		configure func(*deploymentTrait)
+		err       string
+	}{
+		{
+			name: "consumer pause without exclusive consumers",
+			configure: func(trait *deploymentTrait) {
+				trait.ExclusiveConsumers = nil
+				trait.ConsumerPauseSeconds = pointer.Int64(10)
+			},
+			err: "consumer pause can only be set when exclusive consumers are enabled",
+		},
+		{
+			name: "max surge",
+			configure: func(trait *deploymentTrait) {
+				trait.Strategy = appsv1.RollingUpdateDeploymentStrategyType
+				trait.RollingUpdateMaxSurge = pointer.Int(1)
+			},
+			err: "rolling update max surge (1) must be 0 with exclusive consumers",
+		},
+		{
+			name: "max unavailable",
+			configure: func(trait *deploymentTrait) {
+				trait.RollingUpdateMaxUnavailable = pointer.Int(0)
+			},
+			err: "rolling update max unavailable (0) must be at least 1 with exclusive consumers",
+		},
+		{
+			name: "shutdown timeout",
+			configure: func(trait *deploymentTrait) {
+				trait.ShutdownTimeout = pointer.Int64(10)
+			},
+			err: "shutdown timeout (10s) must not be lower than the consumer pause (30s)",
+		},
+		{
+			name: "termination grace period",
+			configure: func(trait *deploymentTrait) {
+				trait.TerminationGracePeriodSeconds = pointer.Int64(20)
+			},
+			err: "shutdown timeout (30s) must be lower than the termination grace period (20s)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deploymentTrait, environment := createNominalDeploymentTest()
+			deploymentTrait.ExclusiveConsumers = pointer.Bool(true)
+			enableExclusiveConsumersReadinessProbe(environment)
+			tc.configure(deploymentTrait)
+
+			configured, err := deploymentTrait.Configure(environment)
+			assert.False(t, configured)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestConfigureDeploymentTraitWithExclusiveConsumersWithoutReadinessProbeFails(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	deploymentTrait.ExclusiveConsumers = pointer.Bool(true)
+
+	configured, err := deploymentTrait.Configure(environment)
+	assert.False(t, configured)
+	assert.EqualError(t, err, "exclusive consumers require the health trait readiness probe to gate the rollout")
+}
+
+func TestConfigureDeploymentTraitWithExclusiveConsumersAndReplicas(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	deploymentTrait.ExclusiveConsumers = pointer.Bool(true)
+	enableExclusiveConsumersReadinessProbe(environment)
+	environment.Integration.Spec.Replicas = pointer.Int32(2)
+
+	configured, err := deploymentTrait.Configure(environment)
+	assert.False(t, configured)
+	assert.EqualError(t, err, "exclusive consumers require a single replica (2) with the rolling update strategy, "+
+		"use the Recreate strategy to run more replicas")
+
+	deploymentTrait.Strategy = appsv1.RecreateDeploymentStrategyType
+	configured, err = deploymentTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func enableExclusiveConsumersReadinessProbe(environment *Environment) {
+	health, _ := environment.Catalog.GetTrait(healthTraitID).(*healthTrait)
+	health.Enabled = pointer.Bool(true)
+}
+
 func createNominalDeploymentTest() (*deploymentTrait, *Environment) {
 	trait, _ := newDeploymentTrait().(*deploymentTrait)
 	trait.Enabled = pointer.Bool(true)
@@ -0,0 +1,200 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+)
+
+const (
+	dnsNDotsOption    = "ndots"
+	dnsTimeoutOption  = "timeout"
+	dnsAttemptsOption = "attempts"
+
+	dnsMaxNDots       = 15
+	dnsMaxTimeout     = 30
+	dnsMaxAttempts    = 5
+	dnsMaxNameservers = 3
+)
+
+// The resolver options supported by resolv.conf, mapped to whether they accept a value.
+var dnsResolverOptions = map[string]bool{
+	dnsNDotsOption:          true,
+	dnsTimeoutOption:        true,
+	dnsAttemptsOption:       true,
+	"rotate":                false,
+	"no-check-names":        false,
+	"inet6":                 false,
+	"edns0":                 false,
+	"single-request":        false,
+	"single-request-reopen": false,
+	"no-tld-query":          false,
+	"use-vc":                false,
+	"no-reload":             false,
+	"trust-ad":              false,
+}
+
+type dnsTrait struct {
+	BaseTrait
+	traitv1.DNSTrait `property:",squash"`
+}
+
+func newDNSTrait() Trait {
+	return &dnsTrait{
+		BaseTrait: NewBaseTrait("dns", 1500),
+	}
+}
+
+func (t *dnsTrait) Configure(e *Environment) (bool, error) {
+	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, false) {
+		return false, nil
+	}
+
+	if _, err := t.resolverOptions(); err != nil {
+		return false, err
+	}
+	if err := t.validateResolverConfig(); err != nil {
+		return false, err
+	}
+
+	return e.IntegrationInRunningPhases(), nil
+}
+
+func (t *dnsTrait) Apply(e *Environment) error {
+	podSpec := e.GetIntegrationPodSpec()
+	if podSpec == nil {
+		return fmt.Errorf("could not find any integration deployment for %v", e.Integration.Name)
+	}
+
+	options, err := t.resolverOptions()
+	if err != nil {
+		return err
+	}
+
+	if t.Policy != "" {
+		podSpec.DNSPolicy = corev1.DNSPolicy(t.Policy)
+	}
+
+	if len(options) == 0 && len(t.Nameservers) == 0 && len(t.Searches) == 0 {
+		return nil
+	}
+	if podSpec.DNSConfig == nil {
+		podSpec.DNSConfig = &corev1.PodDNSConfig{}
+	}
+	podSpec.DNSConfig.Nameservers = append(podSpec.DNSConfig.Nameservers, t.Nameservers...)
+	podSpec.DNSConfig.Searches = append(podSpec.DNSConfig.Searches, t.Searches...)
+	podSpec.DNSConfig.Options = append(podSpec.DNSConfig.Options, options...)
+
+	return nil
+}
+
+func (t *dnsTrait) validateResolverConfig() error {
+	switch corev1.DNSPolicy(t.Policy) {
+	case "", corev1.DNSClusterFirst, corev1.DNSClusterFirstWithHostNet, corev1.DNSDefault:
+	case corev1.DNSNone:
+		if len(t.Nameservers) == 0 {
+			return fmt.Errorf("at least one nameserver must be provided with the %s DNS policy", corev1.DNSNone)
+		}
+	default:
+		return fmt.Errorf("unsupported DNS policy %q", t.Policy)
+	}
+
+	if len(t.Nameservers) > dnsMaxNameservers {
+		return fmt.Errorf("too many nameservers %v: at most %d can be provided", t.Nameservers, dnsMaxNameservers)
+	}
+	for _, nameserver := range t.Nameservers {
+		if net.ParseIP(nameserver) == nil {
+			return fmt.Errorf("invalid nameserver %q: it must be an IP address", nameserver)
+		}
+	}
+	for _, search := range t.Searches {
+		if strings.TrimSpace(search) == "" {
+			return errors.New("invalid empty DNS search domain")
+		}
+	}
+
+	return nil
+}
+
+func (t *dnsTrait) resolverOptions() ([]corev1.PodDNSConfigOption, error) {
+	options := make([]corev1.PodDNSConfigOption, 0, len(t.Options)+1)
+	names := make(map[string]bool)
+
+	if t.NDots != nil {
+		if *t.NDots < 0 || *t.NDots > dnsMaxNDots {
+			return nil, fmt.Errorf("invalid ndots value %d: it must be between 0 and %d", *t.NDots, dnsMaxNDots)
+		}
+		value := strconv.Itoa(*t.NDots)
+		options = append(options, corev1.PodDNSConfigOption{Name: dnsNDotsOption, Value: &value})
+		names[dnsNDotsOption] = true
+	}
+
+	for _, o := range t.Options {
+		option, err := parseDNSOption(o)
+		if err != nil {
+			return nil, err
+		}
+		if names[option.Name] {
+			return nil, fmt.Errorf("duplicate DNS resolver option %q", option.Name)
+		}
+		names[option.Name] = true
+		options = append(options, option)
+	}
+
+	return options, nil
+}
+
+func parseDNSOption(option string) (corev1.PodDNSConfigOption, error) {
+	name, value, hasValue := strings.Cut(strings.TrimSpace(option), ":")
+	acceptsValue, ok := dnsResolverOptions[name]
+	if !ok {
+		return corev1.PodDNSConfigOption{}, fmt.Errorf("unsupported DNS resolver option %q", name)
+	}
+	if !acceptsValue {
+		if hasValue {
+			return corev1.PodDNSConfigOption{}, fmt.Errorf("DNS resolver option %q does not accept any value", name)
+		}
+		return corev1.PodDNSConfigOption{Name: name}, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return corev1.PodDNSConfigOption{}, fmt.Errorf("DNS resolver option %q requires a numeric value: %q", name, value)
+	}
+	limit := dnsMaxNDots
+	switch name {
+	case dnsTimeoutOption:
+		limit = dnsMaxTimeout
+	case dnsAttemptsOption:
+		limit = dnsMaxAttempts
+	}
+	if n < 0 || n > limit {
+		return corev1.PodDNSConfigOption{}, fmt.Errorf("invalid value %d for DNS resolver option %q: it must be between 0 and %d", n, name, limit)
+	}
+
+	return corev1.PodDNSConfigOption{Name: name, Value: &value}, nil
+}
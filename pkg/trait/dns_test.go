@@ -0,0 +1,173 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+)
+
+func TestConfigureDNSTraitDoesSucceed(t *testing.T) {
+	dnsTrait := createNominalDNSTrait()
+	dnsTrait.NDots = pointer.Int(2)
+	environment, _ := createNominalDeploymentTraitTest()
+	configured, err := dnsTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureDisabledDNSTraitDoesNotSucceed(t *testing.T) {
+	dnsTrait := createNominalDNSTrait()
+	dnsTrait.Enabled = pointer.Bool(false)
+	environment, _ := createNominalDeploymentTraitTest()
+	configured, err := dnsTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureDNSTraitWithInvalidOptionsFails(t *testing.T) {
+	environment, _ := createNominalDeploymentTraitTest()
+
+	for _, options := range [][]string{
+		{"unknown"},
+		{"timeout"},
+		{"timeout:abc"},
+		{"attempts:10"},
+		{"rotate:1"},
+		{"edns0", "edns0"},
+	} {
+		dnsTrait := createNominalDNSTrait()
+		dnsTrait.Options = options
+		configured, err := dnsTrait.Configure(environment)
+
+		assert.False(t, configured)
+		assert.NotNil(t, err, "options %v", options)
+	}
+
+	dnsTrait := createNominalDNSTrait()
+	dnsTrait.NDots = pointer.Int(16)
+	configured, err := dnsTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+
+	dnsTrait.NDots = pointer.Int(1)
+	dnsTrait.Options = []string{"ndots:2"}
+	configured, err = dnsTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureDNSTraitWithInvalidResolverConfigFails(t *testing.T) {
+	environment, _ := createNominalDeploymentTraitTest()
+
+	for _, trait := range []*dnsTrait{
+		{DNSTrait: traitv1.DNSTrait{Policy: "Unknown"}},
+		{DNSTrait: traitv1.DNSTrait{Policy: "None"}},
+		{DNSTrait: traitv1.DNSTrait{Policy: "None", Searches: []string{"svc.cluster.local"}}},
+		{DNSTrait: traitv1.DNSTrait{Nameservers: []string{"dns.example.com"}}},
+		{DNSTrait: traitv1.DNSTrait{Nameservers: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"}}},
+		{DNSTrait: traitv1.DNSTrait{Searches: []string{""}}},
+	} {
+		trait.Enabled = pointer.Bool(true)
+		configured, err := trait.Configure(environment)
+
+		assert.False(t, configured)
+		assert.NotNil(t, err, "trait %v", trait.DNSTrait)
+	}
+}
+
+func TestApplyDNSTraitPolicyNoneDoesSucceed(t *testing.T) {
+	dnsTrait := createNominalDNSTrait()
+	dnsTrait.Policy = "None"
+	dnsTrait.Nameservers = []string{"10.0.0.10", "fd00::10"}
+	dnsTrait.Searches = []string{"example.com"}
+	dnsTrait.NDots = pointer.Int(1)
+
+	environment, deployment := createNominalDeploymentTraitTest()
+	configured, err := dnsTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	err = dnsTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	podSpec := deployment.Spec.Template.Spec
+	assert.Equal(t, corev1.DNSNone, podSpec.DNSPolicy)
+	assert.Equal(t, &corev1.PodDNSConfig{
+		Nameservers: []string{"10.0.0.10", "fd00::10"},
+		Searches:    []string{"example.com"},
+		Options:     []corev1.PodDNSConfigOption{{Name: "ndots", Value: pointer.String("1")}},
+	}, podSpec.DNSConfig)
+}
+
+func TestApplyDNSTraitMissingDeployment(t *testing.T) {
+	dnsTrait := createNominalDNSTrait()
+	dnsTrait.NDots = pointer.Int(2)
+
+	environment := createNominalMissingDeploymentTraitTest()
+	err := dnsTrait.Apply(environment)
+
+	assert.NotNil(t, err)
+}
+
+func TestApplyDNSTraitOptionsDoesSucceed(t *testing.T) {
+	dnsTrait := createNominalDNSTrait()
+	dnsTrait.NDots = pointer.Int(2)
+	dnsTrait.Options = []string{"timeout:2", "single-request-reopen"}
+
+	environment, deployment := createNominalDeploymentTraitTest()
+	testApplyDNSTraitOptionsDoesSucceed(t, dnsTrait, environment, &deployment.Spec.Template.Spec)
+
+	environment, knativeService := createNominalKnativeServiceTraitTest()
+	testApplyDNSTraitOptionsDoesSucceed(t, dnsTrait, environment, &knativeService.Spec.Template.Spec.PodSpec)
+
+	environment, cronJob := createNominalCronJobTraitTest()
+	testApplyDNSTraitOptionsDoesSucceed(t, dnsTrait, environment, &cronJob.Spec.JobTemplate.Spec.Template.Spec)
+}
+
+func testApplyDNSTraitOptionsDoesSucceed(t *testing.T, trait *dnsTrait, environment *Environment, podSpec *corev1.PodSpec) {
+	t.Helper()
+
+	err := trait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, podSpec.DNSConfig)
+	assert.Equal(t, []corev1.PodDNSConfigOption{
+		{Name: "ndots", Value: pointer.String("2")},
+		{Name: "timeout", Value: pointer.String("2")},
+		{Name: "single-request-reopen"},
+	}, podSpec.DNSConfig.Options)
+}
+
+func createNominalDNSTrait() *dnsTrait {
+	trait, _ := newDNSTrait().(*dnsTrait)
+	trait.Enabled = pointer.Bool(true)
+
+	return trait
+}
@@ -0,0 +1,212 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util"
+	"github.com/apache/camel-k/pkg/util/camel"
+)
+
+const (
+	drainTraitID = "drain"
+
+	drainPolicyVolumeName = "drain-policy"
+	drainPolicyFile       = "jolokia-access.xml"
+	// The Jolokia access policy, that only grants reading the Camel context state and in-flight exchanges,
+	// and suspending or resuming it.
+	drainPolicy = `<?xml version="1.0" encoding="UTF-8"?>
+<restrict>
+  <commands>
+    <command>read</command>
+    <command>exec</command>
+    <command>version</command>
+  </commands>
+  <allow>
+    <mbean>
+      <name>org.apache.camel:context=*,type=context,name=*</name>
+      <attribute>State</attribute>
+      <attribute>ExchangesInflight</attribute>
+      <operation>suspend</operation>
+      <operation>resume</operation>
+    </mbean>
+  </allow>
+</restrict>
+`
+)
+
+var drainPolicyMountPath = filepath.Join(camel.BasePath, "drain")
+
+type drainTrait struct {
+	BaseTrait
+	traitv1.DrainTrait `property:",squash"`
+}
+
+func newDrainTrait() Trait {
+	return &drainTrait{
+		// Before the jolokia trait, so that the agent is restricted to the drain operations
+		BaseTrait: NewBaseTrait(drainTraitID, 1790),
+	}
+}
+
+func (t *drainTrait) Configure(e *Environment) (bool, error) {
+	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, false) {
+		return false, nil
+	}
+
+	if !e.IntegrationInRunningPhases() {
+		return false, nil
+	}
+
+	if err := t.validateJolokia(e); err != nil {
+		return false, err
+	}
+	if err := t.validateHealth(e); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// validateJolokia checks the Jolokia agent, that serves the drain endpoint, is enabled with authentication.
+func (t *drainTrait) validateJolokia(e *Environment) error {
+	jolokia, ok := e.Catalog.GetTrait(jolokiaTraitID).(*jolokiaTrait)
+	if !ok || !pointer.BoolDeref(jolokia.Enabled, false) {
+		return errors.New("drain trait requires the jolokia trait to be enabled, as it serves the drain endpoint")
+	}
+
+	options, err := keyValuePairArrayAsStringMap(jolokia.Options)
+	if err != nil {
+		return err
+	}
+	if _, ok := options["policyLocation"]; ok {
+		return errors.New("drain trait can't be enabled together with the jolokia policyLocation option, " +
+			"as it restricts the Jolokia agent to the drain operations")
+	}
+	if jolokia.User != nil || jolokia.Password != nil || options["user"] != "" || options["password"] != "" {
+		return errors.New("drain endpoint credentials must be provided with the jolokia credentials-secret option, " +
+			"rather than with the user and password options")
+	}
+
+	// The explicit trait configuration takes precedence over the extra options, that take precedence over the defaults
+	openShift := e.DetermineProfile() == v1.TraitProfileOpenShift
+	protocol := "http"
+	if openShift {
+		protocol = "https"
+	}
+	if p, ok := options["protocol"]; ok {
+		protocol = p
+	}
+	if jolokia.Protocol != nil {
+		protocol = *jolokia.Protocol
+	}
+	clientAuthentication := openShift
+	if c, ok := options["useSslClientAuthentication"]; ok {
+		clientAuthentication = c == "true"
+	}
+	if jolokia.UseSslClientAuthentication != nil {
+		clientAuthentication = *jolokia.UseSslClientAuthentication
+	}
+
+	if jolokia.CredentialsSecret == "" && (protocol != "https" || !clientAuthentication) {
+		return errors.New("drain endpoint requires authentication: set the jolokia credentials-secret option, " +
+			"or enable the jolokia client certificates authentication over https")
+	}
+
+	return nil
+}
+
+// validateHealth checks a drained pod stops receiving traffic, without being restarted.
+func (t *drainTrait) validateHealth(e *Environment) error {
+	health, ok := e.Catalog.GetTrait(healthTraitID).(*healthTrait)
+	if !ok || !pointer.BoolDeref(health.Enabled, false) || !pointer.BoolDeref(health.ReadinessProbeEnabled, true) {
+		return errors.New("drain trait requires the health trait readiness probe to be enabled, " +
+			"so that a drained pod stops receiving traffic")
+	}
+	if len(health.ReadinessChecks) > 0 && !util.StringSliceExists(health.ReadinessChecks, "context") {
+		return errors.New("drain trait requires the context readiness health check, that fails when the pod is drained")
+	}
+	// The runtime reports the context health check to the liveness group as well, so a drained pod
+	// would either be restarted, or, when it's excluded from the liveness health checks, stay ready
+	if pointer.BoolDeref(health.LivenessProbeEnabled, false) {
+		return errors.New("drain trait can't be enabled together with the health trait liveness probe, " +
+			"as the context health check, that fails when the pod is drained, is reported to both probes")
+	}
+
+	return nil
+}
+
+func (t *drainTrait) Apply(e *Environment) error {
+	container := e.GetIntegrationContainer()
+	if container == nil {
+		return fmt.Errorf("unable to find integration container: %s", e.Integration.Name)
+	}
+	podSpec := e.GetIntegrationPodSpec()
+	if podSpec == nil {
+		return fmt.Errorf("could not find any integration deployment for %v", e.Integration.Name)
+	}
+
+	name := e.Integration.Name + "-drain-policy"
+	e.Resources.Add(&corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+		},
+		Data: map[string]string{
+			drainPolicyFile: drainPolicy,
+		},
+	})
+
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: drainPolicyVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: name,
+				},
+			},
+		},
+	})
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      drainPolicyVolumeName,
+		MountPath: drainPolicyMountPath,
+		ReadOnly:  true,
+	})
+
+	if jolokia, ok := e.Catalog.GetTrait(jolokiaTraitID).(*jolokiaTrait); ok {
+		jolokia.Options = append(jolokia.Options, "policyLocation=file://"+filepath.Join(drainPolicyMountPath, drainPolicyFile))
+	}
+
+	return nil
+}
@@ -0,0 +1,243 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+)
+
+func TestDrainTrait(t *testing.T) {
+	env := newTestDrainEnv(t, v1.Traits{})
+
+	err := env.Catalog.apply(&env)
+	assert.Nil(t, err)
+	assert.NotNil(t, env.GetTrait(drainTraitID))
+
+	configMap := env.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool {
+		return cm.Name == "my-it-drain-policy"
+	})
+	assert.NotNil(t, configMap)
+	assert.Equal(t, "my-it", configMap.Labels[v1.IntegrationLabel])
+	assert.Contains(t, configMap.Data[drainPolicyFile], "<operation>suspend</operation>")
+
+	podSpec := env.GetIntegrationPodSpec()
+	assert.Contains(t, podSpec.Volumes, corev1.Volume{
+		Name: drainPolicyVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "my-it-drain-policy"},
+			},
+		},
+	})
+
+	container := env.GetIntegrationContainer()
+	assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{
+		Name:      drainPolicyVolumeName,
+		MountPath: "/etc/camel/drain",
+		ReadOnly:  true,
+	})
+	assert.Contains(t, container.Args, "-javaagent:dependencies/lib/main/org.jolokia.jolokia-jvm-1.7.1.jar="+
+		"discoveryEnabled=false,host=*,password=$(JOLOKIA_PASSWORD),"+
+		"policyLocation=file:///etc/camel/drain/jolokia-access.xml,port=8778,user=$(JOLOKIA_USER)")
+	assert.NotNil(t, container.ReadinessProbe)
+	assert.Nil(t, container.LivenessProbe)
+}
+
+func TestDrainTraitWithClientCertificatesAuthentication(t *testing.T) {
+	env := newTestDrainEnv(t, v1.Traits{
+		Jolokia: &traitv1.JolokiaTrait{
+			Trait: traitv1.Trait{
+				Enabled: pointer.Bool(true),
+			},
+			Protocol:                   pointer.String("https"),
+			UseSslClientAuthentication: pointer.Bool(true),
+		},
+	})
+
+	err := env.Catalog.apply(&env)
+	assert.Nil(t, err)
+	assert.NotNil(t, env.GetTrait(drainTraitID))
+}
+
+func TestDrainTraitWithInvalidConfiguration(t *testing.T) {
+	testCases := []struct {
+		name   string
+		traits v1.Traits
+		err    string
+	}{
+		{
+			name: "jolokia disabled",
+			traits: v1.Traits{
+				Jolokia: &traitv1.JolokiaTrait{},
+			},
+			err: "drain trait requires the jolokia trait to be enabled",
+		},
+		{
+			name: "no authentication",
+			traits: v1.Traits{
+				Jolokia: &traitv1.JolokiaTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+				},
+			},
+			err: "drain endpoint requires authentication",
+		},
+		{
+			name: "client certificates without https",
+			traits: v1.Traits{
+				Jolokia: &traitv1.JolokiaTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					UseSslClientAuthentication: pointer.Bool(true),
+				},
+			},
+			err: "drain endpoint requires authentication",
+		},
+		{
+			name: "inline credentials",
+			traits: v1.Traits{
+				Jolokia: &traitv1.JolokiaTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					Options: []string{"user=admin", "password=admin"},
+				},
+			},
+			err: "drain endpoint credentials must be provided with the jolokia credentials-secret option",
+		},
+		{
+			name: "policy location",
+			traits: v1.Traits{
+				Jolokia: &traitv1.JolokiaTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					CredentialsSecret: "jolokia-credentials",
+					Options:           []string{"policyLocation=classpath:/jolokia-access.xml"},
+				},
+			},
+			err: "drain trait can't be enabled together with the jolokia policyLocation option",
+		},
+		{
+			name: "health disabled",
+			traits: v1.Traits{
+				Health: &traitv1.HealthTrait{},
+			},
+			err: "drain trait requires the health trait readiness probe to be enabled",
+		},
+		{
+			name: "no context readiness check",
+			traits: v1.Traits{
+				Health: &traitv1.HealthTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					ReadinessChecks: []string{"consumers"},
+				},
+			},
+			err: "drain trait requires the context readiness health check",
+		},
+		{
+			name: "liveness probe",
+			traits: v1.Traits{
+				Health: &traitv1.HealthTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					LivenessProbeEnabled: pointer.Bool(true),
+				},
+			},
+			err: "drain trait can't be enabled together with the health trait liveness probe",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			env := newTestDrainEnv(t, tc.traits)
+
+			err := env.Catalog.apply(&env)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+// newTestDrainEnv returns an environment with the drain trait enabled, as well as the jolokia and health traits
+// it depends on, unless they're overridden by the given traits.
+func newTestDrainEnv(t *testing.T, traits v1.Traits) Environment {
+	t.Helper()
+
+	traits.Drain = &traitv1.DrainTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+	}
+	if traits.Jolokia == nil {
+		traits.Jolokia = &traitv1.JolokiaTrait{
+			Trait: traitv1.Trait{
+				Enabled: pointer.Bool(true),
+			},
+			CredentialsSecret: "jolokia-credentials",
+		}
+	}
+	if traits.Health == nil {
+		traits.Health = &traitv1.HealthTrait{
+			Trait: traitv1.Trait{
+				Enabled: pointer.Bool(true),
+			},
+		}
+	}
+
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-it",
+			Namespace: "ns",
+		},
+		Spec: v1.IntegrationSpec{
+			Traits: traits,
+		},
+		Status: v1.IntegrationStatus{
+			Phase: v1.IntegrationPhaseDeploying,
+		},
+	}
+
+	env := newTestProbesEnv(t, integration)
+	env.IntegrationKit = &v1.IntegrationKit{
+		Status: v1.IntegrationKitStatus{
+			Artifacts: []v1.Artifact{
+				{
+					ID:     "org.jolokia.jolokia-jvm-1.7.1.jar",
+					Target: "dependencies/lib/main/org.jolokia.jolokia-jvm-1.7.1.jar",
+				},
+			},
+		},
+	}
+
+	return env
+}
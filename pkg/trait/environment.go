@@ -18,11 +18,18 @@ limitations under the License.
 package trait
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"regexp"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/pointer"
 
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util"
 	"github.com/apache/camel-k/pkg/util/camel"
 	"github.com/apache/camel-k/pkg/util/defaults"
 	"github.com/apache/camel-k/pkg/util/envvar"
@@ -52,6 +59,23 @@ const (
 	envVarMountPathSecrets = "CAMEL_K_MOUNT_PATH_SECRETS"
 )
 
+// The container resources that can be referenced by the environment variables.
+var envVarResourceFields = []string{
+	"limits.cpu", "limits.memory", "limits.ephemeral-storage",
+	"requests.cpu", "requests.memory", "requests.ephemeral-storage",
+}
+
+// The pod fields that can be referenced by the environment variables, besides the labels and annotations,
+// with `metadata.labels['key']` and `metadata.annotations['key']`.
+var envVarFieldPaths = []string{
+	"metadata.name", "metadata.namespace", "metadata.uid",
+	"spec.nodeName", "spec.serviceAccountName",
+	"status.hostIP", "status.hostIPs", "status.podIP", "status.podIPs",
+}
+
+// envVarSubscriptedFieldPath matches the labels and annotations field paths.
+var envVarSubscriptedFieldPath = regexp.MustCompile(`^metadata\.(labels|annotations)\['(.+)'\]$`)
+
 func newEnvironmentTrait() Trait {
 	return &environmentTrait{
 		BaseTrait: NewBaseTrait("environment", 800),
@@ -66,6 +90,12 @@ func (t *environmentTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
+	for _, v := range t.Vars {
+		if _, err := parseEnvVar(v); err != nil {
+			return false, err
+		}
+	}
+
 	return e.IntegrationInRunningPhases(), nil
 }
 
@@ -98,14 +128,114 @@ func (t *environmentTrait) Apply(e *Environment) error {
 
 	if t.Vars != nil {
 		for _, env := range t.Vars {
-			k, v := property.SplitPropertyFileEntry(env)
-			envvar.SetVal(&e.EnvVars, k, v)
+			v, err := parseEnvVar(env)
+			if err != nil {
+				return err
+			}
+			envvar.SetVar(&e.EnvVars, v)
 		}
 	}
 
 	return nil
 }
 
+// parseEnvVar parses an environment variable in the form KEY=VALUE, whose value can reference a ConfigMap or Secret key,
+// e.g., KEY=secret:name/key, a field of the pod, e.g., KEY=fieldRef:status.podIP, or a resource of the container,
+// e.g., KEY=resourceFieldRef:limits.memory.
+func parseEnvVar(entry string) (corev1.EnvVar, error) {
+	k, v := property.SplitPropertyFileEntry(entry)
+	if errs := validation.IsEnvVarName(k); len(errs) > 0 {
+		return corev1.EnvVar{}, fmt.Errorf("invalid environment variable %q: %s", entry, strings.Join(errs, ", "))
+	}
+
+	switch {
+	case strings.HasPrefix(v, "configmap:"):
+		name, key, err := parseEnvVarKeyRef(entry, strings.TrimPrefix(v, "configmap:"))
+		if err != nil {
+			return corev1.EnvVar{}, err
+		}
+		return corev1.EnvVar{
+			Name: k,
+			ValueFrom: &corev1.EnvVarSource{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: name},
+					Key:                  key,
+				},
+			},
+		}, nil
+	case strings.HasPrefix(v, "secret:"):
+		name, key, err := parseEnvVarKeyRef(entry, strings.TrimPrefix(v, "secret:"))
+		if err != nil {
+			return corev1.EnvVar{}, err
+		}
+		return corev1.EnvVar{
+			Name: k,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: name},
+					Key:                  key,
+				},
+			},
+		}, nil
+	case strings.HasPrefix(v, "fieldRef:"):
+		path := strings.TrimPrefix(v, "fieldRef:")
+		if err := validateEnvVarFieldPath(path); err != nil {
+			return corev1.EnvVar{}, fmt.Errorf("invalid environment variable %q: %w", entry, err)
+		}
+		return corev1.EnvVar{
+			Name: k,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: path},
+			},
+		}, nil
+	case strings.HasPrefix(v, "resourceFieldRef:"):
+		resource := strings.TrimPrefix(v, "resourceFieldRef:")
+		if !util.StringSliceExists(envVarResourceFields, resource) {
+			return corev1.EnvVar{}, fmt.Errorf("invalid environment variable %q: the resource must be one of %s",
+				entry, strings.Join(envVarResourceFields, ", "))
+		}
+		return corev1.EnvVar{
+			Name: k,
+			ValueFrom: &corev1.EnvVarSource{
+				ResourceFieldRef: &corev1.ResourceFieldSelector{Resource: resource},
+			},
+		}, nil
+	default:
+		return corev1.EnvVar{Name: k, Value: v}, nil
+	}
+}
+
+func validateEnvVarFieldPath(path string) error {
+	if path == "" {
+		return errors.New("the field path is missing")
+	}
+	if util.StringSliceExists(envVarFieldPaths, path) {
+		return nil
+	}
+	if m := envVarSubscriptedFieldPath.FindStringSubmatch(path); m != nil {
+		if errs := validation.IsQualifiedName(m[2]); len(errs) > 0 {
+			return fmt.Errorf("invalid %s key %s: %s", strings.TrimSuffix(m[1], "s"), m[2], strings.Join(errs, ", "))
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported field path %s: it must be one of %s, or metadata.labels['<key>'] or metadata.annotations['<key>']",
+		path, strings.Join(envVarFieldPaths, ", "))
+}
+
+func parseEnvVarKeyRef(entry string, ref string) (string, string, error) {
+	parts := strings.SplitN(ref, "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid environment variable %q: expected name/key reference", entry)
+	}
+	if errs := validation.IsDNS1123Subdomain(parts[0]); len(errs) > 0 {
+		return "", "", fmt.Errorf("invalid environment variable %q: invalid name %s: %s", entry, parts[0], strings.Join(errs, ", "))
+	}
+	if errs := validation.IsConfigMapKey(parts[1]); len(errs) > 0 {
+		return "", "", fmt.Errorf("invalid environment variable %q: invalid key %s: %s", entry, parts[1], strings.Join(errs, ", "))
+	}
+	return parts[0], parts[1], nil
+}
+
 // IsPlatformTrait overrides base class method.
 func (t *environmentTrait) IsPlatformTrait() bool {
 	return true
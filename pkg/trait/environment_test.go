@@ -190,6 +190,88 @@ func TestCustomEnvVars(t *testing.T) {
 	assert.True(t, userK2)
 }
 
+func TestEnvVarsFromSources(t *testing.T) {
+	c, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	env := mockEnvironment(c)
+	env.Integration.Spec.Traits = v1.Traits{
+		Environment: &traitv1.EnvironmentTrait{
+			Vars: []string{
+				"CM_VAR=configmap:my-cm/my-key",
+				"SECRET_VAR=secret:my-secret/password",
+				"POD_IP=fieldRef:status.podIP",
+				"MEMORY_LIMIT=resourceFieldRef:limits.memory",
+				"APP=fieldRef:metadata.labels['app.kubernetes.io/name']",
+			},
+		},
+	}
+	env.Platform.ResyncStatusFullConfig()
+
+	err = NewEnvironmentTestCatalog().apply(&env)
+
+	assert.Nil(t, err)
+
+	container := env.GetIntegrationContainer()
+	assert.NotNil(t, container)
+	assert.Contains(t, container.Env, corev1.EnvVar{
+		Name: "CM_VAR",
+		ValueFrom: &corev1.EnvVarSource{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "my-cm"},
+				Key:                  "my-key",
+			},
+		},
+	})
+	assert.Contains(t, container.Env, corev1.EnvVar{
+		Name: "SECRET_VAR",
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"},
+				Key:                  "password",
+			},
+		},
+	})
+	assert.Contains(t, container.Env, corev1.EnvVar{
+		Name: "POD_IP",
+		ValueFrom: &corev1.EnvVarSource{
+			FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.podIP"},
+		},
+	})
+	assert.Contains(t, container.Env, corev1.EnvVar{
+		Name: "APP",
+		ValueFrom: &corev1.EnvVarSource{
+			FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.labels['app.kubernetes.io/name']"},
+		},
+	})
+	assert.Contains(t, container.Env, corev1.EnvVar{
+		Name: "MEMORY_LIMIT",
+		ValueFrom: &corev1.EnvVarSource{
+			ResourceFieldRef: &corev1.ResourceFieldSelector{Resource: "limits.memory"},
+		},
+	})
+}
+
+func TestInvalidEnvVars(t *testing.T) {
+	for _, v := range []string{
+		"=value",
+		"CM_VAR=configmap:my-cm",
+		"SECRET_VAR=secret:My_Secret/password",
+		"POD_IP=fieldRef:",
+		"POD_IP=fieldRef:status.ip",
+		"LABEL=fieldRef:metadata.labels['my label']",
+		"CPU=resourceFieldRef:limits.gpu",
+	} {
+		trait, _ := newEnvironmentTrait().(*environmentTrait)
+		trait.Vars = []string{v}
+
+		configured, err := trait.Configure(&Environment{Integration: &v1.Integration{}})
+
+		assert.False(t, configured)
+		assert.NotNil(t, err, v)
+	}
+}
+
 func NewEnvironmentTestCatalog() *Catalog {
 	return NewCatalog(nil)
 }
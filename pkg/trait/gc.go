@@ -70,13 +70,17 @@ func (t *gcTrait) Configure(e *Environment) (bool, error) {
 }
 
 func (t *gcTrait) Apply(e *Environment) error {
-	if e.IntegrationInRunningPhases() && e.Integration.GetGeneration() > 1 {
+	if e.IntegrationInRunningPhases() && e.Integration.GetGeneration() > 1 && !isReconciliationPaused(e) {
 		// Register a post action that deletes the existing resources that are labelled
 		// with the previous integration generation(s).
 		// We make the assumption generation is a monotonically increasing strictly positive integer,
 		// in which case we can skip garbage collection on the first generation.
 		// TODO: this should be refined so that it's run when all the replicas for the newer generation are ready.
 		e.PostActions = append(e.PostActions, func(env *Environment) error {
+			// Keep the previous resources running when the new ones have not been deployed
+			if isImageSignatureUnverified(env) {
+				return nil
+			}
 			return t.garbageCollectResources(env)
 		})
 	}
@@ -77,6 +77,20 @@ func TestApplyGCTraitDuringInitializationPhaseSkipPostActions(t *testing.T) {
 	assert.Len(t, environment.PostActions, 0)
 }
 
+func TestApplyGCTraitWithPausedReconciliationSkipPostActions(t *testing.T) {
+	gcTrait, environment := createNominalGCTest()
+	environment.Integration.Generation = 2
+	deployerTrait, _ := newDeployerTrait().(*deployerTrait)
+	deployerTrait.paused = true
+	environment.ExecutedTraits = append(environment.ExecutedTraits, deployerTrait)
+
+	err := gcTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Len(t, environment.PostProcessors, 1)
+	assert.Len(t, environment.PostActions, 0)
+}
+
 func createNominalGCTest() (*gcTrait, *Environment) {
 	trait, _ := newGCTrait().(*gcTrait)
 	trait.Enabled = pointer.Bool(true)
@@ -18,7 +18,11 @@ limitations under the License.
 package trait
 
 import (
+	"errors"
+	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -27,13 +31,32 @@ import (
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/pkg/util"
+	"github.com/apache/camel-k/pkg/util/envvar"
 )
 
 const (
+	healthTraitID = "health"
+
 	defaultLivenessProbePath  = "/q/health/live"
 	defaultReadinessProbePath = "/q/health/ready"
+	defaultStartupProbePath   = "/q/health/started"
 )
 
+// The health checks that can gate the integration readiness, mapped to the environment variable
+// that configures the corresponding runtime property, and whether the runtime reports them to the
+// liveness group as well. It's a slice, so that the container environment is consistent over iterations.
+var healthChecks = []struct {
+	name     string
+	envVar   string
+	liveness bool
+}{
+	{name: "context", envVar: "CAMEL_HEALTH_CONTEXT_ENABLED", liveness: true},
+	{name: "routes", envVar: "CAMEL_HEALTH_ROUTES_ENABLED"},
+	{name: "consumers", envVar: "CAMEL_HEALTH_CONSUMERS_ENABLED"},
+	{name: "registry", envVar: "CAMEL_HEALTH_REGISTRY_ENABLED", liveness: true},
+	{name: "datasource", envVar: "QUARKUS_DATASOURCE_HEALTH_ENABLED"},
+}
+
 type healthTrait struct {
 	BaseTrait
 	traitv1.HealthTrait `property:",squash"`
@@ -41,10 +64,11 @@ type healthTrait struct {
 
 func newHealthTrait() Trait {
 	return &healthTrait{
-		BaseTrait: NewBaseTrait("health", 1700),
+		BaseTrait: NewBaseTrait(healthTraitID, 1700),
 		HealthTrait: traitv1.HealthTrait{
 			LivenessScheme:  string(corev1.URISchemeHTTP),
 			ReadinessScheme: string(corev1.URISchemeHTTP),
+			StartupScheme:   string(corev1.URISchemeHTTP),
 		},
 	}
 }
@@ -59,9 +83,62 @@ func (t *healthTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
+	if err := t.validateReadinessChecks(); err != nil {
+		return false, err
+	}
+	if err := t.validateLivenessChecks(); err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
+func (t *healthTrait) validateReadinessChecks() error {
+	names := make([]string, 0, len(healthChecks))
+	for _, check := range healthChecks {
+		names = append(names, check.name)
+	}
+	for _, check := range t.ReadinessChecks {
+		if !util.StringSliceExists(names, check) {
+			return fmt.Errorf("unsupported readiness health check %q: it must be one of %s", check, strings.Join(names, ", "))
+		}
+	}
+
+	return nil
+}
+
+// validateLivenessChecks checks the liveness health checks are reported to the liveness group by the runtime,
+// and that the ones that are disabled, as they're not listed, are not readiness checks.
+func (t *healthTrait) validateLivenessChecks() error {
+	if len(t.LivenessChecks) == 0 {
+		return nil
+	}
+	if !pointer.BoolDeref(t.LivenessProbeEnabled, false) {
+		return errors.New("liveness-checks requires the liveness probe to be enabled")
+	}
+
+	names := make([]string, 0, len(healthChecks))
+	for _, check := range healthChecks {
+		if check.liveness {
+			names = append(names, check.name)
+		}
+	}
+	for _, check := range t.LivenessChecks {
+		if !util.StringSliceExists(names, check) {
+			return fmt.Errorf("unsupported liveness health check %q: it must be one of %s, as the other health checks "+
+				"only gate the readiness", check, strings.Join(names, ", "))
+		}
+	}
+	for _, check := range names {
+		if !util.StringSliceExists(t.LivenessChecks, check) && util.StringSliceExists(t.ReadinessChecks, check) {
+			return fmt.Errorf("the %q health check can't be excluded from the liveness health checks, as it's a readiness "+
+				"health check, and the runtime reports it to both", check)
+		}
+	}
+
+	return nil
+}
+
 func (t *healthTrait) Apply(e *Environment) error {
 	if e.IntegrationInPhase(v1.IntegrationPhaseInitialization) {
 		if capability, ok := e.CamelCatalog.Runtime.Capabilities[v1.CapabilityHealth]; ok {
@@ -74,10 +151,15 @@ func (t *healthTrait) Apply(e *Environment) error {
 		return nil
 	}
 
-	if !pointer.BoolDeref(t.LivenessProbeEnabled, false) && !pointer.BoolDeref(t.ReadinessProbeEnabled, true) {
+	if !pointer.BoolDeref(t.LivenessProbeEnabled, false) && !pointer.BoolDeref(t.ReadinessProbeEnabled, true) &&
+		!pointer.BoolDeref(t.StartupProbeEnabled, false) {
 		return nil
 	}
 
+	if pointer.BoolDeref(t.StartupProbeEnabled, false) && e.GetTrait(knativeServiceTraitID) != nil {
+		return errors.New("the startup probe isn't supported by Knative Serving")
+	}
+
 	container := e.GetIntegrationContainer()
 	var port *intstr.IntOrString
 	// Use the default named HTTP container port if it exists.
@@ -97,6 +179,24 @@ func (t *healthTrait) Apply(e *Environment) error {
 	if pointer.BoolDeref(t.ReadinessProbeEnabled, true) {
 		container.ReadinessProbe = t.newReadinessProbe(port, defaultReadinessProbePath)
 	}
+	if pointer.BoolDeref(t.StartupProbeEnabled, false) {
+		container.StartupProbe = t.newStartupProbe(port, defaultStartupProbePath)
+	}
+	// The liveness and readiness endpoints aggregate the results of the enabled health checks,
+	// so only the required ones are kept enabled
+	readinessChecks := pointer.BoolDeref(t.ReadinessProbeEnabled, true) && len(t.ReadinessChecks) > 0
+	if readinessChecks || len(t.LivenessChecks) > 0 {
+		for _, check := range healthChecks {
+			enabled := true
+			if readinessChecks {
+				enabled = util.StringSliceExists(t.ReadinessChecks, check.name)
+			}
+			if check.liveness && len(t.LivenessChecks) > 0 {
+				enabled = util.StringSliceExists(t.LivenessChecks, check.name)
+			}
+			envvar.SetVal(&container.Env, check.envVar, strconv.FormatBool(enabled))
+		}
+	}
 
 	return nil
 }
@@ -144,3 +244,24 @@ func (t *healthTrait) newReadinessProbe(port *intstr.IntOrString, path string) *
 
 	return &p
 }
+
+func (t *healthTrait) newStartupProbe(port *intstr.IntOrString, path string) *corev1.Probe {
+	p := corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path:   path,
+				Scheme: corev1.URIScheme(t.StartupScheme),
+			},
+		},
+		InitialDelaySeconds: t.StartupInitialDelay,
+		TimeoutSeconds:      t.StartupTimeout,
+		PeriodSeconds:       t.StartupPeriod,
+		FailureThreshold:    t.StartupFailureThreshold,
+	}
+
+	if port != nil {
+		p.ProbeHandler.HTTPGet.Port = *port
+	}
+
+	return &p
+}
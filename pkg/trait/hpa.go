@@ -0,0 +1,255 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+)
+
+const (
+	hpaTraitID = "hpa"
+
+	hpaDefaultCPUUtilization = 80
+	knativeServingHPAClass   = "hpa.autoscaling.knative.dev"
+)
+
+type hpaTrait struct {
+	BaseTrait
+	traitv1.HPATrait `property:",squash"`
+	strategy         ControllerStrategy
+}
+
+func newHPATrait() Trait {
+	return &hpaTrait{
+		// After the deployment trait, and before the knative-service trait, that's configured by this trait
+		BaseTrait: NewBaseTrait(hpaTraitID, 1300),
+	}
+}
+
+func (t *hpaTrait) Configure(e *Environment) (bool, error) {
+	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, false) {
+		return false, nil
+	}
+
+	if !e.IntegrationInRunningPhases() {
+		return false, nil
+	}
+
+	strategy, err := e.DetermineControllerStrategy()
+	if err != nil {
+		return false, err
+	}
+	t.strategy = strategy
+
+	if t.CPU == nil && t.Memory == nil && len(t.Metrics) == 0 {
+		t.CPU = pointer.Int32(hpaDefaultCPUUtilization)
+	}
+	if err := t.validate(e); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (t *hpaTrait) validate(e *Environment) error {
+	if t.MaxReplicas == nil {
+		return errors.New("the hpa trait requires the max-replicas option")
+	}
+	minReplicas := pointer.Int32Deref(t.MinReplicas, 1)
+	if minReplicas < 1 {
+		return fmt.Errorf("invalid min-replicas %d: it must be positive", minReplicas)
+	}
+	if *t.MaxReplicas < minReplicas {
+		return fmt.Errorf("max-replicas (%d) must be greater than or equal to min-replicas (%d)", *t.MaxReplicas, minReplicas)
+	}
+	if e.Integration.Spec.Replicas != nil {
+		return errors.New("the integration replicas can't be set together with the hpa trait, as they're managed by the autoscaler")
+	}
+
+	if t.CPU != nil && *t.CPU <= 0 {
+		return fmt.Errorf("invalid cpu target %d: it must be a positive percentage", *t.CPU)
+	}
+	if t.Memory != nil && *t.Memory <= 0 {
+		return fmt.Errorf("invalid memory target %d: it must be a positive percentage", *t.Memory)
+	}
+	if _, err := t.parseMetrics(); err != nil {
+		return err
+	}
+
+	switch t.strategy {
+	case ControllerStrategyDeployment, ControllerStrategyStatefulSet:
+		return nil
+	case ControllerStrategyKnativeService:
+		return t.validateKnativeService(e)
+	default:
+		return fmt.Errorf("the hpa trait isn't supported with the %s controller strategy", t.strategy)
+	}
+}
+
+// validateKnativeService checks the autoscaling of the Knative Service isn't also configured by the knative-service
+// trait, and that the targets are supported by the Knative Horizontal Pod Autoscaler class.
+func (t *hpaTrait) validateKnativeService(e *Environment) error {
+	if t.Memory != nil || len(t.Metrics) > 0 {
+		return errors.New("the hpa trait only supports the cpu target with the knative-service controller strategy")
+	}
+	if ks, ok := e.Catalog.GetTrait(knativeServiceTraitID).(*knativeServiceTrait); ok {
+		if ks.Class != "" || ks.Metric != "" || ks.Target != nil || ks.MinScale != nil || ks.MaxScale != nil {
+			return errors.New("the hpa trait can't be enabled together with the knative-service trait autoscaling options")
+		}
+	}
+	return nil
+}
+
+// parseMetrics returns the custom pods metrics, from their `name=quantity` representation.
+func (t *hpaTrait) parseMetrics() ([]autoscalingv2.MetricSpec, error) {
+	metrics := make([]autoscalingv2.MetricSpec, 0, len(t.Metrics))
+	for _, m := range t.Metrics {
+		name, value, ok := strings.Cut(m, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid metric %q: it must be in the name=quantity form", m)
+		}
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid metric %q: %w", m, err)
+		}
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{
+					Name: name,
+				},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: &quantity,
+				},
+			},
+		})
+	}
+	return metrics, nil
+}
+
+func (t *hpaTrait) Apply(e *Environment) error {
+	if t.strategy == ControllerStrategyKnativeService {
+		// The Knative Service isn't scalable, so the autoscaling is delegated to Knative
+		if ks, ok := e.Catalog.GetTrait(knativeServiceTraitID).(*knativeServiceTrait); ok {
+			ks.Class = knativeServingHPAClass
+			ks.Metric = "cpu"
+			ks.Target = pointer.Int(int(*t.CPU))
+			ks.MinScale = pointer.Int(int(pointer.Int32Deref(t.MinReplicas, 1)))
+			ks.MaxScale = pointer.Int(int(*t.MaxReplicas))
+		}
+		return nil
+	}
+
+	var target autoscalingv2.CrossVersionObjectReference
+	if t.strategy == ControllerStrategyStatefulSet {
+		statefulSet := e.Resources.GetStatefulSet(func(s *appsv1.StatefulSet) bool {
+			return s.Name == e.Integration.Name
+		})
+		if statefulSet == nil {
+			return fmt.Errorf("unable to find integration stateful set: %s", e.Integration.Name)
+		}
+		// Leave the replicas to the autoscaler, so that they're not reset when the StatefulSet is patched
+		statefulSet.Spec.Replicas = nil
+		target = scaleTargetRef("StatefulSet", statefulSet.Name)
+	} else {
+		deployment := e.Resources.GetDeploymentForIntegration(e.Integration)
+		if deployment == nil {
+			return fmt.Errorf("unable to find integration deployment: %s", e.Integration.Name)
+		}
+		// Leave the replicas to the autoscaler, so that they're not reset when the Deployment is patched
+		deployment.Spec.Replicas = nil
+		target = scaleTargetRef("Deployment", deployment.Name)
+	}
+
+	hpa, err := t.horizontalPodAutoscalerFor(e, target)
+	if err != nil {
+		return err
+	}
+	e.Resources.Add(hpa)
+
+	return nil
+}
+
+func scaleTargetRef(kind string, name string) autoscalingv2.CrossVersionObjectReference {
+	return autoscalingv2.CrossVersionObjectReference{
+		APIVersion: appsv1.SchemeGroupVersion.String(),
+		Kind:       kind,
+		Name:       name,
+	}
+}
+
+func (t *hpaTrait) horizontalPodAutoscalerFor(e *Environment, target autoscalingv2.CrossVersionObjectReference) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	var metrics []autoscalingv2.MetricSpec
+	if t.CPU != nil {
+		metrics = append(metrics, resourceUtilizationMetric(corev1.ResourceCPU, *t.CPU))
+	}
+	if t.Memory != nil {
+		metrics = append(metrics, resourceUtilizationMetric(corev1.ResourceMemory, *t.Memory))
+	}
+	custom, err := t.parseMetrics()
+	if err != nil {
+		return nil, err
+	}
+	metrics = append(metrics, custom...)
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: autoscalingv2.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: target,
+			MinReplicas:    pointer.Int32(pointer.Int32Deref(t.MinReplicas, 1)),
+			MaxReplicas:    *t.MaxReplicas,
+			Metrics:        metrics,
+		},
+	}, nil
+}
+
+func resourceUtilizationMetric(name corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: pointer.Int32(utilization),
+			},
+		},
+	}
+}
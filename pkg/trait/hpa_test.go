@@ -0,0 +1,241 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	serving "knative.dev/serving/pkg/apis/serving/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+)
+
+func TestHPATrait(t *testing.T) {
+	env := newTestHPAEnv(t, &traitv1.HPATrait{
+		MaxReplicas: pointer.Int32(5),
+	})
+
+	err := env.Catalog.apply(&env)
+	assert.Nil(t, err)
+	assert.NotNil(t, env.GetTrait(hpaTraitID))
+
+	deployment := env.Resources.GetDeploymentForIntegration(env.Integration)
+	assert.NotNil(t, deployment)
+	assert.Nil(t, deployment.Spec.Replicas)
+
+	hpa := getHorizontalPodAutoscaler(env)
+	assert.NotNil(t, hpa)
+	assert.Equal(t, "my-it", hpa.Labels[v1.IntegrationLabel])
+	assert.Equal(t, autoscalingv2.CrossVersionObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "my-it",
+	}, hpa.Spec.ScaleTargetRef)
+	assert.Equal(t, pointer.Int32(1), hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+	assert.Equal(t, []autoscalingv2.MetricSpec{
+		resourceUtilizationMetric(corev1.ResourceCPU, 80),
+	}, hpa.Spec.Metrics)
+}
+
+func TestHPATraitWithMetrics(t *testing.T) {
+	env := newTestHPAEnv(t, &traitv1.HPATrait{
+		MinReplicas: pointer.Int32(2),
+		MaxReplicas: pointer.Int32(10),
+		Memory:      pointer.Int32(70),
+		Metrics:     []string{"http_requests_per_second=100"},
+	})
+
+	err := env.Catalog.apply(&env)
+	assert.Nil(t, err)
+
+	hpa := getHorizontalPodAutoscaler(env)
+	assert.NotNil(t, hpa)
+	assert.Equal(t, pointer.Int32(2), hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(10), hpa.Spec.MaxReplicas)
+	quantity := resource.MustParse("100")
+	assert.Equal(t, []autoscalingv2.MetricSpec{
+		resourceUtilizationMetric(corev1.ResourceMemory, 70),
+		{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{
+					Name: "http_requests_per_second",
+				},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: &quantity,
+				},
+			},
+		},
+	}, hpa.Spec.Metrics)
+}
+
+func TestHPATraitWithKnativeService(t *testing.T) {
+	env := newTestHPAEnv(t, &traitv1.HPATrait{
+		MinReplicas: pointer.Int32(2),
+		MaxReplicas: pointer.Int32(4),
+		CPU:         pointer.Int32(60),
+	})
+	env.Integration.Spec.Profile = v1.TraitProfileKnative
+	env.Integration.Spec.Traits.KnativeService = &traitv1.KnativeServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+	}
+
+	err := env.Catalog.apply(&env)
+	assert.Nil(t, err)
+	assert.Nil(t, getHorizontalPodAutoscaler(env))
+
+	ksvc := env.Resources.GetKnativeService(func(s *serving.Service) bool {
+		return s.Name == "my-it"
+	})
+	assert.NotNil(t, ksvc)
+	annotations := ksvc.Spec.Template.Annotations
+	assert.Equal(t, "hpa.autoscaling.knative.dev", annotations[knativeServingClassAnnotation])
+	assert.Equal(t, "cpu", annotations[knativeServingMetricAnnotation])
+	assert.Equal(t, "60", annotations[knativeServingTargetAnnotation])
+	assert.Equal(t, "2", annotations[knativeServingMinScaleAnnotation])
+	assert.Equal(t, "4", annotations[knativeServingMaxScaleAnnotation])
+}
+
+func TestHPATraitWithInvalidConfiguration(t *testing.T) {
+	testCases := []struct {
+		name     string
+		trait    traitv1.HPATrait
+		replicas *int32
+		knative  *traitv1.KnativeServiceTrait
+		err      string
+	}{
+		{
+			name:  "no max replicas",
+			trait: traitv1.HPATrait{},
+			err:   "the hpa trait requires the max-replicas option",
+		},
+		{
+			name:  "non positive min replicas",
+			trait: traitv1.HPATrait{MinReplicas: pointer.Int32(0), MaxReplicas: pointer.Int32(2)},
+			err:   "invalid min-replicas 0: it must be positive",
+		},
+		{
+			name:  "max replicas lower than min replicas",
+			trait: traitv1.HPATrait{MinReplicas: pointer.Int32(3), MaxReplicas: pointer.Int32(2)},
+			err:   "max-replicas (2) must be greater than or equal to min-replicas (3)",
+		},
+		{
+			name:     "integration replicas",
+			trait:    traitv1.HPATrait{MaxReplicas: pointer.Int32(2)},
+			replicas: pointer.Int32(2),
+			err:      "the integration replicas can't be set together with the hpa trait",
+		},
+		{
+			name:  "non positive cpu target",
+			trait: traitv1.HPATrait{MaxReplicas: pointer.Int32(2), CPU: pointer.Int32(0)},
+			err:   "invalid cpu target 0: it must be a positive percentage",
+		},
+		{
+			name:  "invalid metric",
+			trait: traitv1.HPATrait{MaxReplicas: pointer.Int32(2), Metrics: []string{"http_requests_per_second"}},
+			err:   `invalid metric "http_requests_per_second": it must be in the name=quantity form`,
+		},
+		{
+			name:  "invalid metric quantity",
+			trait: traitv1.HPATrait{MaxReplicas: pointer.Int32(2), Metrics: []string{"http_requests_per_second=many"}},
+			err:   `invalid metric "http_requests_per_second=many"`,
+		},
+		{
+			name:  "memory target with knative service",
+			trait: traitv1.HPATrait{MaxReplicas: pointer.Int32(2), Memory: pointer.Int32(70)},
+			knative: &traitv1.KnativeServiceTrait{
+				Trait: traitv1.Trait{
+					Enabled: pointer.Bool(true),
+				},
+			},
+			err: "the hpa trait only supports the cpu target with the knative-service controller strategy",
+		},
+		{
+			name:  "knative service autoscaling",
+			trait: traitv1.HPATrait{MaxReplicas: pointer.Int32(2)},
+			knative: &traitv1.KnativeServiceTrait{
+				Trait: traitv1.Trait{
+					Enabled: pointer.Bool(true),
+				},
+				MaxScale: pointer.Int(3),
+			},
+			err: "the hpa trait can't be enabled together with the knative-service trait autoscaling options",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trait := tc.trait
+			env := newTestHPAEnv(t, &trait)
+			env.Integration.Spec.Replicas = tc.replicas
+			if tc.knative != nil {
+				env.Integration.Spec.Profile = v1.TraitProfileKnative
+				env.Integration.Spec.Traits.KnativeService = tc.knative
+			}
+
+			err := env.Catalog.apply(&env)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func getHorizontalPodAutoscaler(e Environment) *autoscalingv2.HorizontalPodAutoscaler {
+	for _, r := range e.Resources.Items() {
+		if hpa, ok := r.(*autoscalingv2.HorizontalPodAutoscaler); ok {
+			return hpa
+		}
+	}
+	return nil
+}
+
+// newTestHPAEnv returns an environment with the given hpa trait enabled.
+func newTestHPAEnv(t *testing.T, trait *traitv1.HPATrait) Environment {
+	t.Helper()
+
+	trait.Enabled = pointer.Bool(true)
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-it",
+			Namespace: "ns",
+		},
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				HPA: trait,
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Phase: v1.IntegrationPhaseDeploying,
+		},
+	}
+
+	return newTestProbesEnv(t, integration)
+}
@@ -0,0 +1,169 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"k8s.io/utils/pointer"
+
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+)
+
+const httpAccessLogPattern = `%h %l %u %t "%r" %s %b`
+
+var (
+	httpMemorySizeRegexp = regexp.MustCompile(`^([0-9]+)[KkMmGg]?$`)
+	// The HTTP header name token characters, except the ones that are special in the access log pattern
+	httpHeaderNameRegexp = regexp.MustCompile("^[!#$&'*+\\-.^_`|~0-9A-Za-z]+$")
+
+	// The request headers that are not logged by default, because they hold credentials
+	httpDefaultRedactedHeaders = []string{"Authorization", "Cookie"}
+)
+
+type httpTrait struct {
+	BaseTrait
+	traitv1.HTTPTrait `property:",squash"`
+}
+
+func newHTTPTrait() Trait {
+	return &httpTrait{
+		// Must be applied before the container trait, that computes the application properties
+		BaseTrait: NewBaseTrait("http", 1500),
+	}
+}
+
+func (t *httpTrait) Configure(e *Environment) (bool, error) {
+	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, false) {
+		return false, nil
+	}
+
+	if _, err := parseHTTPTimeout("idle-timeout", t.IdleTimeout); err != nil {
+		return false, err
+	}
+	if _, err := parseHTTPTimeout("read-timeout", t.ReadTimeout); err != nil {
+		return false, err
+	}
+	if t.MaxHeaderSize != "" && !isValidHTTPMemorySize(t.MaxHeaderSize) {
+		return false, fmt.Errorf("invalid max-header-size %q: it must be a positive number of bytes, "+
+			"with an optional K, M or G unit suffix", t.MaxHeaderSize)
+	}
+
+	if err := t.validateAccessLogHeaders(); err != nil {
+		return false, err
+	}
+
+	return e.IntegrationInRunningPhases(), nil
+}
+
+func (t *httpTrait) validateAccessLogHeaders() error {
+	redacted := make(map[string]bool)
+	for _, h := range t.redactedHeaders() {
+		if !httpHeaderNameRegexp.MatchString(h) {
+			return fmt.Errorf("invalid access-log-redacted-headers header name %q", h)
+		}
+		redacted[http.CanonicalHeaderKey(h)] = true
+	}
+	for _, h := range t.AccessLogHeaders {
+		if !httpHeaderNameRegexp.MatchString(h) {
+			return fmt.Errorf("invalid access-log-headers header name %q", h)
+		}
+		if redacted[http.CanonicalHeaderKey(h)] {
+			return fmt.Errorf("header %q can't be both logged and redacted in the access log", h)
+		}
+	}
+	return nil
+}
+
+// redactedHeaders returns the request headers that must not be logged in the access log.
+func (t *httpTrait) redactedHeaders() []string {
+	if t.AccessLogRedactedHeaders == nil {
+		return httpDefaultRedactedHeaders
+	}
+	return t.AccessLogRedactedHeaders
+}
+
+// accessLogPattern returns the access log pattern, that extends the common log format with the logged headers.
+func (t *httpTrait) accessLogPattern() string {
+	pattern := httpAccessLogPattern
+	logged := make(map[string]bool)
+	for _, h := range t.AccessLogHeaders {
+		name := http.CanonicalHeaderKey(h)
+		if logged[name] {
+			continue
+		}
+		logged[name] = true
+		pattern += fmt.Sprintf(` "%s: %%{i,%s}"`, name, name)
+	}
+	return pattern
+}
+
+func (t *httpTrait) Apply(e *Environment) error {
+	if e.ApplicationProperties == nil {
+		e.ApplicationProperties = make(map[string]string)
+	}
+
+	// The timeouts are validated when the trait is configured
+	if timeout, _ := parseHTTPTimeout("idle-timeout", t.IdleTimeout); timeout != "" {
+		e.ApplicationProperties["quarkus.http.idle-timeout"] = timeout
+	}
+	if timeout, _ := parseHTTPTimeout("read-timeout", t.ReadTimeout); timeout != "" {
+		e.ApplicationProperties["quarkus.http.read-timeout"] = timeout
+	}
+	if t.MaxHeaderSize != "" {
+		e.ApplicationProperties["quarkus.http.limits.max-header-size"] = t.MaxHeaderSize
+	}
+	if pointer.BoolDeref(t.AccessLog, false) {
+		e.ApplicationProperties["quarkus.http.access-log.enabled"] = "true"
+		e.ApplicationProperties["quarkus.http.access-log.pattern"] = t.accessLogPattern()
+	}
+
+	return nil
+}
+
+// parseHTTPTimeout validates the given timeout, and converts it to the Quarkus duration format.
+func parseHTTPTimeout(name string, value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s %q: %w", name, value, err)
+	}
+	if d <= 0 {
+		return "", fmt.Errorf("invalid %s %q: it must be positive", name, value)
+	}
+	if d.Truncate(time.Second) != d {
+		return "", fmt.Errorf("invalid %s %q: it must be rounded to a second precision", name, value)
+	}
+	return fmt.Sprintf("%ds", int64(d.Seconds())), nil
+}
+
+// isValidHTTPMemorySize checks the given size complies with the Quarkus memory size format, and is positive.
+func isValidHTTPMemorySize(size string) bool {
+	match := httpMemorySizeRegexp.FindStringSubmatch(size)
+	if match == nil {
+		return false
+	}
+	n, err := strconv.ParseInt(match[1], 10, 64)
+	return err == nil && n > 0
+}
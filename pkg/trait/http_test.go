@@ -0,0 +1,177 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/utils/pointer"
+
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+)
+
+func TestConfigureHTTPTraitDoesSucceed(t *testing.T) {
+	httpTrait := createNominalHTTPTrait()
+	httpTrait.IdleTimeout = "30s"
+	environment, _ := createNominalDeploymentTraitTest()
+	configured, err := httpTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureDisabledHTTPTraitDoesNotSucceed(t *testing.T) {
+	httpTrait := createNominalHTTPTrait()
+	httpTrait.Enabled = pointer.Bool(false)
+	environment, _ := createNominalDeploymentTraitTest()
+	configured, err := httpTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureHTTPTraitWithInvalidValuesFails(t *testing.T) {
+	environment, _ := createNominalDeploymentTraitTest()
+
+	for _, trait := range []*httpTrait{
+		{HTTPTrait: traitv1.HTTPTrait{IdleTimeout: "30"}},
+		{HTTPTrait: traitv1.HTTPTrait{IdleTimeout: "-1m"}},
+		{HTTPTrait: traitv1.HTTPTrait{IdleTimeout: "1500ms"}},
+		{HTTPTrait: traitv1.HTTPTrait{ReadTimeout: "abc"}},
+		{HTTPTrait: traitv1.HTTPTrait{ReadTimeout: "0s"}},
+		{HTTPTrait: traitv1.HTTPTrait{MaxHeaderSize: "8KB"}},
+		{HTTPTrait: traitv1.HTTPTrait{MaxHeaderSize: "0"}},
+		{HTTPTrait: traitv1.HTTPTrait{MaxHeaderSize: "-8K"}},
+	} {
+		trait.Enabled = pointer.Bool(true)
+		configured, err := trait.Configure(environment)
+
+		assert.False(t, configured)
+		assert.NotNil(t, err, "trait %v", trait.HTTPTrait)
+	}
+}
+
+func TestApplyHTTPTraitDoesSucceed(t *testing.T) {
+	httpTrait := createNominalHTTPTrait()
+	httpTrait.IdleTimeout = "1m30s"
+	httpTrait.ReadTimeout = "10s"
+	httpTrait.MaxHeaderSize = "8K"
+
+	environment, _ := createNominalDeploymentTraitTest()
+	configured, err := httpTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	err = httpTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "90s", environment.ApplicationProperties["quarkus.http.idle-timeout"])
+	assert.Equal(t, "10s", environment.ApplicationProperties["quarkus.http.read-timeout"])
+	assert.Equal(t, "8K", environment.ApplicationProperties["quarkus.http.limits.max-header-size"])
+}
+
+func TestApplyHTTPTraitWithoutValuesDoesNotSetProperties(t *testing.T) {
+	httpTrait := createNominalHTTPTrait()
+	environment, _ := createNominalDeploymentTraitTest()
+
+	err := httpTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.NotContains(t, environment.ApplicationProperties, "quarkus.http.idle-timeout")
+	assert.NotContains(t, environment.ApplicationProperties, "quarkus.http.read-timeout")
+	assert.NotContains(t, environment.ApplicationProperties, "quarkus.http.limits.max-header-size")
+	assert.NotContains(t, environment.ApplicationProperties, "quarkus.http.access-log.enabled")
+}
+
+func TestConfigureHTTPTraitWithInvalidAccessLogHeadersFails(t *testing.T) {
+	environment, _ := createNominalDeploymentTraitTest()
+
+	for _, tc := range []struct {
+		trait   traitv1.HTTPTrait
+		message string
+	}{
+		{
+			trait:   traitv1.HTTPTrait{AccessLogHeaders: []string{"User Agent"}},
+			message: `invalid access-log-headers header name "User Agent"`,
+		},
+		{
+			trait:   traitv1.HTTPTrait{AccessLogHeaders: []string{"X-%h"}},
+			message: `invalid access-log-headers header name "X-%h"`,
+		},
+		{
+			trait:   traitv1.HTTPTrait{AccessLogRedactedHeaders: []string{""}},
+			message: `invalid access-log-redacted-headers header name ""`,
+		},
+		{
+			trait:   traitv1.HTTPTrait{AccessLogHeaders: []string{"User-Agent", "authorization"}},
+			message: `header "authorization" can't be both logged and redacted in the access log`,
+		},
+		{
+			trait: traitv1.HTTPTrait{
+				AccessLogHeaders:         []string{"X-Api-Key"},
+				AccessLogRedactedHeaders: []string{"x-api-key"},
+			},
+			message: `header "X-Api-Key" can't be both logged and redacted in the access log`,
+		},
+	} {
+		trait := createNominalHTTPTrait()
+		trait.HTTPTrait = tc.trait
+		trait.Enabled = pointer.Bool(true)
+		configured, err := trait.Configure(environment)
+
+		assert.False(t, configured)
+		assert.EqualError(t, err, tc.message)
+	}
+
+	// The default redacted headers can be logged when they're overridden
+	trait := createNominalHTTPTrait()
+	trait.AccessLogHeaders = []string{"Cookie"}
+	trait.AccessLogRedactedHeaders = []string{"Authorization"}
+	configured, err := trait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestApplyHTTPTraitAccessLogDoesSucceed(t *testing.T) {
+	httpTrait := createNominalHTTPTrait()
+	httpTrait.AccessLog = pointer.Bool(true)
+	httpTrait.AccessLogHeaders = []string{"user-agent", "X-Request-ID", "User-Agent"}
+
+	environment, _ := createNominalDeploymentTraitTest()
+	configured, err := httpTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	err = httpTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "true", environment.ApplicationProperties["quarkus.http.access-log.enabled"])
+	assert.Equal(t, `%h %l %u %t "%r" %s %b "User-Agent: %{i,User-Agent}" "X-Request-Id: %{i,X-Request-Id}"`,
+		environment.ApplicationProperties["quarkus.http.access-log.pattern"])
+}
+
+func createNominalHTTPTrait() *httpTrait {
+	trait, _ := newHTTPTrait().(*httpTrait)
+	trait.Enabled = pointer.Bool(true)
+	return trait
+}
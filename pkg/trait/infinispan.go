@@ -0,0 +1,371 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/envvar"
+)
+
+const (
+	infinispanTraitID = "infinispan"
+
+	infinispanCacheModeReplicated   = "replicated"
+	infinispanCacheModeDistributed  = "distributed"
+	infinispanDiscoveryKubePing     = "kube-ping"
+	infinispanDiscoveryDNSPing      = "dns-ping"
+	infinispanDefaultOwners         = 2
+	infinispanConfigFile            = "infinispan.xml"
+	infinispanConfigVolumeName      = "infinispan-config"
+	infinispanConfigEnvVar          = "QUARKUS_INFINISPAN_EMBEDDED_XML_CONFIG"
+	infinispanJGroupsPortName       = "jgroups"
+	infinispanJGroupsPort           = 7800
+	infinispanJGroupsKubernetesDep  = "mvn:org.jgroups.kubernetes:jgroups-kubernetes:2.0.1.Final"
+	infinispanEmbeddedDependency    = "mvn:io.quarkus:quarkus-infinispan-embedded"
+	infinispanCacheNameMaxLength    = 255
+	infinispanConfigurationTemplate = `<?xml version="1.0" encoding="UTF-8"?>
+<infinispan xmlns="urn:infinispan:config:14.0" xmlns:ispn="urn:infinispan:config:14.0">
+  <jgroups>
+    <stack name="camel-k" extends="kubernetes">
+      %s
+    </stack>
+  </jgroups>
+  <cache-container name="%s">
+    <transport cluster="%s" stack="camel-k"/>
+%s  </cache-container>
+</infinispan>
+`
+)
+
+var (
+	infinispanConfigMountPath = filepath.Join(camel.BasePath, "infinispan")
+	infinispanCacheNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+)
+
+type infinispanTrait struct {
+	BaseTrait
+	traitv1.InfinispanTrait `property:",squash"`
+}
+
+func newInfinispanTrait() Trait {
+	return &infinispanTrait{
+		// After the mount trait, so that the volumes of the integration container are configured
+		BaseTrait: NewBaseTrait(infinispanTraitID, 1630),
+	}
+}
+
+func (t *infinispanTrait) Configure(e *Environment) (bool, error) {
+	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, false) {
+		return false, nil
+	}
+
+	if !e.IntegrationInPhase(v1.IntegrationPhaseInitialization) && !e.IntegrationInRunningPhases() {
+		return false, nil
+	}
+
+	if t.CacheMode == "" {
+		t.CacheMode = infinispanCacheModeReplicated
+	}
+	if t.Discovery == "" {
+		t.Discovery = infinispanDiscoveryKubePing
+	}
+	if err := t.validateCaches(); err != nil {
+		return false, err
+	}
+	if err := t.validateClustering(e); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// validateCaches checks the cache names are unique, and can be used in the Infinispan configuration.
+func (t *infinispanTrait) validateCaches() error {
+	for i, name := range t.Caches {
+		if len(name) > infinispanCacheNameMaxLength || !infinispanCacheNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid Infinispan cache name %q: it must consist of at most %d alphanumeric characters, "+
+				"'.', '_' or '-'", name, infinispanCacheNameMaxLength)
+		}
+		if util.StringSliceExists(t.Caches[:i], name) {
+			return fmt.Errorf("duplicate Infinispan cache name %q", name)
+		}
+	}
+	return nil
+}
+
+// validateClustering checks the caches can be clustered across the integration pods, as they're configured.
+func (t *infinispanTrait) validateClustering(e *Environment) error {
+	switch t.Discovery {
+	case infinispanDiscoveryKubePing, infinispanDiscoveryDNSPing:
+	default:
+		return fmt.Errorf("unsupported Infinispan discovery %q: it must be one of %s, %s", t.Discovery,
+			infinispanDiscoveryKubePing, infinispanDiscoveryDNSPing)
+	}
+
+	switch t.CacheMode {
+	case infinispanCacheModeReplicated:
+		if t.Owners != nil {
+			return fmt.Errorf("the Infinispan owners can't be set for the %s cache mode, only for the %s one",
+				infinispanCacheModeReplicated, infinispanCacheModeDistributed)
+		}
+	case infinispanCacheModeDistributed:
+		owners := pointer.IntDeref(t.Owners, infinispanDefaultOwners)
+		if owners < 1 {
+			return fmt.Errorf("invalid Infinispan owners %d: it must be positive", owners)
+		}
+		if replicas := e.Integration.Spec.Replicas; replicas != nil && int32(owners) > *replicas {
+			return fmt.Errorf("the Infinispan owners (%d) exceed the integration replicas (%d), "+
+				"so that the entries wouldn't be held by as many pods", owners, *replicas)
+		}
+	default:
+		return fmt.Errorf("unsupported Infinispan cache mode %q: it must be one of %s, %s", t.CacheMode,
+			infinispanCacheModeReplicated, infinispanCacheModeDistributed)
+	}
+
+	if e.IntegrationInRunningPhases() {
+		strategy, err := e.DetermineControllerStrategy()
+		if err != nil {
+			return err
+		}
+		// The pods of a CronJob don't overlap, and a Knative Service can be scaled to zero, which loses the state
+		if strategy != ControllerStrategyDeployment {
+			return fmt.Errorf("the Infinispan caches can't be clustered with the %s controller strategy, only with the %s one",
+				strategy, ControllerStrategyDeployment)
+		}
+	}
+
+	return nil
+}
+
+func (t *infinispanTrait) Apply(e *Environment) error {
+	if e.IntegrationInPhase(v1.IntegrationPhaseInitialization) {
+		util.StringSliceUniqueAdd(&e.Integration.Status.Dependencies, infinispanEmbeddedDependency)
+		if t.Discovery == infinispanDiscoveryKubePing {
+			util.StringSliceUniqueAdd(&e.Integration.Status.Dependencies, infinispanJGroupsKubernetesDep)
+		}
+		return nil
+	}
+
+	container := e.GetIntegrationContainer()
+	if container == nil {
+		return fmt.Errorf("unable to find integration container: %s", e.Integration.Name)
+	}
+	podSpec := e.GetIntegrationPodSpec()
+	if podSpec == nil {
+		return fmt.Errorf("could not find any integration deployment for %v", e.Integration.Name)
+	}
+
+	configMap, err := t.newConfigMap(e)
+	if err != nil {
+		return err
+	}
+	e.Resources.Add(configMap)
+	e.Resources.Add(t.newPingService(e))
+	if t.Discovery == infinispanDiscoveryKubePing {
+		role, roleBinding := t.newPodsReaderRole(e)
+		e.Resources.Add(role)
+		e.Resources.Add(roleBinding)
+	}
+
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: infinispanConfigVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: configMap.Name,
+				},
+			},
+		},
+	})
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      infinispanConfigVolumeName,
+		MountPath: infinispanConfigMountPath,
+		ReadOnly:  true,
+	})
+	envvar.SetVal(&container.Env, infinispanConfigEnvVar, filepath.Join(infinispanConfigMountPath, infinispanConfigFile))
+	container.Ports = append(container.Ports, corev1.ContainerPort{
+		Name:          infinispanJGroupsPortName,
+		ContainerPort: infinispanJGroupsPort,
+		Protocol:      corev1.ProtocolTCP,
+	})
+
+	return nil
+}
+
+// configuration returns the Infinispan configuration, that defines the clustered caches and the JGroups discovery.
+func (t *infinispanTrait) configuration(e *Environment) (string, error) {
+	var discovery string
+	switch t.Discovery {
+	case infinispanDiscoveryKubePing:
+		discovery = fmt.Sprintf(`<kubernetes.KUBE_PING xmlns="urn:org:jgroups" namespace="%s" labels="%s=%s" `+
+			`ispn:stack.combine="REPLACE" ispn:stack.position="dns.DNS_PING"/>`,
+			e.Integration.Namespace, v1.IntegrationLabel, e.Integration.Name)
+	case infinispanDiscoveryDNSPing:
+		discovery = fmt.Sprintf(`<dns.DNS_PING xmlns="urn:org:jgroups" dns_query="%s.%s.svc.cluster.local" `+
+			`ispn:stack.combine="COMBINE"/>`, t.pingServiceName(e), e.Integration.Namespace)
+	default:
+		return "", fmt.Errorf("unsupported Infinispan discovery %q", t.Discovery)
+	}
+
+	var caches strings.Builder
+	for _, name := range t.Caches {
+		if t.CacheMode == infinispanCacheModeDistributed {
+			caches.WriteString(fmt.Sprintf("    <distributed-cache name=\"%s\" mode=\"SYNC\" owners=\"%d\"/>\n",
+				name, pointer.IntDeref(t.Owners, infinispanDefaultOwners)))
+		} else {
+			caches.WriteString(fmt.Sprintf("    <replicated-cache name=\"%s\" mode=\"SYNC\"/>\n", name))
+		}
+	}
+
+	// The cluster name is unique across namespaces, so that the integrations don't join each others' clusters
+	cluster := e.Integration.Namespace + "." + e.Integration.Name
+
+	return fmt.Sprintf(infinispanConfigurationTemplate, discovery, e.Integration.Name, cluster, caches.String()), nil
+}
+
+func (t *infinispanTrait) newConfigMap(e *Environment) (*corev1.ConfigMap, error) {
+	configuration, err := t.configuration(e)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name + "-infinispan",
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+		},
+		Data: map[string]string{
+			infinispanConfigFile: configuration,
+		},
+	}, nil
+}
+
+func (t *infinispanTrait) pingServiceName(e *Environment) string {
+	return e.Integration.Name + "-ping"
+}
+
+// newPingService returns the headless Service that resolves to the addresses of all the integration pods,
+// including the ones that are not ready yet, as they must discover each other to become ready.
+func (t *infinispanTrait) newPingService(e *Environment) *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      t.pingServiceName(e),
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel:             e.Integration.Name,
+				"camel.apache.org/service.type": v1.ServiceTypeAdditional,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP:                corev1.ClusterIPNone,
+			PublishNotReadyAddresses: true,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       infinispanJGroupsPortName,
+					Port:       infinispanJGroupsPort,
+					Protocol:   corev1.ProtocolTCP,
+					TargetPort: intstr.FromString(infinispanJGroupsPortName),
+				},
+			},
+			Selector: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+		},
+	}
+}
+
+// newPodsReaderRole returns the Role, and its binding to the integration service account,
+// that grants listing the integration pods, as required by the KUBE_PING discovery.
+func (t *infinispanTrait) newPodsReaderRole(e *Environment) (*rbacv1.Role, *rbacv1.RoleBinding) {
+	name := e.Integration.Name + "-infinispan"
+	serviceAccount := e.Integration.Spec.ServiceAccountName
+	if serviceAccount == "" {
+		serviceAccount = "default"
+	}
+	labels := map[string]string{
+		v1.IntegrationLabel: e.Integration.Name,
+	}
+
+	role := &rbacv1.Role{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Role",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: e.Integration.Namespace,
+			Labels:    labels,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods"},
+				Verbs:     []string{"get", "list"},
+			},
+		},
+	}
+	roleBinding := &rbacv1.RoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "RoleBinding",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: e.Integration.Namespace,
+			Labels:    labels,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     name,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Namespace: e.Integration.Namespace,
+				Name:      serviceAccount,
+			},
+		},
+	}
+
+	return role, roleBinding
+}
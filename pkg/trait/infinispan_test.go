@@ -0,0 +1,245 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util/envvar"
+)
+
+func TestInfinispanTraitDependencies(t *testing.T) {
+	env := newTestInfinispanEnv(t, &traitv1.InfinispanTrait{}, v1.IntegrationPhaseInitialization)
+
+	err := env.Catalog.apply(&env)
+	assert.Nil(t, err)
+	assert.NotNil(t, env.GetTrait(infinispanTraitID))
+	assert.Contains(t, env.Integration.Status.Dependencies, "mvn:io.quarkus:quarkus-infinispan-embedded")
+	assert.Contains(t, env.Integration.Status.Dependencies, "mvn:org.jgroups.kubernetes:jgroups-kubernetes:2.0.1.Final")
+}
+
+func TestInfinispanTraitDNSPingDependencies(t *testing.T) {
+	env := newTestInfinispanEnv(t, &traitv1.InfinispanTrait{
+		Discovery: infinispanDiscoveryDNSPing,
+	}, v1.IntegrationPhaseInitialization)
+
+	err := env.Catalog.apply(&env)
+	assert.Nil(t, err)
+	assert.Contains(t, env.Integration.Status.Dependencies, "mvn:io.quarkus:quarkus-infinispan-embedded")
+	assert.NotContains(t, env.Integration.Status.Dependencies, "mvn:org.jgroups.kubernetes:jgroups-kubernetes:2.0.1.Final")
+}
+
+func TestInfinispanTraitKubePing(t *testing.T) {
+	env := newTestInfinispanEnv(t, &traitv1.InfinispanTrait{
+		Caches: []string{"orders", "idempotent"},
+	}, v1.IntegrationPhaseDeploying)
+	env.Integration.Spec.ServiceAccountName = "my-sa"
+
+	err := env.Catalog.apply(&env)
+	assert.Nil(t, err)
+	assert.NotNil(t, env.GetTrait(infinispanTraitID))
+
+	configMap := env.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool {
+		return cm.Name == "my-it-infinispan"
+	})
+	assert.NotNil(t, configMap)
+	assert.Equal(t, "my-it", configMap.Labels[v1.IntegrationLabel])
+	configuration := configMap.Data[infinispanConfigFile]
+	assert.Contains(t, configuration, `<kubernetes.KUBE_PING xmlns="urn:org:jgroups" namespace="ns" `+
+		`labels="camel.apache.org/integration=my-it"`)
+	assert.Contains(t, configuration, `<transport cluster="ns.my-it" stack="camel-k"/>`)
+	assert.Contains(t, configuration, `<replicated-cache name="orders" mode="SYNC"/>`)
+	assert.Contains(t, configuration, `<replicated-cache name="idempotent" mode="SYNC"/>`)
+
+	service := env.Resources.GetService(func(s *corev1.Service) bool {
+		return s.Name == "my-it-ping"
+	})
+	assert.NotNil(t, service)
+	assert.Equal(t, corev1.ClusterIPNone, service.Spec.ClusterIP)
+	assert.True(t, service.Spec.PublishNotReadyAddresses)
+	assert.Equal(t, "my-it", service.Spec.Selector[v1.IntegrationLabel])
+	assert.Equal(t, int32(7800), service.Spec.Ports[0].Port)
+	assert.Equal(t, v1.ServiceTypeAdditional, service.Labels["camel.apache.org/service.type"])
+	// The ping Service is not mistaken for the integration Service
+	assert.Nil(t, env.Resources.GetServiceForIntegration(env.Integration))
+
+	var role *rbacv1.Role
+	var roleBinding *rbacv1.RoleBinding
+	for _, r := range env.Resources.Items() {
+		switch res := r.(type) {
+		case *rbacv1.Role:
+			role = res
+		case *rbacv1.RoleBinding:
+			roleBinding = res
+		}
+	}
+	assert.NotNil(t, role)
+	assert.Equal(t, "my-it-infinispan", role.Name)
+	assert.Equal(t, []string{"pods"}, role.Rules[0].Resources)
+	assert.Equal(t, []string{"get", "list"}, role.Rules[0].Verbs)
+	assert.NotNil(t, roleBinding)
+	assert.Equal(t, "my-it-infinispan", roleBinding.RoleRef.Name)
+	assert.Equal(t, "my-sa", roleBinding.Subjects[0].Name)
+
+	container := env.GetIntegrationContainer()
+	assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{
+		Name:      infinispanConfigVolumeName,
+		MountPath: "/etc/camel/infinispan",
+		ReadOnly:  true,
+	})
+	assert.Equal(t, "/etc/camel/infinispan/infinispan.xml", envvar.Get(container.Env, infinispanConfigEnvVar).Value)
+	assert.Contains(t, container.Ports, corev1.ContainerPort{
+		Name:          "jgroups",
+		ContainerPort: 7800,
+		Protocol:      corev1.ProtocolTCP,
+	})
+}
+
+func TestInfinispanTraitDNSPingDistributed(t *testing.T) {
+	env := newTestInfinispanEnv(t, &traitv1.InfinispanTrait{
+		Caches:    []string{"orders"},
+		CacheMode: infinispanCacheModeDistributed,
+		Owners:    pointer.Int(3),
+		Discovery: infinispanDiscoveryDNSPing,
+	}, v1.IntegrationPhaseDeploying)
+	env.Integration.Spec.Replicas = pointer.Int32(3)
+
+	err := env.Catalog.apply(&env)
+	assert.Nil(t, err)
+
+	configMap := env.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool {
+		return cm.Name == "my-it-infinispan"
+	})
+	assert.NotNil(t, configMap)
+	configuration := configMap.Data[infinispanConfigFile]
+	assert.Contains(t, configuration, `<dns.DNS_PING xmlns="urn:org:jgroups" dns_query="my-it-ping.ns.svc.cluster.local"`)
+	assert.Contains(t, configuration, `<distributed-cache name="orders" mode="SYNC" owners="3"/>`)
+
+	for _, r := range env.Resources.Items() {
+		_, isRole := r.(*rbacv1.Role)
+		_, isRoleBinding := r.(*rbacv1.RoleBinding)
+		assert.False(t, isRole || isRoleBinding)
+	}
+}
+
+func TestInfinispanTraitWithInvalidConfiguration(t *testing.T) {
+	testCases := []struct {
+		name     string
+		trait    traitv1.InfinispanTrait
+		replicas *int32
+		err      string
+	}{
+		{
+			name:  "invalid cache name",
+			trait: traitv1.InfinispanTrait{Caches: []string{"my cache"}},
+			err:   `invalid Infinispan cache name "my cache"`,
+		},
+		{
+			name:  "duplicate cache name",
+			trait: traitv1.InfinispanTrait{Caches: []string{"orders", "orders"}},
+			err:   `duplicate Infinispan cache name "orders"`,
+		},
+		{
+			name:  "unsupported cache mode",
+			trait: traitv1.InfinispanTrait{CacheMode: "local"},
+			err:   `unsupported Infinispan cache mode "local"`,
+		},
+		{
+			name:  "unsupported discovery",
+			trait: traitv1.InfinispanTrait{Discovery: "tcp-ping"},
+			err:   `unsupported Infinispan discovery "tcp-ping"`,
+		},
+		{
+			name:  "owners with replicated mode",
+			trait: traitv1.InfinispanTrait{Owners: pointer.Int(2)},
+			err:   "the Infinispan owners can't be set for the replicated cache mode",
+		},
+		{
+			name:  "non positive owners",
+			trait: traitv1.InfinispanTrait{CacheMode: infinispanCacheModeDistributed, Owners: pointer.Int(0)},
+			err:   "invalid Infinispan owners 0",
+		},
+		{
+			name:     "owners exceeding replicas",
+			trait:    traitv1.InfinispanTrait{CacheMode: infinispanCacheModeDistributed},
+			replicas: pointer.Int32(1),
+			err:      "the Infinispan owners (2) exceed the integration replicas (1)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trait := tc.trait
+			env := newTestInfinispanEnv(t, &trait, v1.IntegrationPhaseDeploying)
+			env.Integration.Spec.Replicas = tc.replicas
+
+			err := env.Catalog.apply(&env)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestInfinispanTraitWithCronStrategy(t *testing.T) {
+	env := newTestInfinispanEnv(t, &traitv1.InfinispanTrait{}, v1.IntegrationPhaseDeploying)
+	env.Integration.Spec.Traits.Deployer = &traitv1.DeployerTrait{
+		Kind: "cron-job",
+	}
+	err := env.Catalog.Configure(&env)
+	assert.Nil(t, err)
+	env.ConfiguredTraits = env.Catalog.traitsFor(&env)
+
+	trait, ok := env.Catalog.GetTrait(infinispanTraitID).(*infinispanTrait)
+	assert.True(t, ok)
+	enabled, err := trait.Configure(&env)
+	assert.False(t, enabled)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "the Infinispan caches can't be clustered with the cron-job controller strategy")
+}
+
+// newTestInfinispanEnv returns an environment with the given infinispan trait enabled, and the integration in the given phase.
+func newTestInfinispanEnv(t *testing.T, trait *traitv1.InfinispanTrait, phase v1.IntegrationPhase) Environment {
+	t.Helper()
+
+	trait.Enabled = pointer.Bool(true)
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-it",
+			Namespace: "ns",
+		},
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Infinispan: trait,
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Phase: phase,
+		},
+	}
+
+	return newTestProbesEnv(t, integration)
+}
@@ -20,6 +20,8 @@ package trait
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -28,11 +30,17 @@ import (
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/metadata"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
 )
 
+const platformHTTPScheme = "platform-http"
+
 type ingressTrait struct {
 	BaseTrait
 	traitv1.IngressTrait `property:",squash"`
+
+	paths []string
 }
 
 func newIngressTrait() Trait {
@@ -76,16 +84,88 @@ func (t *ingressTrait) Configure(e *Environment) (bool, error) {
 		}
 	}
 
+	if _, err := parseAnnotations("ingress", t.Annotations); err != nil {
+		return false, err
+	}
+
+	t.paths = t.Paths
+	if len(t.paths) == 0 && pointer.BoolDeref(t.Auto, true) {
+		sources, err := kubernetes.ResolveIntegrationSources(e.Ctx, t.Client, e.Integration, e.Resources)
+		if err != nil {
+			return false, err
+		}
+		meta, err := metadata.ExtractAll(e.CamelCatalog, sources)
+		if err != nil {
+			return false, err
+		}
+		t.paths = t.discoverPaths(e, meta)
+	}
+
 	return true, nil
 }
 
+// discoverPaths returns the paths of the platform-http consumers of the integration, or nil when the integration
+// exposes other HTTP endpoints, e.g., with the REST DSL, whose paths can't be determined, so that / is exposed.
+func (t *ingressTrait) discoverPaths(e *Environment, meta metadata.IntegrationMetadata) []string {
+	if meta.RequiredCapabilities.Has(v1.CapabilityRest) {
+		return nil
+	}
+	paths := make(map[string]bool)
+	for _, uri := range meta.FromURIs {
+		parts := strings.SplitN(uri, ":", 2)
+		if parts[0] != platformHTTPScheme {
+			if scheme, ok := e.CamelCatalog.GetScheme(parts[0]); ok && scheme.HTTP {
+				return nil
+			}
+			continue
+		}
+		path := "/"
+		if len(parts) == 2 {
+			path = strings.TrimPrefix(parts[1], "//")
+			// Strip the endpoint parameters and the path parameters, e.g., /api/{id}
+			path = strings.SplitN(path, "?", 2)[0]
+			path = strings.SplitN(path, "{", 2)[0]
+			path = "/" + strings.Trim(path, "/")
+		}
+		paths[path] = true
+	}
+	if paths["/"] {
+		return []string{"/"}
+	}
+	result := make([]string, 0, len(paths))
+	for path := range paths {
+		result = append(result, path)
+	}
+	sort.Strings(result)
+	return result
+}
+
 func (t *ingressTrait) Apply(e *Environment) error {
 	service := e.Resources.GetUserServiceForIntegration(e.Integration)
 	if service == nil {
 		return errors.New("cannot Apply ingress trait: no target service")
 	}
 
+	exposedPaths := t.paths
+	if len(exposedPaths) == 0 {
+		exposedPaths = []string{"/"}
+	}
 	pathType := networkingv1.PathTypePrefix
+	paths := make([]networkingv1.HTTPIngressPath, 0, len(exposedPaths))
+	for _, path := range exposedPaths {
+		paths = append(paths, networkingv1.HTTPIngressPath{
+			Path:     path,
+			PathType: &pathType,
+			Backend: networkingv1.IngressBackend{
+				Service: &networkingv1.IngressServiceBackend{
+					Name: service.Name,
+					Port: networkingv1.ServiceBackendPort{
+						Name: "http",
+					},
+				},
+			},
+		})
+	}
 
 	ingress := networkingv1.Ingress{
 		TypeMeta: metav1.TypeMeta{
@@ -102,20 +182,7 @@ func (t *ingressTrait) Apply(e *Environment) error {
 					Host: t.Host,
 					IngressRuleValue: networkingv1.IngressRuleValue{
 						HTTP: &networkingv1.HTTPIngressRuleValue{
-							Paths: []networkingv1.HTTPIngressPath{
-								{
-									Path:     "/",
-									PathType: &pathType,
-									Backend: networkingv1.IngressBackend{
-										Service: &networkingv1.IngressServiceBackend{
-											Name: service.Name,
-											Port: networkingv1.ServiceBackendPort{
-												Name: "http",
-											},
-										},
-									},
-								},
-							},
+							Paths: paths,
 						},
 					},
 				},
@@ -123,6 +190,22 @@ func (t *ingressTrait) Apply(e *Environment) error {
 		},
 	}
 
+	if annotations, _ := parseAnnotations("ingress", t.Annotations); len(annotations) > 0 {
+		ingress.Annotations = annotations
+	}
+	if t.IngressClassName != "" {
+		ingress.Spec.IngressClassName = &t.IngressClassName
+	}
+	if t.TLSSecret != "" {
+		tls := networkingv1.IngressTLS{
+			SecretName: t.TLSSecret,
+		}
+		if t.Host != "" {
+			tls.Hosts = []string{t.Host}
+		}
+		ingress.Spec.TLS = []networkingv1.IngressTLS{tls}
+	}
+
 	e.Resources.Add(&ingress)
 
 	message := fmt.Sprintf("%s(%s) -> %s(%s)", ingress.Name, t.Host, service.Name, "http")
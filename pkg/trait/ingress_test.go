@@ -29,6 +29,7 @@ import (
 	"k8s.io/utils/pointer"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/camel"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
 )
 
@@ -129,6 +130,129 @@ func TestApplyIngressTraitDoesSucceed(t *testing.T) {
 	assert.Equal(t, "service-name(hostname) -> service-name(http)", conditions[0].Message)
 }
 
+func TestApplyIngressTraitWithTLSClassAndAnnotations(t *testing.T) {
+	ingressTrait, environment := createNominalIngressTest()
+	ingressTrait.IngressClassName = "nginx"
+	ingressTrait.TLSSecret = "my-tls"
+	ingressTrait.Annotations = []string{
+		"cert-manager.io/cluster-issuer=letsencrypt",
+		"nginx.ingress.kubernetes.io/rewrite-target=/$2",
+	}
+
+	configured, err := ingressTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	err = ingressTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	ingress := findIngress(environment)
+	assert.NotNil(t, ingress)
+	assert.Equal(t, map[string]string{
+		"cert-manager.io/cluster-issuer":             "letsencrypt",
+		"nginx.ingress.kubernetes.io/rewrite-target": "/$2",
+	}, ingress.Annotations)
+	assert.Equal(t, "nginx", *ingress.Spec.IngressClassName)
+	assert.Equal(t, []networkingv1.IngressTLS{
+		{Hosts: []string{"hostname"}, SecretName: "my-tls"},
+	}, ingress.Spec.TLS)
+}
+
+func TestConfigureIngressTraitWithInvalidAnnotation(t *testing.T) {
+	ingressTrait, environment := createNominalIngressTest()
+	ingressTrait.Annotations = []string{"invalid"}
+
+	configured, err := ingressTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `invalid ingress annotation "invalid"`)
+}
+
+func TestApplyIngressTraitWithPaths(t *testing.T) {
+	ingressTrait, environment := createNominalIngressTest()
+	ingressTrait.Paths = []string{"/api", "/admin"}
+
+	configured, err := ingressTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	err = ingressTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	ingress := findIngress(environment)
+	assert.NotNil(t, ingress)
+	assert.Len(t, ingress.Spec.Rules[0].HTTP.Paths, 2)
+	assert.Equal(t, "/api", ingress.Spec.Rules[0].HTTP.Paths[0].Path)
+	assert.Equal(t, "/admin", ingress.Spec.Rules[0].HTTP.Paths[1].Path)
+}
+
+func TestConfigureIngressTraitDiscoversPlatformHTTPPaths(t *testing.T) {
+	ingressTrait, environment := createNominalIngressTest()
+	ingressTrait.Auto = nil
+	setIngressTestSource(t, environment, `
+		from("platform-http:/hello").log("hello");
+		from("platform-http:/api/users/{id}?httpMethodRestrict=GET").log("user");
+		from("platform-http:/api/users").log("users");
+	`)
+
+	configured, err := ingressTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/api/users", "/hello"}, ingressTrait.paths)
+}
+
+func TestConfigureIngressTraitWithRestDSLExposesRootPath(t *testing.T) {
+	ingressTrait, environment := createNominalIngressTest()
+	ingressTrait.Auto = nil
+	setIngressTestSource(t, environment, `
+		rest().get("/hello").to("direct:hello");
+		from("platform-http:/api").log("api");
+	`)
+
+	configured, err := ingressTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+	assert.Empty(t, ingressTrait.paths)
+
+	err = ingressTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	ingress := findIngress(environment)
+	assert.NotNil(t, ingress)
+	assert.Len(t, ingress.Spec.Rules[0].HTTP.Paths, 1)
+	assert.Equal(t, "/", ingress.Spec.Rules[0].HTTP.Paths[0].Path)
+}
+
+func findIngress(environment *Environment) *networkingv1.Ingress {
+	for _, resource := range environment.Resources.Items() {
+		if ingress, ok := resource.(*networkingv1.Ingress); ok {
+			return ingress
+		}
+	}
+	return nil
+}
+
+func setIngressTestSource(t *testing.T, environment *Environment, source string) {
+	t.Helper()
+
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	environment.CamelCatalog = catalog
+	environment.Integration.Spec.Sources = []v1.SourceSpec{
+		{
+			DataSpec: v1.DataSpec{
+				Name:    "routes.js",
+				Content: source,
+			},
+			Language: v1.LanguageJavaScript,
+		},
+	}
+}
+
 func createNominalIngressTest() (*ingressTrait, *Environment) {
 	trait, _ := newIngressTrait().(*ingressTrait)
 	trait.Enabled = pointer.Bool(true)
@@ -18,13 +18,18 @@ limitations under the License.
 package trait
 
 import (
+	"fmt"
 	"strconv"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/pointer"
 
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
 )
 
@@ -36,6 +41,14 @@ type istioTrait struct {
 const (
 	istioSidecarInjectAnnotation    = "sidecar.istio.io/inject"
 	istioOutboundIPRangesAnnotation = "traffic.sidecar.istio.io/includeOutboundIPRanges"
+
+	istioMTLSModeDisable = "DISABLE"
+	istioMutualTLSMode   = "ISTIO_MUTUAL"
+)
+
+var (
+	istioPeerAuthenticationGVK = schema.GroupVersionKind{Group: "security.istio.io", Version: "v1beta1", Kind: "PeerAuthentication"}
+	istioDestinationRuleGVK    = schema.GroupVersionKind{Group: "networking.istio.io", Version: "v1beta1", Kind: "DestinationRule"}
 )
 
 func newIstioTrait() Trait {
@@ -60,13 +73,72 @@ func (t *istioTrait) Apply(e *Environment) error {
 		e.Resources.VisitDeployment(func(d *appsv1.Deployment) {
 			d.Spec.Template.Annotations = t.injectIstioAnnotation(d.Spec.Template.Annotations, true)
 		})
+		e.Resources.VisitStatefulSet(func(s *appsv1.StatefulSet) {
+			s.Spec.Template.Annotations = t.injectIstioAnnotation(s.Spec.Template.Annotations, true)
+		})
 		e.Resources.VisitKnativeConfigurationSpec(func(cs *servingv1.ConfigurationSpec) {
 			cs.Template.Annotations = t.injectIstioAnnotation(cs.Template.Annotations, false)
 		})
 	}
+	if t.MTLSMode != "" {
+		e.Resources.Add(t.peerAuthenticationFor(e))
+		service := e.Resources.GetService(func(s *corev1.Service) bool {
+			return s.Name == e.Integration.Name
+		})
+		if service != nil {
+			e.Resources.Add(t.destinationRuleFor(e, service))
+		}
+	}
 	return nil
 }
 
+// peerAuthenticationFor returns the PeerAuthentication that sets the mutual TLS mode of the integration pods sidecars.
+// The Istio resources are unstructured, so that the Istio API isn't required.
+func (t *istioTrait) peerAuthenticationFor(e *Environment) *unstructured.Unstructured {
+	peerAuthentication := newIstioResource(e, istioPeerAuthenticationGVK, e.Integration.Name)
+	peerAuthentication.Object["spec"] = map[string]interface{}{
+		"selector": map[string]interface{}{
+			"matchLabels": map[string]interface{}{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+		},
+		"mtls": map[string]interface{}{
+			"mode": t.MTLSMode,
+		},
+	}
+	return peerAuthentication
+}
+
+// destinationRuleFor returns the DestinationRule that sets the TLS mode of the traffic to the integration Service,
+// consistently with the mutual TLS mode of its pods.
+func (t *istioTrait) destinationRuleFor(e *Environment, service *corev1.Service) *unstructured.Unstructured {
+	mode := istioMutualTLSMode
+	if t.MTLSMode == istioMTLSModeDisable {
+		mode = istioMTLSModeDisable
+	}
+	destinationRule := newIstioResource(e, istioDestinationRuleGVK, e.Integration.Name)
+	destinationRule.Object["spec"] = map[string]interface{}{
+		"host": fmt.Sprintf("%s.%s.svc.cluster.local", service.Name, e.Integration.Namespace),
+		"trafficPolicy": map[string]interface{}{
+			"tls": map[string]interface{}{
+				"mode": mode,
+			},
+		},
+	}
+	return destinationRule
+}
+
+func newIstioResource(e *Environment, gvk schema.GroupVersionKind, name string) *unstructured.Unstructured {
+	resource := &unstructured.Unstructured{}
+	resource.SetGroupVersionKind(gvk)
+	resource.SetName(name)
+	resource.SetNamespace(e.Integration.Namespace)
+	resource.SetLabels(map[string]string{
+		v1.IntegrationLabel: e.Integration.Name,
+	})
+	return resource
+}
+
 func (t *istioTrait) injectIstioAnnotation(annotations map[string]string, includeInject bool) map[string]string {
 	if annotations == nil {
 		annotations = make(map[string]string)
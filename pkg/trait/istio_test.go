@@ -25,6 +25,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/utils/pointer"
 
 	serving "knative.dev/serving/pkg/apis/serving/v1"
@@ -175,3 +176,85 @@ func TestIstioDisabled(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotContains(t, env.ExecutedTraits, "istio")
 }
+
+func TestIstioMutualTLS(t *testing.T) {
+	testCases := []struct {
+		mode    string
+		tlsMode string
+	}{
+		{mode: "STRICT", tlsMode: "ISTIO_MUTUAL"},
+		{mode: "PERMISSIVE", tlsMode: "ISTIO_MUTUAL"},
+		{mode: "DISABLE", tlsMode: "DISABLE"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.mode, func(t *testing.T) {
+			d := appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{},
+				},
+			}
+
+			env := NewIstioTestEnv(t, &d, &serving.Service{}, true)
+			env.Integration.Name = "my-it"
+			env.Integration.Namespace = "ns"
+			env.Integration.Spec.Traits.Istio.MTLSMode = tc.mode
+			env.Resources.Add(&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-it",
+					Namespace: "ns",
+					Labels:    map[string]string{v1.IntegrationLabel: "my-it"},
+				},
+			})
+
+			err := env.Catalog.apply(&env)
+			assert.Nil(t, err)
+
+			peerAuthentication := getIstioResource(env, "PeerAuthentication")
+			assert.NotNil(t, peerAuthentication)
+			assert.Equal(t, "security.istio.io/v1beta1", peerAuthentication.GetAPIVersion())
+			assert.Equal(t, "my-it", peerAuthentication.GetName())
+			assert.Equal(t, "ns", peerAuthentication.GetNamespace())
+			assert.Equal(t, "my-it", peerAuthentication.GetLabels()[v1.IntegrationLabel])
+			selector, _, _ := unstructured.NestedStringMap(peerAuthentication.Object, "spec", "selector", "matchLabels")
+			assert.Equal(t, map[string]string{v1.IntegrationLabel: "my-it"}, selector)
+			mode, _, _ := unstructured.NestedString(peerAuthentication.Object, "spec", "mtls", "mode")
+			assert.Equal(t, tc.mode, mode)
+
+			destinationRule := getIstioResource(env, "DestinationRule")
+			assert.NotNil(t, destinationRule)
+			assert.Equal(t, "networking.istio.io/v1beta1", destinationRule.GetAPIVersion())
+			host, _, _ := unstructured.NestedString(destinationRule.Object, "spec", "host")
+			assert.Equal(t, "my-it.ns.svc.cluster.local", host)
+			tlsMode, _, _ := unstructured.NestedString(destinationRule.Object, "spec", "trafficPolicy", "tls", "mode")
+			assert.Equal(t, tc.tlsMode, tlsMode)
+		})
+	}
+}
+
+func TestIstioMutualTLSWithoutService(t *testing.T) {
+	d := appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{},
+		},
+	}
+
+	env := NewIstioTestEnv(t, &d, &serving.Service{}, true)
+	env.Integration.Name = "my-it"
+	env.Integration.Spec.Traits.Istio.MTLSMode = "STRICT"
+
+	err := env.Catalog.apply(&env)
+	assert.Nil(t, err)
+
+	assert.NotNil(t, getIstioResource(env, "PeerAuthentication"))
+	assert.Nil(t, getIstioResource(env, "DestinationRule"))
+}
+
+func getIstioResource(e Environment, kind string) *unstructured.Unstructured {
+	for _, r := range e.Resources.Items() {
+		if u, ok := r.(*unstructured.Unstructured); ok && u.GetKind() == kind {
+			return u
+		}
+	}
+	return nil
+}
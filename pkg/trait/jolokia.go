@@ -23,11 +23,25 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/pkg/util"
+	"github.com/apache/camel-k/pkg/util/envvar"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+const (
+	jolokiaTraitID = "jolokia"
+
+	jolokiaCredentialsUsernameKey = "username"
+	jolokiaCredentialsPasswordKey = "password"
+	jolokiaUserEnvVar             = "JOLOKIA_USER"
+	jolokiaPasswordEnvVar         = "JOLOKIA_PASSWORD"
+	jolokiaAuthenticationDisabled = ", authentication disabled"
 )
 
 type jolokiaTrait struct {
@@ -37,7 +51,7 @@ type jolokiaTrait struct {
 
 func newJolokiaTrait() Trait {
 	return &jolokiaTrait{
-		BaseTrait: NewBaseTrait("jolokia", 1800),
+		BaseTrait: NewBaseTrait(jolokiaTraitID, 1800),
 		JolokiaTrait: traitv1.JolokiaTrait{
 			Port: 8778,
 		},
@@ -49,6 +63,10 @@ func (t *jolokiaTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
+	if t.CredentialsSecret != "" && (t.User != nil || t.Password != nil) {
+		return false, fmt.Errorf("jolokia credentials secret %s can't be set together with the user or password options", t.CredentialsSecret)
+	}
+
 	return e.IntegrationInPhase(v1.IntegrationPhaseInitialization) || e.IntegrationInRunningPhases(), nil
 }
 
@@ -114,6 +132,31 @@ func (t *jolokiaTrait) Apply(e *Environment) error {
 	t.addToJolokiaOptions(options, "user", t.User)
 	t.addToJolokiaOptions(options, "useSslClientAuthentication", t.UseSslClientAuthentication)
 
+	// Use the credentials from the Secret, that Kubernetes substitutes into the agent options
+	if t.CredentialsSecret != "" {
+		if err := t.validateCredentialsSecret(e); err != nil {
+			return err
+		}
+		for _, credential := range []struct{ envVar, key string }{
+			{jolokiaUserEnvVar, jolokiaCredentialsUsernameKey},
+			{jolokiaPasswordEnvVar, jolokiaCredentialsPasswordKey},
+		} {
+			envvar.SetVar(&container.Env, corev1.EnvVar{
+				Name: credential.envVar,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: t.CredentialsSecret},
+						Key:                  credential.key,
+					},
+				},
+			})
+		}
+		options["user"] = "$(" + jolokiaUserEnvVar + ")"
+		options["password"] = "$(" + jolokiaPasswordEnvVar + ")"
+	}
+
+	authenticated := options["user"] != "" || options["useSslClientAuthentication"] == "true"
+
 	// Options must be sorted so that the environment variable value is consistent over iterations,
 	// otherwise the value changes which results in triggering a new deployment.
 	optionValues := make([]string, len(options))
@@ -136,15 +179,86 @@ func (t *jolokiaTrait) Apply(e *Environment) error {
 		Protocol:      corev1.ProtocolTCP,
 	}
 
+	message := fmt.Sprintf("%s(%s/%d)", container.Name, containerPort.Name, containerPort.ContainerPort)
+	if !authenticated {
+		message += jolokiaAuthenticationDisabled
+		// Only warn when the authentication gets disabled, rather than at every reconciliation
+		if c := e.Integration.Status.GetCondition(v1.IntegrationConditionJolokiaAvailable); c == nil ||
+			!strings.HasSuffix(c.Message, jolokiaAuthenticationDisabled) {
+			t.L.ForIntegration(e.Integration).Infof("WARNING: the Jolokia endpoint of integration %s/%s has authentication disabled, "+
+				"and grants anyone who can reach it management access to the integration; "+
+				"set the credentials-secret option to enable basic authentication", e.Integration.Namespace, e.Integration.Name)
+		}
+	}
 	e.Integration.Status.SetCondition(
 		v1.IntegrationConditionJolokiaAvailable,
 		corev1.ConditionTrue,
 		v1.IntegrationConditionJolokiaAvailableReason,
-		fmt.Sprintf("%s(%s/%d)", container.Name, containerPort.Name, containerPort.ContainerPort),
+		message,
 	)
 
 	container.Ports = append(container.Ports, containerPort)
 
+	if pointer.BoolDeref(t.ClusterLocalService, false) {
+		return t.addClusterLocalService(e)
+	}
+
+	return nil
+}
+
+func (t *jolokiaTrait) validateCredentialsSecret(e *Environment) error {
+	if t.Client == nil {
+		return nil
+	}
+	secret, err := kubernetes.GetSecret(e.Ctx, t.Client, t.CredentialsSecret, e.Integration.Namespace)
+	if err != nil {
+		return fmt.Errorf("unable to get jolokia credentials secret %s: %w", t.CredentialsSecret, err)
+	}
+	for _, key := range []string{jolokiaCredentialsUsernameKey, jolokiaCredentialsPasswordKey} {
+		if len(secret.Data[key]) == 0 && secret.StringData[key] == "" {
+			return fmt.Errorf("jolokia credentials secret %s has no %s key", t.CredentialsSecret, key)
+		}
+	}
+	return nil
+}
+
+// addClusterLocalService exposes the Jolokia endpoint with a ClusterIP Service, that only exposes the Jolokia port.
+func (t *jolokiaTrait) addClusterLocalService(e *Environment) error {
+	name := e.Integration.Name + "-jolokia"
+	if e.Resources.GetService(func(s *corev1.Service) bool { return s.Name == name }) != nil {
+		return fmt.Errorf("jolokia service %s collides with another service of the integration", name)
+	}
+
+	e.Resources.Add(&corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+				// So that it's not mistaken for the integration Service
+				"camel.apache.org/service.type": v1.ServiceTypeAdditional,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "jolokia",
+					Port:       int32(t.Port),
+					Protocol:   corev1.ProtocolTCP,
+					TargetPort: intstr.FromString("jolokia"),
+				},
+			},
+			Selector: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+		},
+	})
+
 	return nil
 }
 
@@ -18,16 +18,21 @@ limitations under the License.
 package trait
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/apache/camel-k/pkg/util/test"
 )
 
 func TestConfigureJolokiaTraitInRunningPhaseDoesSucceed(t *testing.T) {
@@ -266,6 +271,142 @@ func TestAddWrongTypeOptionToJolokiaOptionsDoesNothing(t *testing.T) {
 	assert.Len(t, options, 0)
 }
 
+func TestConfigureJolokiaTraitWithCredentialsSecretAndUserShouldFail(t *testing.T) {
+	trait, environment := createNominalJolokiaTest()
+	trait.CredentialsSecret = "jolokia-credentials"
+	trait.User = pointer.String("admin")
+
+	configured, err := trait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestApplyJolokiaTraitWithCredentialsSecretShouldSucceed(t *testing.T) {
+	trait, environment := createNominalJolokiaTest()
+	trait.CredentialsSecret = "jolokia-credentials"
+	withJolokiaClient(t, trait, environment, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "jolokia-credentials",
+			Namespace: "ns",
+		},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": []byte("secret"),
+		},
+	})
+
+	err := trait.Apply(environment)
+
+	assert.Nil(t, err)
+
+	container := environment.Resources.GetContainerByName(defaultContainerName)
+	assert.NotNil(t, container)
+	assert.Equal(t, container.Args, []string{
+		"-javaagent:dependencies/lib/main/org.jolokia.jolokia-jvm-1.7.1.jar=discoveryEnabled=false,host=*," +
+			"password=$(JOLOKIA_PASSWORD),port=8778,user=$(JOLOKIA_USER)",
+	})
+	assert.Len(t, container.Env, 2)
+	assert.Equal(t, "JOLOKIA_USER", container.Env[0].Name)
+	assert.Equal(t, "jolokia-credentials", container.Env[0].ValueFrom.SecretKeyRef.Name)
+	assert.Equal(t, "username", container.Env[0].ValueFrom.SecretKeyRef.Key)
+	assert.Equal(t, "JOLOKIA_PASSWORD", container.Env[1].Name)
+	assert.Equal(t, "jolokia-credentials", container.Env[1].ValueFrom.SecretKeyRef.Name)
+	assert.Equal(t, "password", container.Env[1].ValueFrom.SecretKeyRef.Key)
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionJolokiaAvailable)
+	assert.NotNil(t, condition)
+	assert.NotContains(t, condition.Message, "authentication disabled")
+}
+
+func TestApplyJolokiaTraitWithMissingCredentialsSecretShouldFail(t *testing.T) {
+	trait, environment := createNominalJolokiaTest()
+	trait.CredentialsSecret = "jolokia-credentials"
+	withJolokiaClient(t, trait, environment)
+
+	err := trait.Apply(environment)
+
+	assert.NotNil(t, err)
+}
+
+func TestApplyJolokiaTraitWithIncompleteCredentialsSecretShouldFail(t *testing.T) {
+	trait, environment := createNominalJolokiaTest()
+	trait.CredentialsSecret = "jolokia-credentials"
+	withJolokiaClient(t, trait, environment, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "jolokia-credentials",
+			Namespace: "ns",
+		},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+		},
+	})
+
+	err := trait.Apply(environment)
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "has no password key")
+}
+
+func TestApplyJolokiaTraitWithoutAuthenticationShouldReportIt(t *testing.T) {
+	trait, environment := createNominalJolokiaTest()
+
+	err := trait.Apply(environment)
+
+	assert.Nil(t, err)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionJolokiaAvailable)
+	assert.NotNil(t, condition)
+	assert.Contains(t, condition.Message, "authentication disabled")
+}
+
+func TestApplyJolokiaTraitWithClusterLocalServiceShouldSucceed(t *testing.T) {
+	trait, environment := createNominalJolokiaTest()
+	trait.ClusterLocalService = pointer.Bool(true)
+
+	err := trait.Apply(environment)
+	assert.Nil(t, err)
+
+	service := environment.Resources.GetService(func(s *corev1.Service) bool {
+		return s.Name == "my-it-jolokia"
+	})
+	assert.NotNil(t, service)
+	assert.Equal(t, corev1.ServiceTypeClusterIP, service.Spec.Type)
+	assert.Equal(t, "my-it", service.Labels[v1.IntegrationLabel])
+	assert.Equal(t, v1.ServiceTypeAdditional, service.Labels["camel.apache.org/service.type"])
+	// The Service isn't mistaken for the integration Service
+	assert.Nil(t, environment.Resources.GetServiceForIntegration(environment.Integration))
+	assert.Equal(t, "my-it", service.Spec.Selector[v1.IntegrationLabel])
+	assert.Len(t, service.Spec.Ports, 1)
+	assert.Equal(t, "jolokia", service.Spec.Ports[0].Name)
+	assert.Equal(t, int32(8778), service.Spec.Ports[0].Port)
+	assert.Equal(t, intstr.FromString("jolokia"), service.Spec.Ports[0].TargetPort)
+}
+
+func TestApplyJolokiaTraitWithClusterLocalServiceCollisionShouldFail(t *testing.T) {
+	trait, environment := createNominalJolokiaTest()
+	trait.ClusterLocalService = pointer.Bool(true)
+	environment.Resources.Add(&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-it-jolokia",
+			Namespace: "ns",
+		},
+	})
+
+	err := trait.Apply(environment)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "jolokia service my-it-jolokia collides with another service of the integration")
+}
+
+func withJolokiaClient(t *testing.T, trait *jolokiaTrait, environment *Environment, objects ...runtime.Object) {
+	t.Helper()
+
+	client, err := test.NewFakeClient(objects...)
+	assert.Nil(t, err)
+
+	trait.Client = client
+	environment.Ctx = context.TODO()
+}
+
 func createNominalJolokiaTest() (*jolokiaTrait, *Environment) {
 	trait, _ := newJolokiaTrait().(*jolokiaTrait)
 	trait.Enabled = pointer.Bool(true)
@@ -273,6 +414,10 @@ func createNominalJolokiaTest() (*jolokiaTrait, *Environment) {
 	environment := &Environment{
 		Catalog: NewCatalog(nil),
 		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-it",
+				Namespace: "ns",
+			},
 			Status: v1.IntegrationStatus{
 				Phase: v1.IntegrationPhaseDeploying,
 			},
@@ -22,6 +22,7 @@ import (
 	"net/url"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -44,6 +45,8 @@ import (
 	"github.com/apache/camel-k/pkg/util/envvar"
 )
 
+const debugContainerPortName = "jdwp"
+
 type jvmTrait struct {
 	BaseTrait
 	traitv1.JVMTrait `property:",squash"`
@@ -152,6 +155,11 @@ func (t *jvmTrait) Apply(e *Environment) error {
 			}
 			meta.Labels["camel.apache.org/debug"] = "true"
 		})
+
+		// Knative Serving only allows the container port that serves the requests
+		if e.GetTrait(knativeServiceTraitID) == nil {
+			t.addDebugContainerPort(container)
+		}
 	}
 
 	hasHeapSizeOption := false
@@ -260,3 +268,26 @@ func (t *jvmTrait) Apply(e *Environment) error {
 func (t *jvmTrait) IsPlatformTrait() bool {
 	return true
 }
+
+// addDebugContainerPort names the port the JVM listens on for the debugger, so that it can be port-forwarded by name.
+// The port is not added when the debug address doesn't end with a port number.
+func (t *jvmTrait) addDebugContainerPort(container *corev1.Container) {
+	address := t.DebugAddress
+	if i := strings.LastIndex(address, ":"); i >= 0 {
+		address = address[i+1:]
+	}
+	port, err := strconv.ParseInt(address, 10, 32)
+	if err != nil {
+		return
+	}
+	for _, p := range container.Ports {
+		if p.Name == debugContainerPortName || p.ContainerPort == int32(port) {
+			return
+		}
+	}
+	container.Ports = append(container.Ports, corev1.ContainerPort{
+		Name:          debugContainerPortName,
+		ContainerPort: int32(port),
+		Protocol:      corev1.ProtocolTCP,
+	})
+}
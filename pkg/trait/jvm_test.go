@@ -184,6 +184,46 @@ func TestApplyJvmTraitWithDebugEnabled(t *testing.T) {
 	assert.Contains(t, d.Spec.Template.Spec.Containers[0].Args,
 		"-agentlib:jdwp=transport=dt_socket,server=y,suspend=y,address=*:5005",
 	)
+	assert.Equal(t, []corev1.ContainerPort{
+		{Name: debugContainerPortName, ContainerPort: 5005, Protocol: corev1.ProtocolTCP},
+	}, d.Spec.Template.Spec.Containers[0].Ports)
+}
+
+func TestApplyJvmTraitWithDebugEnabledOnKnativeService(t *testing.T) {
+	trait, environment := createNominalJvmTest(v1.IntegrationKitTypePlatform)
+	trait.Debug = pointer.Bool(true)
+	trait.DebugAddress = "*:8000"
+	environment.ExecutedTraits = []Trait{newKnativeServiceTrait()}
+
+	s := serving.Service{
+		Spec: serving.ServiceSpec{
+			ConfigurationSpec: serving.ConfigurationSpec{
+				Template: serving.RevisionTemplateSpec{
+					Spec: serving.RevisionSpec{
+						PodSpec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: defaultContainerName,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	environment.Resources.Add(&s)
+
+	err := trait.Apply(environment)
+
+	assert.Nil(t, err)
+
+	container := s.Spec.Template.Spec.Containers[0]
+	assert.Contains(t, container.Args,
+		"-agentlib:jdwp=transport=dt_socket,server=y,suspend=n,address=*:8000",
+	)
+	assert.Empty(t, container.Ports)
 }
 
 func TestApplyJvmTraitWithExternalKitType(t *testing.T) {
@@ -0,0 +1,124 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/utils/pointer"
+
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util/envvar"
+)
+
+const (
+	kafkaGroupIDProperty = "camel.component.kafka.group-id"
+	// kafkaGroupIDMaxLength is the maximum length of the Kafka consumer group ids, that are valid resource names.
+	kafkaGroupIDMaxLength = 249
+	// kafkaPodNameSuffixLength is the length of the random suffix of the Deployment pod names.
+	kafkaPodNameSuffixLength = len("-5d8f9c7b6d-x4k2p")
+)
+
+var kafkaGroupIDRegexp = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+type kafkaTrait struct {
+	BaseTrait
+	traitv1.KafkaTrait `property:",squash"`
+}
+
+func newKafkaTrait() Trait {
+	return &kafkaTrait{
+		// Must be applied before the container trait, that computes the application properties
+		BaseTrait: NewBaseTrait("kafka", 1500),
+	}
+}
+
+func (t *kafkaTrait) Configure(e *Environment) (bool, error) {
+	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, false) {
+		return false, nil
+	}
+
+	if t.GroupID != "" && (t.GroupIDSuffix != "" || pointer.BoolDeref(t.PerPod, false)) {
+		return false, fmt.Errorf("group-id can't be set along with the group-id-suffix or per-pod options")
+	}
+	if err := validateKafkaGroupID(t.groupID(e.Integration.Namespace, e.Integration.Name), t.maxGroupIDLength()); err != nil {
+		return false, err
+	}
+
+	if !e.IntegrationInRunningPhases() {
+		return false, nil
+	}
+	if pointer.BoolDeref(t.PerPod, false) {
+		strategy, err := e.DetermineControllerStrategy()
+		if err != nil {
+			return false, err
+		}
+		// The Knative Services don't support the pod fields in the environment variables
+		if strategy == ControllerStrategyKnativeService {
+			return false, fmt.Errorf("per-pod consumer groups aren't supported by the %s strategy", strategy)
+		}
+	}
+
+	return true, nil
+}
+
+func (t *kafkaTrait) Apply(e *Environment) error {
+	name := e.Integration.Name
+	if pointer.BoolDeref(t.PerPod, false) {
+		envvar.SetValFrom(&e.EnvVars, envVarPodName, "metadata.name")
+		// Resolved by the runtime from the pod environment
+		name = "${" + envVarPodName + "}"
+	}
+	if e.ApplicationProperties == nil {
+		e.ApplicationProperties = make(map[string]string)
+	}
+	e.ApplicationProperties[kafkaGroupIDProperty] = t.groupID(e.Integration.Namespace, name)
+
+	return nil
+}
+
+func (t *kafkaTrait) groupID(namespace string, name string) string {
+	if t.GroupID != "" {
+		return t.GroupID
+	}
+	groupID := namespace + "." + name
+	if t.GroupIDSuffix != "" {
+		groupID += "." + t.GroupIDSuffix
+	}
+	return groupID
+}
+
+func (t *kafkaTrait) maxGroupIDLength() int {
+	if pointer.BoolDeref(t.PerPod, false) {
+		// The pod names are prefixed with the integration name
+		return kafkaGroupIDMaxLength - kafkaPodNameSuffixLength
+	}
+	return kafkaGroupIDMaxLength
+}
+
+func validateKafkaGroupID(groupID string, maxLength int) error {
+	if strings.Trim(groupID, ".") == "" || !kafkaGroupIDRegexp.MatchString(groupID) {
+		return fmt.Errorf("invalid Kafka consumer group %q: it must only contain ASCII alphanumerics, '.', '_' and '-'", groupID)
+	}
+	if len(groupID) > maxLength {
+		return fmt.Errorf("invalid Kafka consumer group %q: it must not be longer than %d characters", groupID, maxLength)
+	}
+	return nil
+}
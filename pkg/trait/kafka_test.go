@@ -0,0 +1,200 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestConfigureKafkaTraitIsDisabledByDefault(t *testing.T) {
+	kafkaTrait, environment := createNominalKafkaTest()
+	kafkaTrait.Enabled = nil
+
+	configured, err := kafkaTrait.Configure(environment)
+	assert.Nil(t, err)
+	assert.False(t, configured)
+}
+
+func TestApplyKafkaTraitWithDerivedGroupID(t *testing.T) {
+	kafkaTrait, environment := createNominalKafkaTest()
+
+	configured, err := kafkaTrait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+	err = kafkaTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "ns.my-integration", environment.ApplicationProperties["camel.component.kafka.group-id"])
+	assert.Empty(t, environment.EnvVars)
+}
+
+func TestApplyKafkaTraitWithGroupIDSuffix(t *testing.T) {
+	kafkaTrait, environment := createNominalKafkaTest()
+	kafkaTrait.GroupIDSuffix = "v2"
+
+	configured, err := kafkaTrait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+	err = kafkaTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "ns.my-integration.v2", environment.ApplicationProperties["camel.component.kafka.group-id"])
+}
+
+func TestApplyKafkaTraitWithGroupID(t *testing.T) {
+	kafkaTrait, environment := createNominalKafkaTest()
+	kafkaTrait.GroupID = "orders_consumers"
+
+	configured, err := kafkaTrait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+	err = kafkaTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "orders_consumers", environment.ApplicationProperties["camel.component.kafka.group-id"])
+}
+
+func TestApplyKafkaTraitPerPod(t *testing.T) {
+	kafkaTrait, environment := createNominalKafkaTest()
+	kafkaTrait.PerPod = pointer.Bool(true)
+	kafkaTrait.GroupIDSuffix = "audit"
+
+	configured, err := kafkaTrait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+	err = kafkaTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "ns.${POD_NAME}.audit", environment.ApplicationProperties["camel.component.kafka.group-id"])
+	assert.Equal(t, []corev1.EnvVar{
+		{
+			Name: "POD_NAME",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "metadata.name",
+				},
+			},
+		},
+	}, environment.EnvVars)
+}
+
+func TestConfigureKafkaTraitWithInvalidOptionsFails(t *testing.T) {
+	testCases := []struct {
+		name      string
+		configure func(*kafkaTrait, *Environment)
+		error     string
+	}{
+		{
+			name: "invalid group id",
+			configure: func(kt *kafkaTrait, _ *Environment) {
+				kt.GroupID = "orders consumers"
+			},
+			error: `invalid Kafka consumer group "orders consumers": it must only contain ASCII alphanumerics, '.', '_' and '-'`,
+		},
+		{
+			name: "dot group id",
+			configure: func(kt *kafkaTrait, _ *Environment) {
+				kt.GroupID = ".."
+			},
+			error: `invalid Kafka consumer group "..": it must only contain ASCII alphanumerics, '.', '_' and '-'`,
+		},
+		{
+			name: "invalid group id suffix",
+			configure: func(kt *kafkaTrait, _ *Environment) {
+				kt.GroupIDSuffix = "v2/blue"
+			},
+			error: `invalid Kafka consumer group "ns.my-integration.v2/blue": it must only contain ASCII alphanumerics, '.', '_' and '-'`,
+		},
+		{
+			name: "too long per-pod group id",
+			configure: func(kt *kafkaTrait, _ *Environment) {
+				kt.PerPod = pointer.Bool(true)
+				kt.GroupIDSuffix = strings.Repeat("x", 220)
+			},
+			error: `invalid Kafka consumer group "ns.my-integration.` + strings.Repeat("x", 220) + `": it must not be longer than 232 characters`,
+		},
+		{
+			name: "group id along with suffix",
+			configure: func(kt *kafkaTrait, _ *Environment) {
+				kt.GroupID = "orders"
+				kt.GroupIDSuffix = "v2"
+			},
+			error: "group-id can't be set along with the group-id-suffix or per-pod options",
+		},
+		{
+			name: "per-pod with Knative Service",
+			configure: func(kt *kafkaTrait, e *Environment) {
+				kt.PerPod = pointer.Bool(true)
+				knativeServiceTrait, _ := newKnativeServiceTrait().(*knativeServiceTrait)
+				knativeServiceTrait.Enabled = pointer.Bool(true)
+				e.ConfiguredTraits = append(e.ConfiguredTraits, knativeServiceTrait)
+			},
+			error: "per-pod consumer groups aren't supported by the knative-service strategy",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kafkaTrait, environment := createNominalKafkaTest()
+			tc.configure(kafkaTrait, environment)
+
+			configured, err := kafkaTrait.Configure(environment)
+			assert.False(t, configured)
+			assert.EqualError(t, err, tc.error)
+		})
+	}
+}
+
+func createNominalKafkaTest() (*kafkaTrait, *Environment) {
+	trait, _ := newKafkaTrait().(*kafkaTrait)
+	trait.Enabled = pointer.Bool(true)
+
+	environment := &Environment{
+		Catalog: NewCatalog(nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-integration",
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Platform: &v1.IntegrationPlatform{
+			Spec: v1.IntegrationPlatformSpec{
+				Cluster: v1.IntegrationPlatformClusterKubernetes,
+				Profile: v1.TraitProfileKubernetes,
+			},
+		},
+		Resources:             kubernetes.NewCollection(),
+		ApplicationProperties: make(map[string]string),
+	}
+	environment.Platform.ResyncStatusFullConfig()
+
+	return trait, environment
+}
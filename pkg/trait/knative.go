@@ -53,6 +53,7 @@ type knativeTrait struct {
 
 const (
 	knativeHistoryHeader = "ce-knativehistory"
+	defaultBrokerName    = "default"
 )
 
 func newKnativeTrait() Trait {
@@ -356,7 +357,11 @@ func (t *knativeTrait) configureEvents(e *Environment, env *knativeapi.CamelEnvi
 				serviceName = "default"
 			}
 			servicePath := fmt.Sprintf("/events/%s", eventType)
-			t.createTrigger(e, ref, eventType, servicePath)
+			filters, err := knativeutil.ExtractEventFilters(serviceURI)
+			if err != nil {
+				return err
+			}
+			t.createTrigger(e, ref, eventType, filters, servicePath)
 
 			if !env.ContainsService(serviceName, knativeapi.CamelEndpointKindSource, knativeapi.CamelServiceTypeEvent, ref.APIVersion, ref.Kind) {
 				svc := knativeapi.CamelServiceDefinition{
@@ -393,6 +398,13 @@ func (t *knativeTrait) configureEvents(e *Environment, env *knativeapi.CamelEnvi
 				if err != nil {
 					return err
 				}
+				if knativeutil.ExtractBrokerName(serviceURI) == "" {
+					// The runtime looks the sink up by the Broker name of the URI, that defaults to "default"
+					svc.Name = defaultBrokerName
+					if env.ContainsService(svc.Name, knativeapi.CamelEndpointKindSink, knativeapi.CamelServiceTypeEvent, ref.APIVersion, ref.Kind) {
+						return nil
+					}
+				}
 				env.Services = append(env.Services, svc)
 				return nil
 			})
@@ -496,18 +508,17 @@ func (t *knativeTrait) configureSinkBinding(e *Environment, env *knativeapi.Came
 	return err
 }
 
-func (t *knativeTrait) createTrigger(e *Environment, ref *corev1.ObjectReference, eventType string, path string) {
-	// TODO extend to additional filters too, to filter them at source and not at destination
-	found := e.Resources.HasKnativeTrigger(func(trigger *eventing.Trigger) bool {
-		return trigger.Spec.Broker == ref.Name &&
-			trigger.Spec.Filter != nil &&
-			trigger.Spec.Filter.Attributes["type"] == eventType // can be also missing
+func (t *knativeTrait) createTrigger(e *Environment, ref *corev1.ObjectReference, eventType string, filters map[string]string, path string) {
+	if ref.Namespace == "" {
+		ref.Namespace = e.Integration.Namespace
+	}
+	trigger := knativeutil.CreateTrigger(*ref, e.Integration.Name, eventType, filters, path)
+	found := e.Resources.HasKnativeTrigger(func(existing *eventing.Trigger) bool {
+		return existing.Spec.Broker == trigger.Spec.Broker &&
+			existing.Spec.Filter != nil &&
+			reflect.DeepEqual(existing.Spec.Filter.Attributes, trigger.Spec.Filter.Attributes)
 	})
 	if !found {
-		if ref.Namespace == "" {
-			ref.Namespace = e.Integration.Namespace
-		}
-		trigger := knativeutil.CreateTrigger(*ref, e.Integration.Name, eventType, path)
 		e.Resources.Add(trigger)
 	}
 }
@@ -536,6 +547,9 @@ func (t *knativeTrait) withServiceDo(
 		if err != nil {
 			return err
 		}
+		if serviceType == knativeapi.CamelServiceTypeEvent && t.Broker != "" && knativeutil.ExtractBrokerName(serviceURI) == "" {
+			ref.Name = t.Broker
+		}
 		if skipDuplicates && env.ContainsService(ref.Name, endpointKind, serviceType, ref.APIVersion, ref.Kind) {
 			continue
 		}
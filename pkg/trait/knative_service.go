@@ -23,6 +23,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
 
 	serving "knative.dev/serving/pkg/apis/serving/v1"
@@ -47,6 +48,9 @@ const (
 	knativeServingRolloutDurationAnnotation = "serving.knative.dev/rolloutDuration"
 	// visibility label.
 	knativeServingVisibilityLabel = "networking.knative.dev/visibility"
+
+	// Knative default revision timeout, that's also the termination grace period of the pods.
+	knativeServingDefaultTimeoutSeconds = 300
 )
 
 type knativeServiceTrait struct {
@@ -118,6 +122,13 @@ func (t *knativeServiceTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
+	if err := t.validateTimeouts(); err != nil {
+		return false, err
+	}
+	if err := t.validateEnvVars(e); err != nil {
+		return false, err
+	}
+
 	if pointer.BoolDeref(t.Auto, true) {
 		// Check the right value for minScale, as not all services are allowed to scale down to 0
 		if t.MinScale == nil {
@@ -151,6 +162,46 @@ func (t *knativeServiceTrait) Configure(e *Environment) (bool, error) {
 	return true, nil
 }
 
+func (t *knativeServiceTrait) validateTimeouts() error {
+	if t.TimeoutSeconds != nil && *t.TimeoutSeconds <= 0 {
+		return fmt.Errorf("invalid timeout %d: it must be a positive number of seconds", *t.TimeoutSeconds)
+	}
+	if t.DrainTimeout == nil {
+		return nil
+	}
+	if *t.DrainTimeout <= 0 {
+		return fmt.Errorf("invalid drain timeout %d: it must be a positive number of seconds", *t.DrainTimeout)
+	}
+	gracePeriod := int64(knativeServingDefaultTimeoutSeconds)
+	if t.TimeoutSeconds != nil {
+		gracePeriod = *t.TimeoutSeconds
+	}
+	if *t.DrainTimeout >= gracePeriod {
+		return fmt.Errorf("drain timeout (%ds) must be lower than the termination grace period (%ds)", *t.DrainTimeout, gracePeriod)
+	}
+	return nil
+}
+
+// validateEnvVars checks the environment trait variables aren't sourced from the pod fields or the container resources,
+// as Knative Serving doesn't support them by default, so that they would be skipped by the container trait.
+func (t *knativeServiceTrait) validateEnvVars(e *Environment) error {
+	et, ok := e.GetTrait("environment").(*environmentTrait)
+	if !ok {
+		return nil
+	}
+	for _, v := range et.Vars {
+		env, err := parseEnvVar(v)
+		if err != nil {
+			return err
+		}
+		if env.ValueFrom != nil && (env.ValueFrom.FieldRef != nil || env.ValueFrom.ResourceFieldRef != nil) {
+			return fmt.Errorf("unsupported environment variable %q: the Knative service can't source environment "+
+				"variables from the pod fields or the container resources", v)
+		}
+	}
+	return nil
+}
+
 func (t *knativeServiceTrait) Apply(e *Environment) error {
 	ksvc, err := t.getServiceFor(e)
 	if err != nil {
@@ -158,6 +209,17 @@ func (t *knativeServiceTrait) Apply(e *Environment) error {
 	}
 	e.Resources.Add(ksvc)
 
+	if t.DrainTimeout != nil {
+		// The Knative queue-proxy drains the requests routed to the pod before the container is
+		// sent the SIGTERM signal, so that the runtime must complete the in-flight requests within
+		// the remaining termination grace period.
+		e.setShutdownTimeout(*t.DrainTimeout)
+	}
+	if t.PreStopPath != "" {
+		// The integration container is only created by the container trait
+		e.PostProcessors = append(e.PostProcessors, t.addPreStopHook)
+	}
+
 	e.Integration.Status.SetCondition(
 		v1.IntegrationConditionKnativeServiceAvailable,
 		corev1.ConditionTrue,
@@ -168,6 +230,30 @@ func (t *knativeServiceTrait) Apply(e *Environment) error {
 	return nil
 }
 
+func (t *knativeServiceTrait) addPreStopHook(e *Environment) error {
+	container := e.GetIntegrationContainer()
+	if container == nil {
+		return fmt.Errorf("unable to find integration container: %s", e.Integration.Name)
+	}
+
+	port := intstr.FromInt(defaultContainerPort)
+	if containerPort := e.getIntegrationContainerPort(); containerPort != nil {
+		port = intstr.FromString(containerPort.Name)
+	}
+
+	if container.Lifecycle == nil {
+		container.Lifecycle = &corev1.Lifecycle{}
+	}
+	container.Lifecycle.PreStop = &corev1.LifecycleHandler{
+		HTTPGet: &corev1.HTTPGetAction{
+			Path: t.PreStopPath,
+			Port: port,
+		},
+	}
+
+	return nil
+}
+
 func (t *knativeServiceTrait) SelectControllerStrategy(e *Environment) (*ControllerStrategy, error) {
 	knativeServiceStrategy := ControllerStrategyKnativeService
 	if t.Enabled != nil {
@@ -269,6 +355,7 @@ func (t *knativeServiceTrait) getServiceFor(e *Environment) (*serving.Service, e
 						PodSpec: corev1.PodSpec{
 							ServiceAccountName: e.Integration.Spec.ServiceAccountName,
 						},
+						TimeoutSeconds: t.TimeoutSeconds,
 					},
 				},
 			},
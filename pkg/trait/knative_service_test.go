@@ -26,6 +26,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
 
 	serving "knative.dev/serving/pkg/apis/serving/v1"
@@ -432,3 +433,77 @@ func createKnativeServiceTestEnvironment(t *testing.T, trait *traitv1.KnativeSer
 
 	return environment
 }
+
+func TestKnativeServiceWithDrainTimeout(t *testing.T) {
+	environment := createKnativeServiceTestEnvironment(t, &traitv1.KnativeServiceTrait{
+		TimeoutSeconds: pointer.Int64(120),
+		DrainTimeout:   pointer.Int64(30),
+	})
+	assert.NotEmpty(t, environment.ExecutedTraits)
+	assert.NotNil(t, environment.GetTrait("knative-service"))
+
+	ksvc := environment.Resources.GetKnativeService(func(service *serving.Service) bool {
+		return service.Name == KnativeServiceTestName
+	})
+	assert.NotNil(t, ksvc)
+
+	assert.Equal(t, pointer.Int64(120), ksvc.Spec.Template.Spec.TimeoutSeconds)
+	assert.Equal(t, "30s", environment.ApplicationProperties["quarkus.shutdown.timeout"])
+	assert.Equal(t, "30", environment.ApplicationProperties["camel.main.shutdown-timeout"])
+}
+
+func TestKnativeServiceWithPreStopPath(t *testing.T) {
+	environment := createKnativeServiceTestEnvironment(t, &traitv1.KnativeServiceTrait{
+		DrainTimeout: pointer.Int64(30),
+		PreStopPath:  "/shutdown",
+	})
+
+	ksvc := environment.Resources.GetKnativeService(func(service *serving.Service) bool {
+		return service.Name == KnativeServiceTestName
+	})
+	assert.NotNil(t, ksvc)
+
+	container := ksvc.Spec.Template.Spec.Containers[0]
+	assert.NotNil(t, container.Lifecycle)
+	assert.Equal(t, &corev1.HTTPGetAction{
+		Path: "/shutdown",
+		Port: intstr.FromInt(defaultContainerPort),
+	}, container.Lifecycle.PreStop.HTTPGet)
+}
+
+func TestKnativeServiceWithInvalidTimeouts(t *testing.T) {
+	trait, _ := newKnativeServiceTrait().(*knativeServiceTrait)
+	trait.DrainTimeout = pointer.Int64(300)
+	assert.Error(t, trait.validateTimeouts())
+
+	trait.TimeoutSeconds = pointer.Int64(60)
+	trait.DrainTimeout = pointer.Int64(45)
+	assert.NoError(t, trait.validateTimeouts())
+
+	trait.DrainTimeout = pointer.Int64(0)
+	assert.Error(t, trait.validateTimeouts())
+
+	trait.DrainTimeout = nil
+	trait.TimeoutSeconds = pointer.Int64(0)
+	assert.Error(t, trait.validateTimeouts())
+
+	trait.TimeoutSeconds = pointer.Int64(-1)
+	assert.Error(t, trait.validateTimeouts())
+}
+
+func TestKnativeServiceWithEnvVarsFromPod(t *testing.T) {
+	trait, _ := newKnativeServiceTrait().(*knativeServiceTrait)
+	envTrait, _ := newEnvironmentTrait().(*environmentTrait)
+	environment := &Environment{
+		ExecutedTraits: []Trait{envTrait},
+	}
+
+	envTrait.Vars = []string{"MY_VAR=value", "CM_VAR=configmap:my-cm/my-key"}
+	assert.NoError(t, trait.validateEnvVars(environment))
+
+	envTrait.Vars = []string{"POD_IP=fieldRef:status.podIP"}
+	assert.Error(t, trait.validateEnvVars(environment))
+
+	envTrait.Vars = []string{"MEMORY_LIMIT=resourceFieldRef:limits.memory"}
+	assert.Error(t, trait.validateEnvVars(environment))
+}
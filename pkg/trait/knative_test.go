@@ -149,6 +149,117 @@ func TestKnativeEnvConfigurationFromTrait(t *testing.T) {
 	assert.Equal(t, "http://broker-default.host/", eEventSink.URL)
 }
 
+func TestKnativeTriggerFiltersAndBroker(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	environment := Environment{
+		CamelCatalog: catalog,
+		Catalog:      NewCatalog(nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+			Spec: v1.IntegrationSpec{
+				Profile: v1.TraitProfileKnative,
+				Traits: v1.Traits{
+					Knative: &traitv1.KnativeTrait{
+						Trait: traitv1.Trait{
+							Enabled: pointer.Bool(true),
+						},
+						Auto:   pointer.Bool(false),
+						Broker: "my-broker",
+						EventSources: []string{
+							"knative:event/evt.type?filter.source=source-1",
+							"knative:event/evt.type?filter.source=source-2&filter.myextension=value",
+							"knative:event/evt.type?name=default",
+						},
+						EventSinks: []string{"knative:event/out.type", "knative:event/other.type"},
+					},
+				},
+			},
+		},
+		IntegrationKit: &v1.IntegrationKit{
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		},
+		Platform: &v1.IntegrationPlatform{
+			Spec: v1.IntegrationPlatformSpec{
+				Cluster: v1.IntegrationPlatformClusterOpenShift,
+				Profile: v1.TraitProfileKnative,
+			},
+		},
+		EnvVars:        make([]corev1.EnvVar, 0),
+		ExecutedTraits: make([]Trait, 0),
+		Resources:      k8sutils.NewCollection(),
+	}
+	environment.Platform.ResyncStatusFullConfig()
+
+	c, err := NewFakeClient("ns")
+	assert.Nil(t, err)
+
+	tc := NewCatalog(c)
+	err = tc.Configure(&environment)
+	assert.Nil(t, err)
+
+	tr, _ := tc.GetTrait("knative").(*knativeTrait)
+	ok, err := tr.Configure(&environment)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	err = tr.Apply(&environment)
+	assert.Nil(t, err)
+
+	triggers := make(map[string]*eventing.Trigger)
+	environment.Resources.VisitKnativeTrigger(func(trigger *eventing.Trigger) {
+		triggers[trigger.Name] = trigger
+	})
+	assert.Len(t, triggers, 3)
+
+	var filtered []eventing.TriggerFilterAttributes
+	for name, trigger := range triggers {
+		if trigger.Spec.Broker == "default" {
+			assert.Equal(t, "default-test-evttype", name)
+			assert.Equal(t, eventing.TriggerFilterAttributes{"type": "evt.type"}, trigger.Spec.Filter.Attributes)
+			continue
+		}
+		assert.Equal(t, "my-broker", trigger.Spec.Broker)
+		assert.Regexp(t, "^my-broker-test-evttype-[0-9a-f]{8}$", name)
+		assert.Equal(t, "/events/evt.type", trigger.Spec.Subscriber.URI.Path)
+		filtered = append(filtered, trigger.Spec.Filter.Attributes)
+	}
+	assert.ElementsMatch(t, []eventing.TriggerFilterAttributes{
+		{"type": "evt.type", "source": "source-1"},
+		{"type": "evt.type", "source": "source-2", "myextension": "value"},
+	}, filtered)
+
+	kc := envvar.Get(environment.EnvVars, "CAMEL_KNATIVE_CONFIGURATION")
+	assert.NotNil(t, kc)
+	ne := knativeapi.NewCamelEnvironment()
+	err = ne.Deserialize(kc.Value)
+	assert.Nil(t, err)
+
+	source := ne.FindService("evt.type", knativeapi.CamelEndpointKindSource, knativeapi.CamelServiceTypeEvent, "eventing.knative.dev/v1", "Broker")
+	assert.NotNil(t, source)
+	assert.Equal(t, "my-broker", source.Metadata[knativeapi.CamelMetaKnativeName])
+
+	sinks := 0
+	for _, svc := range ne.Services {
+		if svc.Metadata[knativeapi.CamelMetaEndpointKind] == string(knativeapi.CamelEndpointKindSink) {
+			sinks++
+			assert.Equal(t, "default", svc.Name)
+			assert.Equal(t, "http://broker-my-broker.host/", svc.URL)
+			assert.Equal(t, "my-broker", svc.Metadata[knativeapi.CamelMetaKnativeName])
+		}
+	}
+	assert.Equal(t, 1, sinks)
+}
+
 func TestKnativeEnvConfigurationFromSource(t *testing.T) {
 	catalog, err := camel.DefaultCatalog()
 	assert.Nil(t, err)
@@ -434,6 +545,10 @@ func NewFakeClient(namespace string) (client.Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	myBrokerURL, err := apis.ParseURL("http://broker-my-broker.host/")
+	if err != nil {
+		return nil, err
+	}
 
 	return test.NewFakeClient(
 		&messaging.Channel{
@@ -524,6 +639,22 @@ func NewFakeClient(namespace string) (client.Client, error) {
 				},
 			},
 		},
+		&eventing.Broker{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: eventing.SchemeGroupVersion.String(),
+				Kind:       "Broker",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "my-broker",
+			},
+			Spec: eventing.BrokerSpec{},
+			Status: eventing.BrokerStatus{
+				Address: duckv1.Addressable{
+					URL: myBrokerURL,
+				},
+			},
+		},
 		&eventing.Trigger{
 			TypeMeta: metav1.TypeMeta{
 				APIVersion: eventing.SchemeGroupVersion.String(),
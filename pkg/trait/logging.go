@@ -18,9 +18,13 @@ limitations under the License.
 package trait
 
 import (
+	"fmt"
+	"strings"
+
 	"k8s.io/utils/pointer"
 
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util"
 	"github.com/apache/camel-k/pkg/util/envvar"
 )
 
@@ -33,6 +37,8 @@ const (
 	defaultLogLevel                        = "INFO"
 )
 
+var logCategoryLevels = []string{"OFF", "FATAL", "ERROR", "WARN", "INFO", "DEBUG", "TRACE", "ALL"}
+
 type loggingTrait struct {
 	BaseTrait
 	traitv1.LoggingTrait `property:",squash"`
@@ -52,6 +58,10 @@ func (l loggingTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
+	if _, err := l.parseCategories(); err != nil {
+		return false, err
+	}
+
 	return e.IntegrationInRunningPhases(), nil
 }
 
@@ -76,5 +86,31 @@ func (l loggingTrait) Apply(e *Environment) error {
 		}
 	}
 
+	categories, err := l.parseCategories()
+	if err != nil {
+		return err
+	}
+	for category, level := range categories {
+		// The category is quoted, as it contains dots
+		e.ApplicationProperties[fmt.Sprintf("quarkus.log.category.\"%s\".level", category)] = level
+	}
+
 	return nil
 }
+
+func (l loggingTrait) parseCategories() (map[string]string, error) {
+	categories := make(map[string]string, len(l.Categories))
+	for _, c := range l.Categories {
+		kv := strings.SplitN(c, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid logging category %q, expected <category>=<level>", c)
+		}
+		level := strings.ToUpper(kv[1])
+		if !util.StringSliceExists(logCategoryLevels, level) {
+			return nil, fmt.Errorf("unsupported logging level %q for the %s category: it must be one of %s",
+				kv[1], kv[0], strings.Join(logCategoryLevels, ", "))
+		}
+		categories[kv[0]] = level
+	}
+	return categories, nil
+}
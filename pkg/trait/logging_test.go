@@ -209,3 +209,42 @@ func TestJsonLoggingTrait(t *testing.T) {
 	assert.True(t, logFormatIsNotDefault)
 	assert.NotEmpty(t, env.ExecutedTraits)
 }
+
+func TestLoggingTraitWithCategories(t *testing.T) {
+	env := createDefaultLoggingTestEnv(t)
+	env.ApplicationProperties = make(map[string]string)
+
+	trait, _ := newLoggingTraitTrait().(*loggingTrait)
+	trait.Categories = []string{"org.apache.camel.component.kafka=debug", "io.quarkus=WARN"}
+
+	configured, err := trait.Configure(env)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+
+	err = trait.Apply(env)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]string{
+		`quarkus.log.category."org.apache.camel.component.kafka".level`: "DEBUG",
+		`quarkus.log.category."io.quarkus".level`:                       "WARN",
+	}, env.ApplicationProperties)
+}
+
+func TestLoggingTraitWithInvalidCategories(t *testing.T) {
+	env := createDefaultLoggingTestEnv(t)
+
+	trait, _ := newLoggingTraitTrait().(*loggingTrait)
+	trait.Categories = []string{"org.apache.camel"}
+
+	configured, err := trait.Configure(env)
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `invalid logging category "org.apache.camel"`)
+
+	trait.Categories = []string{"org.apache.camel=VERBOSE"}
+
+	configured, err = trait.Configure(env)
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `unsupported logging level "VERBOSE" for the org.apache.camel category`)
+}
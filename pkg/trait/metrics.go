@@ -0,0 +1,105 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+const (
+	namespaceLabel   = "namespace"
+	integrationLabel = "integration"
+	stageLabel       = "stage"
+
+	// traitsStage is the trait customization stage, that determines the integration resources.
+	traitsStage = "Traits"
+	// deployStage is the stage that executes the post actions, that deploy the integration resources.
+	deployStage = "Deploy"
+)
+
+var (
+	integrationReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "camel_k_integration_reconcile_duration_seconds",
+			Help: "Camel K integration trait customization and deployment duration",
+			Buckets: []float64{
+				0.1 * time.Second.Seconds(),
+				0.25 * time.Second.Seconds(),
+				0.5 * time.Second.Seconds(),
+				1 * time.Second.Seconds(),
+				5 * time.Second.Seconds(),
+			},
+		},
+		[]string{
+			namespaceLabel,
+			integrationLabel,
+			stageLabel,
+		},
+	)
+
+	integrationReconcileErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "camel_k_integration_reconcile_errors_total",
+			Help: "Camel K integration trait customization and deployment errors",
+		},
+		[]string{
+			namespaceLabel,
+			integrationLabel,
+			stageLabel,
+		},
+	)
+)
+
+func init() {
+	// Register custom metrics with the global prometheus registry
+	metrics.Registry.MustRegister(integrationReconcileDuration, integrationReconcileErrors)
+}
+
+// observeIntegrationStage records the duration of the given stage for the integration, and whether it has errored.
+// It's a no-op for the integration kits.
+func observeIntegrationStage(integration *v1.Integration, stage string, begin time.Time, err error) {
+	if integration == nil {
+		return
+	}
+	labels := prometheus.Labels{
+		namespaceLabel:   integration.Namespace,
+		integrationLabel: integration.Name,
+		stageLabel:       stage,
+	}
+	integrationReconcileDuration.With(labels).Observe(time.Since(begin).Seconds())
+	if err != nil {
+		integrationReconcileErrors.With(labels).Inc()
+	}
+}
+
+// DeleteIntegrationMetrics deletes the metrics of the given integration, so that
+// the metrics cardinality is bounded by the number of existing integrations.
+func DeleteIntegrationMetrics(namespace string, name string) {
+	labels := prometheus.Labels{
+		namespaceLabel:   namespace,
+		integrationLabel: name,
+	}
+	integrationReconcileDuration.DeletePartialMatch(labels)
+	integrationReconcileErrors.DeletePartialMatch(labels)
+}
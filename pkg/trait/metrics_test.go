@@ -0,0 +1,56 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func TestObserveIntegrationStage(t *testing.T) {
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "metrics-integration",
+			Namespace: "ns",
+		},
+	}
+
+	durations := testutil.CollectAndCount(integrationReconcileDuration)
+	errs := testutil.CollectAndCount(integrationReconcileErrors)
+
+	observeIntegrationStage(integration, traitsStage, time.Now(), nil)
+	observeIntegrationStage(integration, deployStage, time.Now(), errors.New("deployment failure"))
+	observeIntegrationStage(nil, traitsStage, time.Now(), errors.New("kit failure"))
+
+	assert.Equal(t, durations+2, testutil.CollectAndCount(integrationReconcileDuration))
+	assert.Equal(t, errs+1, testutil.CollectAndCount(integrationReconcileErrors))
+	assert.Equal(t, 1.0, testutil.ToFloat64(integrationReconcileErrors.WithLabelValues("ns", "metrics-integration", deployStage)))
+
+	DeleteIntegrationMetrics("ns", "metrics-integration")
+
+	assert.Equal(t, durations, testutil.CollectAndCount(integrationReconcileDuration))
+	assert.Equal(t, errs, testutil.CollectAndCount(integrationReconcileErrors))
+}
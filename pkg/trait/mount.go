@@ -18,13 +18,20 @@ limitations under the License.
 package trait
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 
+	"github.com/pkg/errors"
+
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/pointer"
 
@@ -36,6 +43,13 @@ import (
 	utilResource "github.com/apache/camel-k/pkg/util/resource"
 )
 
+const (
+	sharedMemoryVolumeName = "dshm"
+	sharedMemoryMountPath  = "/dev/shm"
+
+	mountsChecksumAnnotation = "camel.apache.org/mounts.checksum"
+)
+
 type mountTrait struct {
 	BaseTrait
 	traitv1.MountTrait `property:",squash"`
@@ -69,10 +83,40 @@ func (t *mountTrait) Configure(e *Environment) (bool, error) {
 			return false, fmt.Errorf("unsupported resource %s, must be a configmap or secret resource", r)
 		}
 	}
+	if err := t.validateSharedMemorySize(e); err != nil {
+		return false, err
+	}
 
 	return true, nil
 }
 
+// validateSharedMemorySize checks the shared memory size is a positive quantity, that's lower than the container memory limit,
+// and that no other volume is mounted at the shared memory path.
+func (t *mountTrait) validateSharedMemorySize(e *Environment) error {
+	if t.SharedMemorySize == "" {
+		return nil
+	}
+	size, err := resource.ParseQuantity(t.SharedMemorySize)
+	if err != nil {
+		return fmt.Errorf("invalid shared memory size %q: %w", t.SharedMemorySize, err)
+	}
+	if size.Sign() <= 0 {
+		return fmt.Errorf("invalid shared memory size %s: it must be positive", t.SharedMemorySize)
+	}
+	if ct, ok := e.Catalog.GetTrait(containerTraitID).(*containerTrait); ok && ct.LimitMemory != "" {
+		if limit, err := resource.ParseQuantity(ct.LimitMemory); err == nil && size.Cmp(limit) >= 0 {
+			return fmt.Errorf("shared memory size %s must be lower than the container memory limit %s, as the shared memory usage counts against it",
+				t.SharedMemorySize, ct.LimitMemory)
+		}
+	}
+	for _, v := range t.Volumes {
+		if vol, err := utilResource.ParseVolume(v); err == nil && filepath.Clean(vol.DestinationPath()) == sharedMemoryMountPath {
+			return fmt.Errorf("shared memory size can't be set together with volume %s, that's mounted at %s", v, sharedMemoryMountPath)
+		}
+	}
+	return nil
+}
+
 func (t *mountTrait) Apply(e *Environment) error {
 	if e.IntegrationInPhase(v1.IntegrationPhaseInitialization) {
 		return nil
@@ -113,6 +157,15 @@ func (t *mountTrait) Apply(e *Environment) error {
 		return err
 	}
 
+	// StatefulSet
+	if err := e.Resources.VisitStatefulSetE(func(statefulSet *appsv1.StatefulSet) error {
+		volumes = &statefulSet.Spec.Template.Spec.Volumes
+		visited = true
+		return nil
+	}); err != nil {
+		return err
+	}
+
 	if visited {
 		// Volumes declared in the Integration resources
 		e.configureVolumesAndMounts(volumes, &container.VolumeMounts)
@@ -121,11 +174,109 @@ func (t *mountTrait) Apply(e *Environment) error {
 		if err != nil {
 			return err
 		}
+		if t.SharedMemorySize != "" {
+			t.mountSharedMemory(volumes, &container.VolumeMounts)
+		}
+		if pointer.BoolDeref(t.HotReload, false) {
+			checksum, err := t.computeMountsChecksum(e)
+			if err != nil {
+				return err
+			}
+			if checksum != "" {
+				// Changing the pod template annotation rolls out the integration
+				e.Resources.VisitPodTemplateMeta(func(meta *metav1.ObjectMeta) {
+					if meta.Annotations == nil {
+						meta.Annotations = make(map[string]string)
+					}
+					meta.Annotations[mountsChecksumAnnotation] = checksum
+				})
+			}
+		}
 	}
 
 	return nil
 }
 
+// computeMountsChecksum returns the checksum of the content of the ConfigMaps and Secrets of the configs and resources,
+// or an empty string if there's none.
+func (t *mountTrait) computeMountsChecksum(e *Environment) (string, error) {
+	mounts := make([]*utilResource.Config, 0, len(t.Configs)+len(t.Resources))
+	for _, c := range t.Configs {
+		conf, err := utilResource.ParseConfig(c)
+		if err != nil {
+			return "", err
+		}
+		mounts = append(mounts, conf)
+	}
+	for _, r := range t.Resources {
+		res, err := utilResource.ParseResource(r)
+		if err != nil {
+			return "", err
+		}
+		mounts = append(mounts, res)
+	}
+	if len(mounts) == 0 {
+		return "", nil
+	}
+	// The checksum must not depend on the mounts order
+	sort.SliceStable(mounts, func(i, j int) bool {
+		return mounts[i].String() < mounts[j].String()
+	})
+
+	hash := sha256.New()
+	for _, m := range mounts {
+		data, err := t.getMountData(e, m)
+		if err != nil {
+			return "", err
+		}
+		keys := make([]string, 0, len(data))
+		for k := range data {
+			if m.Key() == "" || m.Key() == k {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		if _, err := hash.Write([]byte(m.String())); err != nil {
+			return "", err
+		}
+		for _, k := range keys {
+			for _, d := range [][]byte{[]byte(k), data[k]} {
+				if _, err := hash.Write(d); err != nil {
+					return "", err
+				}
+			}
+		}
+	}
+
+	return base64.RawURLEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (t *mountTrait) getMountData(e *Environment, m *utilResource.Config) (map[string][]byte, error) {
+	data := make(map[string][]byte)
+	switch m.StorageType() {
+	case utilResource.StorageTypeConfigmap:
+		cm, err := kubernetes.GetConfigMap(e.Ctx, e.Client, m.Name(), e.Integration.Namespace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get ConfigMap %s", m.Name())
+		}
+		for k, v := range cm.Data {
+			data[k] = []byte(v)
+		}
+		for k, v := range cm.BinaryData {
+			data[k] = v
+		}
+	case utilResource.StorageTypeSecret:
+		secret, err := kubernetes.GetSecret(e.Ctx, e.Client, m.Name(), e.Integration.Namespace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get Secret %s", m.Name())
+		}
+		for k, v := range secret.Data {
+			data[k] = v
+		}
+	}
+	return data, nil
+}
+
 func (t *mountTrait) configureVolumesAndMounts(e *Environment, vols *[]corev1.Volume, mnts *[]corev1.VolumeMount) error {
 	for _, c := range t.Configs {
 		if conf, parseErr := utilResource.ParseConfig(c); parseErr == nil {
@@ -191,6 +342,24 @@ func (t *mountTrait) mountResource(vols *[]corev1.Volume, mnts *[]corev1.VolumeM
 	*mnts = append(*mnts, *mnt)
 }
 
+// mountSharedMemory mounts a memory backed volume at /dev/shm, that's sized to the shared memory size.
+func (t *mountTrait) mountSharedMemory(vols *[]corev1.Volume, mnts *[]corev1.VolumeMount) {
+	size := resource.MustParse(t.SharedMemorySize)
+	*vols = append(*vols, corev1.Volume{
+		Name: sharedMemoryVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{
+				Medium:    corev1.StorageMediumMemory,
+				SizeLimit: &size,
+			},
+		},
+	})
+	*mnts = append(*mnts, corev1.VolumeMount{
+		Name:      sharedMemoryVolumeName,
+		MountPath: sharedMemoryMountPath,
+	})
+}
+
 // IsPlatformTrait overrides base class method.
 func (t *mountTrait) IsPlatformTrait() bool {
 	return true
@@ -26,7 +26,9 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
@@ -125,6 +127,143 @@ func TestMountVolumesIntegrationPhaseInitialization(t *testing.T) {
 	assert.Nil(t, s)
 }
 
+func TestMountSharedMemory(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Spec.Traits.Mount.SharedMemorySize = "1Gi"
+	environment.Platform.ResyncStatusFullConfig()
+
+	err := traitCatalog.apply(environment)
+
+	require.NoError(t, err)
+	assert.NotNil(t, environment.GetTrait("mount"))
+
+	d := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool {
+		return deployment.Name == "hello"
+	})
+	assert.NotNil(t, d)
+	spec := d.Spec.Template.Spec
+
+	size := resource.MustParse("1Gi")
+	assert.Contains(t, spec.Volumes, corev1.Volume{
+		Name: "dshm",
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{
+				Medium:    corev1.StorageMediumMemory,
+				SizeLimit: &size,
+			},
+		},
+	})
+	assert.Contains(t, spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      "dshm",
+		MountPath: "/dev/shm",
+	})
+}
+
+func TestMountInvalidSharedMemory(t *testing.T) {
+	testCases := []struct {
+		name        string
+		size        string
+		limitMemory string
+		volumes     []string
+		err         string
+	}{
+		{
+			name: "invalid quantity",
+			size: "1Gb",
+			err:  `invalid shared memory size "1Gb"`,
+		},
+		{
+			name: "zero",
+			size: "0",
+			err:  "invalid shared memory size 0: it must be positive",
+		},
+		{
+			name:        "container memory limit",
+			size:        "1Gi",
+			limitMemory: "512Mi",
+			err:         "shared memory size 1Gi must be lower than the container memory limit 512Mi",
+		},
+		{
+			name:    "volume collision",
+			size:    "1Gi",
+			volumes: []string{"my-pvc:/dev/shm"},
+			err:     "shared memory size can't be set together with volume my-pvc:/dev/shm",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			traitCatalog := NewCatalog(nil)
+
+			environment := getNominalEnv(t, traitCatalog)
+			environment.Integration.Spec.Traits.Mount.SharedMemorySize = tc.size
+			environment.Integration.Spec.Traits.Mount.Volumes = append(environment.Integration.Spec.Traits.Mount.Volumes, tc.volumes...)
+			if tc.limitMemory != "" {
+				environment.Integration.Spec.Traits.Container = &traitv1.ContainerTrait{
+					LimitMemory: tc.limitMemory,
+				}
+			}
+			environment.Platform.ResyncStatusFullConfig()
+
+			err := traitCatalog.apply(environment)
+
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestMountHotReload(t *testing.T) {
+	checksum := func(cmData string) string {
+		traitCatalog := NewCatalog(nil)
+
+		environment := getNominalEnv(t, traitCatalog)
+		environment.Integration.Spec.Traits.Mount.HotReload = pointer.Bool(true)
+		client, err := test.NewFakeClient(
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cm", Namespace: "default"},
+				Data:       map[string]string{"my-key": cmData},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "default"},
+				Data:       map[string][]byte{"my-key": []byte("my-secret-value")},
+			},
+		)
+		require.NoError(t, err)
+		environment.Client = client
+		environment.Platform.ResyncStatusFullConfig()
+
+		err = traitCatalog.apply(environment)
+		require.NoError(t, err)
+
+		d := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool {
+			return deployment.Name == "hello"
+		})
+		require.NotNil(t, d)
+		return d.Spec.Template.Annotations[mountsChecksumAnnotation]
+	}
+
+	first := checksum("my-value")
+	assert.NotEmpty(t, first)
+	assert.Equal(t, first, checksum("my-value"))
+	assert.NotEqual(t, first, checksum("my-new-value"))
+}
+
+func TestMountHotReloadMissingConfigMap(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Spec.Traits.Mount.HotReload = pointer.Bool(true)
+	environment.Platform.ResyncStatusFullConfig()
+
+	err := traitCatalog.apply(environment)
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unable to get ConfigMap my-cm")
+}
+
 func getNominalEnv(t *testing.T, traitCatalog *Catalog) *Environment {
 	t.Helper()
 	fakeClient, _ := test.NewFakeClient()
@@ -0,0 +1,205 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/metadata"
+	"github.com/apache/camel-k/pkg/platform"
+	"github.com/apache/camel-k/pkg/util"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+const (
+	networkPolicyTraitID = "network-policy"
+
+	// knativeQueueProxyPort is the port of the Knative Serving queue-proxy sidecar, the requests are routed to.
+	knativeQueueProxyPort = 8012
+	// knativeQueueProxyH2CPort is the port of the Knative Serving queue-proxy sidecar, the HTTP/2 requests are routed to.
+	knativeQueueProxyH2CPort = 8013
+)
+
+type networkPolicyTrait struct {
+	BaseTrait
+	traitv1.NetworkPolicyTrait `property:",squash"`
+
+	exposesHTTPServices bool
+	podSelectors        []*metav1.LabelSelector
+}
+
+func newNetworkPolicyTrait() Trait {
+	return &networkPolicyTrait{
+		BaseTrait: NewBaseTrait(networkPolicyTraitID, 2350),
+	}
+}
+
+func (t *networkPolicyTrait) Configure(e *Environment) (bool, error) {
+	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, false) {
+		return false, nil
+	}
+
+	if !e.IntegrationInRunningPhases() {
+		return false, nil
+	}
+
+	t.podSelectors = make([]*metav1.LabelSelector, 0, len(t.AllowedPodSelectors))
+	for _, s := range t.AllowedPodSelectors {
+		selector, err := metav1.ParseToLabelSelector(s)
+		if err != nil {
+			return false, fmt.Errorf("invalid allowed pod selector %q: %w", s, err)
+		}
+		t.podSelectors = append(t.podSelectors, selector)
+	}
+
+	if pointer.BoolDeref(t.Auto, true) {
+		sources, err := kubernetes.ResolveIntegrationSources(e.Ctx, t.Client, e.Integration, e.Resources)
+		if err != nil {
+			return false, err
+		}
+		meta, err := metadata.ExtractAll(e.CamelCatalog, sources)
+		if err != nil {
+			return false, err
+		}
+		t.exposesHTTPServices = meta.ExposesHTTPServices
+	}
+
+	return true, nil
+}
+
+func (t *networkPolicyTrait) Apply(e *Environment) error {
+	networkPolicy := &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "NetworkPolicy",
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					v1.IntegrationLabel: e.Integration.Name,
+				},
+			},
+			// Only the Ingress policy type is set, so that the egress traffic isn't restricted
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			// An empty list of rules denies all the ingress traffic
+			Ingress: make([]networkingv1.NetworkPolicyIngressRule, 0),
+		},
+	}
+
+	if ports := t.getAllowedPorts(e); len(ports) > 0 {
+		networkPolicy.Spec.Ingress = append(networkPolicy.Spec.Ingress, networkingv1.NetworkPolicyIngressRule{
+			Ports: ports,
+		})
+	}
+
+	peers := make([]networkingv1.NetworkPolicyPeer, 0, len(t.AllowedNamespaces)+len(t.podSelectors)+1)
+	// The traffic from the operator is allowed
+	namespaces := make([]string, 0, len(t.AllowedNamespaces)+1)
+	if operatorNamespace := platform.GetOperatorNamespace(); operatorNamespace != "" {
+		namespaces = append(namespaces, operatorNamespace)
+	}
+	for _, namespace := range t.AllowedNamespaces {
+		util.StringSliceUniqueAdd(&namespaces, namespace)
+	}
+	for _, namespace := range namespaces {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					corev1.LabelMetadataName: namespace,
+				},
+			},
+		})
+	}
+	for _, selector := range t.podSelectors {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{
+			PodSelector: selector,
+		})
+	}
+	if len(peers) > 0 {
+		networkPolicy.Spec.Ingress = append(networkPolicy.Spec.Ingress, networkingv1.NetworkPolicyIngressRule{
+			From: peers,
+		})
+	}
+
+	e.Resources.Add(networkPolicy)
+
+	return nil
+}
+
+// getAllowedPorts returns the ports the ingress traffic is allowed to from any source, i.e., the HTTP port
+// when the integration exposes HTTP endpoints, the integration container ports declared by the other traits,
+// e.g., the Jolokia or Prometheus traits, and the Knative queue-proxy ports.
+func (t *networkPolicyTrait) getAllowedPorts(e *Environment) []networkingv1.NetworkPolicyPort {
+	ports := make([]networkingv1.NetworkPolicyPort, 0)
+	add := func(port int, protocol corev1.Protocol) {
+		if protocol == "" {
+			protocol = corev1.ProtocolTCP
+		}
+		for _, p := range ports {
+			if p.Port.IntValue() == port && *p.Protocol == protocol {
+				return
+			}
+		}
+		portValue := intstr.FromInt(port)
+		ports = append(ports, networkingv1.NetworkPolicyPort{
+			Protocol: &protocol,
+			Port:     &portValue,
+		})
+	}
+
+	if t.exposesHTTPServices {
+		add(t.httpPort(e), corev1.ProtocolTCP)
+	}
+	if container := e.GetIntegrationContainer(); container != nil {
+		for _, port := range container.Ports {
+			// The debug port is meant to be port-forwarded, that isn't subject to the network policies
+			if port.Name != debugContainerPortName {
+				add(int(port.ContainerPort), port.Protocol)
+			}
+		}
+	}
+	if e.GetTrait(knativeServiceTraitID) != nil {
+		add(knativeQueueProxyPort, corev1.ProtocolTCP)
+		add(knativeQueueProxyH2CPort, corev1.ProtocolTCP)
+	}
+
+	return ports
+}
+
+// httpPort returns the container port the integration HTTP endpoints are served on.
+func (t *networkPolicyTrait) httpPort(e *Environment) int {
+	if ct, ok := e.GetTrait(containerTraitID).(*containerTrait); ok && ct.Port != 0 {
+		return ct.Port
+	}
+	return defaultContainerPort
+}
@@ -0,0 +1,231 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/gzip"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestNetworkPolicyDisabledByDefault(t *testing.T) {
+	trait, environment := createNetworkPolicyTest(t, `from("timer:tick").log("hello")`)
+	trait.Enabled = nil
+
+	configured, err := trait.Configure(environment)
+	assert.Nil(t, err)
+	assert.False(t, configured)
+}
+
+func TestNetworkPolicyDeniesIngressByDefault(t *testing.T) {
+	trait, environment := createNetworkPolicyTest(t, `from("timer:tick").log("hello")`)
+
+	networkPolicy := networkPolicyCreatedCheck(t, trait, environment)
+	assert.Equal(t, map[string]string{v1.IntegrationLabel: "test"}, networkPolicy.Spec.PodSelector.MatchLabels)
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, networkPolicy.Spec.PolicyTypes)
+	assert.NotNil(t, networkPolicy.Spec.Ingress)
+	assert.Empty(t, networkPolicy.Spec.Ingress)
+}
+
+func TestNetworkPolicyAllowsHTTPPort(t *testing.T) {
+	trait, environment := createNetworkPolicyTest(t, `from("netty-http:test").log("hello")`)
+
+	networkPolicy := networkPolicyCreatedCheck(t, trait, environment)
+	assert.Len(t, networkPolicy.Spec.Ingress, 1)
+	assert.Empty(t, networkPolicy.Spec.Ingress[0].From)
+	assert.Len(t, networkPolicy.Spec.Ingress[0].Ports, 1)
+	assert.Equal(t, int32(defaultContainerPort), networkPolicy.Spec.Ingress[0].Ports[0].Port.IntVal)
+	assert.Equal(t, corev1.ProtocolTCP, *networkPolicy.Spec.Ingress[0].Ports[0].Protocol)
+}
+
+func TestNetworkPolicyWithoutAuto(t *testing.T) {
+	trait, environment := createNetworkPolicyTest(t, `from("netty-http:test").log("hello")`)
+	trait.Auto = pointer.Bool(false)
+
+	networkPolicy := networkPolicyCreatedCheck(t, trait, environment)
+	assert.Empty(t, networkPolicy.Spec.Ingress)
+}
+
+func TestNetworkPolicyAllowsNamespacesAndPods(t *testing.T) {
+	trait, environment := createNetworkPolicyTest(t, `from("timer:tick").log("hello")`)
+	trait.AllowedNamespaces = []string{"monitoring"}
+	trait.AllowedPodSelectors = []string{"app=frontend", "tier in (web,api)"}
+
+	networkPolicy := networkPolicyCreatedCheck(t, trait, environment)
+	assert.Len(t, networkPolicy.Spec.Ingress, 1)
+	assert.Empty(t, networkPolicy.Spec.Ingress[0].Ports)
+
+	from := networkPolicy.Spec.Ingress[0].From
+	assert.Len(t, from, 3)
+	assert.Equal(t, map[string]string{corev1.LabelMetadataName: "monitoring"}, from[0].NamespaceSelector.MatchLabels)
+	assert.Nil(t, from[0].PodSelector)
+	assert.Equal(t, map[string]string{"app": "frontend"}, from[1].PodSelector.MatchLabels)
+	assert.Nil(t, from[1].NamespaceSelector)
+	assert.Equal(t, []metav1.LabelSelectorRequirement{
+		{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"api", "web"}},
+	}, from[2].PodSelector.MatchExpressions)
+}
+
+func TestNetworkPolicyAllowsContainerPorts(t *testing.T) {
+	trait, environment := createNetworkPolicyTest(t, `from("netty-http:test").log("hello")`)
+	environment.Resources.Add(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: defaultContainerName,
+							Ports: []corev1.ContainerPort{
+								{Name: defaultContainerPortName, ContainerPort: int32(defaultContainerPort), Protocol: corev1.ProtocolTCP},
+								{Name: "jolokia", ContainerPort: 8778, Protocol: corev1.ProtocolTCP},
+								{Name: "syslog", ContainerPort: 514, Protocol: corev1.ProtocolUDP},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	networkPolicy := networkPolicyCreatedCheck(t, trait, environment)
+	assert.Len(t, networkPolicy.Spec.Ingress, 1)
+	ports := networkPolicy.Spec.Ingress[0].Ports
+	assert.Len(t, ports, 3)
+	assert.Equal(t, int32(defaultContainerPort), ports[0].Port.IntVal)
+	assert.Equal(t, int32(8778), ports[1].Port.IntVal)
+	assert.Equal(t, corev1.ProtocolTCP, *ports[1].Protocol)
+	assert.Equal(t, int32(514), ports[2].Port.IntVal)
+	assert.Equal(t, corev1.ProtocolUDP, *ports[2].Protocol)
+}
+
+func TestNetworkPolicyAllowsKnativeQueueProxy(t *testing.T) {
+	trait, environment := createNetworkPolicyTest(t, `from("timer:tick").log("hello")`)
+	environment.ExecutedTraits = append(environment.ExecutedTraits, newKnativeServiceTrait())
+
+	networkPolicy := networkPolicyCreatedCheck(t, trait, environment)
+	assert.Len(t, networkPolicy.Spec.Ingress, 1)
+	ports := networkPolicy.Spec.Ingress[0].Ports
+	assert.Len(t, ports, 2)
+	assert.Equal(t, int32(knativeQueueProxyPort), ports[0].Port.IntVal)
+	assert.Equal(t, int32(knativeQueueProxyH2CPort), ports[1].Port.IntVal)
+}
+
+func TestNetworkPolicyAllowsOperatorNamespace(t *testing.T) {
+	t.Setenv("NAMESPACE", "camel-k")
+	trait, environment := createNetworkPolicyTest(t, `from("timer:tick").log("hello")`)
+	trait.AllowedNamespaces = []string{"camel-k", "monitoring"}
+
+	networkPolicy := networkPolicyCreatedCheck(t, trait, environment)
+	assert.Len(t, networkPolicy.Spec.Ingress, 1)
+	from := networkPolicy.Spec.Ingress[0].From
+	assert.Len(t, from, 2)
+	assert.Equal(t, map[string]string{corev1.LabelMetadataName: "camel-k"}, from[0].NamespaceSelector.MatchLabels)
+	assert.Equal(t, map[string]string{corev1.LabelMetadataName: "monitoring"}, from[1].NamespaceSelector.MatchLabels)
+}
+
+func TestNetworkPolicyWithInvalidPodSelector(t *testing.T) {
+	trait, environment := createNetworkPolicyTest(t, `from("timer:tick").log("hello")`)
+	trait.AllowedPodSelectors = []string{"app in frontend"}
+
+	configured, err := trait.Configure(environment)
+	assert.NotNil(t, err)
+	assert.False(t, configured)
+}
+
+func networkPolicyCreatedCheck(t *testing.T, trait *networkPolicyTrait, environment *Environment) *networkingv1.NetworkPolicy {
+	t.Helper()
+
+	configured, err := trait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+
+	err = trait.Apply(environment)
+	assert.Nil(t, err)
+
+	networkPolicy := findNetworkPolicy(environment.Resources)
+	assert.NotNil(t, networkPolicy)
+	assert.Equal(t, "test", networkPolicy.Name)
+	assert.Equal(t, "ns", networkPolicy.Namespace)
+	return networkPolicy
+}
+
+func findNetworkPolicy(resources *kubernetes.Collection) *networkingv1.NetworkPolicy {
+	for _, r := range resources.Items() {
+		if networkPolicy, ok := r.(*networkingv1.NetworkPolicy); ok {
+			return networkPolicy
+		}
+	}
+	return nil
+}
+
+func createNetworkPolicyTest(t *testing.T, route string) (*networkPolicyTrait, *Environment) {
+	t.Helper()
+
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	compressedRoute, err := gzip.CompressBase64([]byte(route))
+	assert.Nil(t, err)
+
+	trait, _ := newNetworkPolicyTrait().(*networkPolicyTrait)
+	trait.Enabled = pointer.Bool(true)
+
+	environment := &Environment{
+		CamelCatalog: catalog,
+		Catalog:      NewCatalog(nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+			Spec: v1.IntegrationSpec{
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name:        "routes.js",
+							Content:     string(compressedRoute),
+							Compression: true,
+						},
+						Language: v1.LanguageJavaScript,
+					},
+				},
+			},
+		},
+		Resources: kubernetes.NewCollection(),
+	}
+
+	return trait, environment
+}
@@ -18,8 +18,13 @@ limitations under the License.
 package trait
 
 import (
+	"fmt"
+	"path"
+	"strings"
+
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/pointer"
 
 	serving "knative.dev/serving/pkg/apis/serving/v1"
@@ -28,6 +33,19 @@ import (
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
 )
 
+// The Kubernetes recommended labels.
+const (
+	recommendedNameLabel      = "app.kubernetes.io/name"
+	recommendedInstanceLabel  = "app.kubernetes.io/instance"
+	recommendedVersionLabel   = "app.kubernetes.io/version"
+	recommendedComponentLabel = "app.kubernetes.io/component"
+	recommendedPartOfLabel    = "app.kubernetes.io/part-of"
+	recommendedManagedByLabel = "app.kubernetes.io/managed-by"
+
+	defaultRecommendedComponent = "integration"
+	recommendedManagedBy        = "camel-k"
+)
+
 type ownerTrait struct {
 	BaseTrait
 	traitv1.OwnerTrait `property:",squash"`
@@ -44,6 +62,27 @@ func (t *ownerTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
+	for label, value := range map[string]string{
+		"part-of":   t.PartOf,
+		"component": t.Component,
+		"version":   t.Version,
+	} {
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return false, fmt.Errorf("invalid %s recommended label value %q: %s", label, value, strings.Join(errs, ", "))
+		}
+	}
+
+	for kind, patterns := range map[string][]string{
+		"label":      t.TargetLabels,
+		"annotation": t.TargetAnnotations,
+	} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return false, fmt.Errorf("invalid target %s pattern %q: %w", kind, pattern, err)
+			}
+		}
+	}
+
 	return e.IntegrationInPhase(v1.IntegrationPhaseInitialization) || e.IntegrationInRunningPhases(), nil
 }
 
@@ -51,23 +90,8 @@ func (t *ownerTrait) Apply(e *Environment) error {
 	controller := true
 	blockOwnerDeletion := true
 
-	targetLabels := make(map[string]string)
-	if e.Integration.Labels != nil {
-		for _, k := range t.TargetLabels {
-			if v, ok := e.Integration.Labels[k]; ok {
-				targetLabels[k] = v
-			}
-		}
-	}
-
-	targetAnnotations := make(map[string]string)
-	if e.Integration.Annotations != nil {
-		for _, k := range t.TargetAnnotations {
-			if v, ok := e.Integration.Annotations[k]; ok {
-				targetAnnotations[k] = v
-			}
-		}
-	}
+	targetLabels := matchingEntries(e.Integration.Labels, t.TargetLabels)
+	targetAnnotations := matchingEntries(e.Integration.Annotations, t.TargetAnnotations)
 
 	e.Resources.VisitMetaObject(func(res metav1.Object) {
 		// Cross-namespace references are forbidden and also asynchronously refused
@@ -95,13 +119,80 @@ func (t *ownerTrait) Apply(e *Environment) error {
 		t.propagateLabelAndAnnotations(&deployment.Spec.Template, targetLabels, targetAnnotations)
 	})
 
+	e.Resources.VisitStatefulSet(func(statefulSet *appsv1.StatefulSet) {
+		t.propagateLabelAndAnnotations(&statefulSet.Spec.Template, targetLabels, targetAnnotations)
+	})
+
 	e.Resources.VisitKnativeService(func(service *serving.Service) {
 		t.propagateLabelAndAnnotations(&service.Spec.ConfigurationSpec.Template, targetLabels, targetAnnotations)
 	})
 
+	if pointer.BoolDeref(t.RecommendedLabels, false) {
+		// Some resources are only created by post-processors, e.g., the additional Services
+		e.PostProcessors = append(e.PostProcessors, t.addRecommendedLabels)
+	}
+
 	return nil
 }
 
+// recommendedLabels returns the Kubernetes recommended labels of the integration resources.
+func (t *ownerTrait) recommendedLabels(e *Environment) map[string]string {
+	labels := map[string]string{
+		recommendedNameLabel:      e.Integration.Name,
+		recommendedInstanceLabel:  e.Integration.Name,
+		recommendedManagedByLabel: recommendedManagedBy,
+	}
+	for label, value := range map[string]string{
+		recommendedPartOfLabel:    t.PartOf,
+		recommendedComponentLabel: t.Component,
+		recommendedVersionLabel:   t.Version,
+	} {
+		if value == "" {
+			value = e.Integration.Labels[label]
+		}
+		if value != "" {
+			labels[label] = value
+		}
+	}
+	if _, ok := labels[recommendedPartOfLabel]; !ok {
+		labels[recommendedPartOfLabel] = e.Integration.Name
+	}
+	if _, ok := labels[recommendedComponentLabel]; !ok {
+		labels[recommendedComponentLabel] = defaultRecommendedComponent
+	}
+
+	return labels
+}
+
+func (t *ownerTrait) addRecommendedLabels(e *Environment) error {
+	labels := t.recommendedLabels(e)
+	noAnnotations := make(map[string]string)
+
+	e.Resources.VisitMetaObject(func(res metav1.Object) {
+		t.propagateLabelAndAnnotations(res, labels, noAnnotations)
+	})
+	e.Resources.VisitPodTemplateMeta(func(meta *metav1.ObjectMeta) {
+		t.propagateLabelAndAnnotations(meta, labels, noAnnotations)
+	})
+
+	return nil
+}
+
+// matchingEntries returns the entries whose key matches any of the glob patterns.
+func matchingEntries(entries map[string]string, patterns []string) map[string]string {
+	matching := make(map[string]string)
+	for k, v := range entries {
+		for _, pattern := range patterns {
+			// The patterns are validated when the trait is configured
+			if ok, _ := path.Match(pattern, k); ok {
+				matching[k] = v
+				break
+			}
+		}
+	}
+	return matching
+}
+
 // IsPlatformTrait overrides base class method.
 func (t *ownerTrait) IsPlatformTrait() bool {
 	return true
@@ -24,6 +24,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
@@ -40,6 +41,99 @@ func TestOwner(t *testing.T) {
 	ValidateOwnerResources(t, env, true)
 }
 
+func TestOwnerWithRecommendedLabels(t *testing.T) {
+	env := SetUpOwnerEnvironment(t)
+	env.Integration.Spec.Traits.Owner.RecommendedLabels = pointer.Bool(true)
+	env.Integration.Spec.Traits.Owner.PartOf = "shop"
+	env.Integration.Labels["app.kubernetes.io/version"] = "1.2.0"
+	env.Integration.Labels["app.kubernetes.io/component"] = "ignored"
+	env.Integration.Spec.Traits.Owner.Component = "orders"
+
+	processTestEnv(t, env)
+
+	expected := map[string]string{
+		"app.kubernetes.io/name":       "test",
+		"app.kubernetes.io/instance":   "test",
+		"app.kubernetes.io/managed-by": "camel-k",
+		"app.kubernetes.io/part-of":    "shop",
+		"app.kubernetes.io/component":  "orders",
+		"app.kubernetes.io/version":    "1.2.0",
+	}
+
+	assert.NotEmpty(t, env.Resources.Items())
+	env.Resources.VisitMetaObject(func(res metav1.Object) {
+		for k, v := range expected {
+			assert.Equal(t, v, res.GetLabels()[k], "%s label of %s", k, res.GetName())
+		}
+		assert.Equal(t, "test", res.GetLabels()[v1.IntegrationLabel])
+	})
+	env.Resources.VisitPodTemplateMeta(func(meta *metav1.ObjectMeta) {
+		for k, v := range expected {
+			assert.Equal(t, v, meta.Labels[k])
+		}
+	})
+}
+
+func TestOwnerWithRecommendedLabelsDefaults(t *testing.T) {
+	env := SetUpOwnerEnvironment(t)
+	env.Integration.Spec.Traits.Owner.RecommendedLabels = pointer.Bool(true)
+
+	processTestEnv(t, env)
+
+	deployment := env.Resources.GetDeploymentForIntegration(env.Integration)
+	assert.NotNil(t, deployment)
+	assert.Equal(t, "test", deployment.Labels["app.kubernetes.io/part-of"])
+	assert.Equal(t, "integration", deployment.Labels["app.kubernetes.io/component"])
+	assert.NotContains(t, deployment.Labels, "app.kubernetes.io/version")
+	// The recommended labels must not leak into the immutable selector
+	assert.NotContains(t, deployment.Spec.Selector.MatchLabels, "app.kubernetes.io/name")
+}
+
+func TestOwnerWithInvalidRecommendedLabelValue(t *testing.T) {
+	trait, _ := newOwnerTrait().(*ownerTrait)
+	trait.RecommendedLabels = pointer.Bool(true)
+	trait.Version = "not a valid value"
+
+	env := SetUpOwnerEnvironment(t)
+	configured, err := trait.Configure(env)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestOwnerWithPatterns(t *testing.T) {
+	env := SetUpOwnerEnvironment(t)
+	env.Integration.Spec.Traits.Owner.TargetLabels = []string{"com.mycompany/*", "cost-*"}
+	env.Integration.Spec.Traits.Owner.TargetAnnotations = []string{"com.mycompany/my?nnotation1"}
+	env.Integration.Labels["cost-center"] = "sales"
+	env.Integration.Labels["example.com/cost-center"] = "marketing"
+
+	processTestEnv(t, env)
+
+	deployment := env.Resources.GetDeploymentForIntegration(env.Integration)
+	assert.NotNil(t, deployment)
+	for _, meta := range []metav1.Object{deployment, &deployment.Spec.Template} {
+		assert.Equal(t, "myvalue1", meta.GetLabels()["com.mycompany/mylabel1"])
+		assert.Equal(t, "myvalue2", meta.GetLabels()["com.mycompany/mylabel2"])
+		assert.Equal(t, "sales", meta.GetLabels()["cost-center"])
+		assert.NotContains(t, meta.GetLabels(), "example.com/cost-center")
+		assert.NotContains(t, meta.GetLabels(), "org.apache.camel/l1")
+		assert.Equal(t, "myannotation1", meta.GetAnnotations()["com.mycompany/myannotation1"])
+	}
+}
+
+func TestOwnerWithInvalidPattern(t *testing.T) {
+	trait, _ := newOwnerTrait().(*ownerTrait)
+	trait.TargetLabels = []string{"com.mycompany/[mylabel"}
+
+	env := SetUpOwnerEnvironment(t)
+	configured, err := trait.Configure(env)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `invalid target label pattern "com.mycompany/[mylabel"`)
+}
+
 func SetUpOwnerEnvironment(t *testing.T) *Environment {
 	t.Helper()
 
@@ -19,18 +19,31 @@ package trait
 
 import (
 	"fmt"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/pointer"
 
 	serving "knative.dev/serving/pkg/apis/serving/v1"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util"
+)
+
+// sidecarStartupGateTimeoutSeconds is the time a sidecar container is granted to become ready,
+// before its startup gate fails, and the container is restarted.
+const sidecarStartupGateTimeoutSeconds = 300
+
+// sidecarStartupGateScript waits for the command given as arguments to succeed.
+var sidecarStartupGateScript = fmt.Sprintf(
+	`i=0; until "$@"; do i=$((i+1)); if [ "$i" -ge %d ]; then exit 1; fi; sleep 1; done`,
+	sidecarStartupGateTimeoutSeconds,
 )
 
 type podTrait struct {
@@ -49,14 +62,47 @@ func (t *podTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
-	if e.Integration.Spec.PodTemplate == nil {
+	if e.Integration.Spec.PodTemplate == nil && t.PriorityClassName == "" && t.SchedulerName == "" {
 		return false, nil
 	}
 
+	for kind, name := range map[string]string{
+		"priority class": t.PriorityClassName,
+		"scheduler":      t.SchedulerName,
+	} {
+		if name == "" {
+			continue
+		}
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			return false, fmt.Errorf("invalid %s name %q: %s", kind, name, strings.Join(errs, ", "))
+		}
+	}
+
 	return e.IntegrationInRunningPhases(), nil
 }
 
 func (t *podTrait) Apply(e *Environment) error {
+	if e.Integration.Spec.PodTemplate != nil {
+		if err := t.applyPodTemplate(e); err != nil {
+			return err
+		}
+	}
+
+	if t.PriorityClassName != "" || t.SchedulerName != "" {
+		e.Resources.VisitPodSpec(func(p *corev1.PodSpec) {
+			if t.PriorityClassName != "" {
+				p.PriorityClassName = t.PriorityClassName
+			}
+			if t.SchedulerName != "" {
+				p.SchedulerName = t.SchedulerName
+			}
+		})
+	}
+
+	return nil
+}
+
+func (t *podTrait) applyPodTemplate(e *Environment) error {
 	changes := e.Integration.Spec.PodTemplate.Spec
 	var patchedPodSpec *corev1.PodSpec
 	strategy, err := e.DetermineControllerStrategy()
@@ -69,6 +115,7 @@ func (t *podTrait) Apply(e *Environment) error {
 			if c.Name == e.Integration.Name {
 				if patchedPodSpec, err = t.applyChangesTo(&c.Spec.JobTemplate.Spec.Template.Spec, changes); err == nil {
 					c.Spec.JobTemplate.Spec.Template.Spec = *patchedPodSpec
+					err = t.orderSidecarContainers(e, &c.Spec.JobTemplate.Spec.Template.Spec)
 				}
 			}
 		})
@@ -78,6 +125,7 @@ func (t *podTrait) Apply(e *Environment) error {
 			if d.Name == e.Integration.Name {
 				if patchedPodSpec, err = t.applyChangesTo(&d.Spec.Template.Spec, changes); err == nil {
 					d.Spec.Template.Spec = *patchedPodSpec
+					err = t.orderSidecarContainers(e, &d.Spec.Template.Spec)
 				}
 			}
 		})
@@ -87,6 +135,17 @@ func (t *podTrait) Apply(e *Environment) error {
 			if s.Name == e.Integration.Name {
 				if patchedPodSpec, err = t.applyChangesTo(&s.Spec.Template.Spec.PodSpec, changes); err == nil {
 					s.Spec.Template.Spec.PodSpec = *patchedPodSpec
+					err = t.orderSidecarContainers(e, &s.Spec.Template.Spec.PodSpec)
+				}
+			}
+		})
+
+	case ControllerStrategyStatefulSet:
+		e.Resources.VisitStatefulSet(func(s *appsv1.StatefulSet) {
+			if s.Name == e.Integration.Name {
+				if patchedPodSpec, err = t.applyChangesTo(&s.Spec.Template.Spec, changes); err == nil {
+					s.Spec.Template.Spec = *patchedPodSpec
+					err = t.orderSidecarContainers(e, &s.Spec.Template.Spec)
 				}
 			}
 		})
@@ -119,3 +178,95 @@ func (t *podTrait) applyChangesTo(podSpec *corev1.PodSpec, changes v1.PodSpec) (
 
 	return patchedPodSpec, err
 }
+
+// orderSidecarContainers moves the sidecar containers before the integration container, and gates their startup,
+// as the kubelet starts the containers sequentially, and waits for the post-start hook of a container to complete,
+// before starting the next one.
+func (t *podTrait) orderSidecarContainers(e *Environment, podSpec *corev1.PodSpec) error {
+	if len(t.SidecarContainers) == 0 {
+		return nil
+	}
+
+	integrationContainerName := e.GetIntegrationContainerName()
+	sidecars := make([]corev1.Container, 0, len(t.SidecarContainers))
+	for _, name := range t.SidecarContainers {
+		if name == integrationContainerName {
+			return fmt.Errorf("integration container %s can't be a sidecar container", name)
+		}
+		var sidecar *corev1.Container
+		for i := range podSpec.Containers {
+			if podSpec.Containers[i].Name == name {
+				sidecar = &podSpec.Containers[i]
+				break
+			}
+		}
+		if sidecar == nil {
+			for _, c := range podSpec.InitContainers {
+				if c.Name == name {
+					return fmt.Errorf("sidecar container %s must not be an init container", name)
+				}
+			}
+			return fmt.Errorf("sidecar container %s not found in the pod template", name)
+		}
+		for _, c := range sidecars {
+			if c.Name == name {
+				return fmt.Errorf("sidecar container %s is declared more than once", name)
+			}
+		}
+		if err := gateSidecarContainer(sidecar); err != nil {
+			return err
+		}
+		sidecars = append(sidecars, *sidecar)
+	}
+
+	// The sidecar containers first, in the declared order, then the other containers, in their original order
+	containers := append(make([]corev1.Container, 0, len(podSpec.Containers)), sidecars...)
+	for _, c := range podSpec.Containers {
+		if !util.StringSliceExists(t.SidecarContainers, c.Name) {
+			containers = append(containers, c)
+		}
+	}
+	podSpec.Containers = containers
+
+	return nil
+}
+
+// gateSidecarContainer adds a post-start hook to the sidecar container, that waits for its readiness probe to succeed.
+// An exec readiness probe is polled by a shell script, that's run in the sidecar container, while an HTTP readiness
+// probe is performed by the kubelet, that restarts the sidecar container, with a back-off delay, until it succeeds.
+func gateSidecarContainer(sidecar *corev1.Container) error {
+	if sidecar.Lifecycle != nil && sidecar.Lifecycle.PostStart != nil {
+		// The container provides its own startup gate
+		return nil
+	}
+
+	var gate *corev1.LifecycleHandler
+	if probe := sidecar.ReadinessProbe; probe != nil {
+		switch {
+		case probe.Exec != nil && len(probe.Exec.Command) > 0:
+			gate = &corev1.LifecycleHandler{
+				Exec: &corev1.ExecAction{
+					Command: append([]string{"/bin/sh", "-c", sidecarStartupGateScript, "sh"}, probe.Exec.Command...),
+				},
+			}
+		case probe.HTTPGet != nil:
+			gate = &corev1.LifecycleHandler{
+				HTTPGet: probe.HTTPGet.DeepCopy(),
+			}
+		case probe.TCPSocket != nil, probe.GRPC != nil:
+			return fmt.Errorf("sidecar container %s readiness probe can't gate the integration container startup, "+
+				"as post-start hooks only support exec and HTTP actions: declare a post-start hook instead", sidecar.Name)
+		}
+	}
+	if gate == nil {
+		return fmt.Errorf("sidecar container %s must declare either an exec or HTTP readiness probe, or a post-start hook, "+
+			"to gate the integration container startup", sidecar.Name)
+	}
+
+	if sidecar.Lifecycle == nil {
+		sidecar.Lifecycle = &corev1.Lifecycle{}
+	}
+	sidecar.Lifecycle.PostStart = gate
+
+	return nil
+}
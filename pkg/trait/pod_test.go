@@ -27,6 +27,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
@@ -101,6 +102,121 @@ func TestSupplementalGroup(t *testing.T) {
 	assert.Contains(t, templateSpec.Spec.SecurityContext.SupplementalGroups, int64(666))
 }
 
+func TestHostAliases(t *testing.T) {
+	templateString := `{containers: [], hostAliases: [{ip: "10.0.0.1", hostnames: ["broker.internal"]}]}`
+
+	templateSpec := testPodTemplateSpec(t, templateString)
+
+	assert.Equal(t, []corev1.HostAlias{
+		{
+			IP:        "10.0.0.1",
+			Hostnames: []string{"broker.internal"},
+		},
+	}, templateSpec.Spec.HostAliases)
+}
+
+func TestSidecarContainers(t *testing.T) {
+	templateString := `containers:
+  - name: proxy
+    readinessProbe:
+      exec:
+        command: [cat, /tmp/ready]
+  - name: gated
+    lifecycle:
+      postStart:
+        exec:
+          command: [pilot-agent, wait]
+  - name: agent
+    readinessProbe:
+      httpGet:
+        path: /ready
+        port: 15021`
+
+	trait, environment, _ := createPodTest(templateString)
+	trait.SidecarContainers = []string{"gated", "proxy", "agent"}
+
+	err := trait.Apply(environment)
+	assert.Nil(t, err)
+
+	deployment := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool {
+		return deployment.Name == "pod-template-test-integration"
+	})
+	containers := deployment.Spec.Template.Spec.Containers
+	assert.Len(t, containers, 5)
+	assert.Equal(t, "gated", containers[0].Name)
+	assert.Equal(t, "proxy", containers[1].Name)
+	assert.Equal(t, "agent", containers[2].Name)
+	assert.Equal(t, "integration", containers[3].Name)
+	assert.Equal(t, "second", containers[4].Name)
+
+	assert.Equal(t, []string{"pilot-agent", "wait"}, containers[0].Lifecycle.PostStart.Exec.Command)
+	gate := containers[1].Lifecycle.PostStart.Exec.Command
+	assert.Equal(t, []string{"/bin/sh", "-c", sidecarStartupGateScript, "sh", "cat", "/tmp/ready"}, gate)
+	assert.Equal(t, &corev1.HTTPGetAction{Path: "/ready", Port: intstr.FromInt(15021)}, containers[2].Lifecycle.PostStart.HTTPGet)
+}
+
+func TestInvalidSidecarContainers(t *testing.T) {
+	templateString := `containers:
+  - name: proxy
+  - name: probed
+    readinessProbe:
+      exec:
+        command: [cat, /tmp/ready]
+  - name: tcp
+    readinessProbe:
+      tcpSocket:
+        port: 5432
+initContainers:
+  - name: migration`
+
+	testCases := []struct {
+		sidecars []string
+		err      string
+	}{
+		{[]string{"unknown"}, "sidecar container unknown not found in the pod template"},
+		{[]string{"integration"}, "integration container integration can't be a sidecar container"},
+		{[]string{"migration"}, "sidecar container migration must not be an init container"},
+		{[]string{"probed", "probed"}, "sidecar container probed is declared more than once"},
+		{[]string{"proxy"}, "sidecar container proxy must declare either an exec or HTTP readiness probe, or a post-start hook, to gate the integration container startup"},
+		{[]string{"tcp"}, "sidecar container tcp readiness probe can't gate the integration container startup, as post-start hooks only support exec and HTTP actions: declare a post-start hook instead"},
+	}
+
+	for _, tc := range testCases {
+		trait, environment, _ := createPodTest(templateString)
+		trait.SidecarContainers = tc.sidecars
+
+		err := trait.Apply(environment)
+		assert.EqualError(t, err, tc.err)
+	}
+}
+
+func TestPriorityClassAndSchedulerName(t *testing.T) {
+	trait, environment, deployment := createPodTest("")
+	environment.Integration.Spec.PodTemplate = nil
+	trait.PriorityClassName = "business-critical"
+	trait.SchedulerName = "my-scheduler"
+
+	configured, err := trait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+
+	err = trait.Apply(environment)
+	assert.Nil(t, err)
+	assert.Equal(t, "business-critical", deployment.Spec.Template.Spec.PriorityClassName)
+	assert.Equal(t, "my-scheduler", deployment.Spec.Template.Spec.SchedulerName)
+	assert.Len(t, deployment.Spec.Template.Spec.Containers, 2)
+}
+
+func TestInvalidPriorityClassName(t *testing.T) {
+	trait, environment, _ := createPodTest("")
+	trait.PriorityClassName = "Business_Critical"
+
+	configured, err := trait.Configure(environment)
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `invalid priority class name "Business_Critical"`)
+}
+
 // nolint: unparam
 func createPodTest(podSpecTemplate string) (*podTrait, *Environment, *appsv1.Deployment) {
 	trait, _ := newPodTrait().(*podTrait)
@@ -158,6 +274,7 @@ func createPodTest(podSpecTemplate string) (*podTrait, *Environment, *appsv1.Dep
 	}
 
 	environment := &Environment{
+		Catalog: NewCatalog(nil),
 		Integration: &v1.Integration{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "pod-template-test-integration",
@@ -18,17 +18,21 @@ limitations under the License.
 package trait
 
 import (
+	"errors"
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/pkg/util"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
 )
 
 type prometheusTrait struct {
@@ -39,9 +43,6 @@ type prometheusTrait struct {
 func newPrometheusTrait() Trait {
 	return &prometheusTrait{
 		BaseTrait: NewBaseTrait("prometheus", 1900),
-		PrometheusTrait: traitv1.PrometheusTrait{
-			PodMonitor: pointer.Bool(true),
-		},
 	}
 }
 
@@ -50,9 +51,24 @@ func (t *prometheusTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
+	if pointer.BoolDeref(t.PodMonitor, false) && pointer.BoolDeref(t.ServiceMonitor, false) {
+		return false, errors.New("pod-monitor and service-monitor can't be both enabled, as the integration pods would be scraped twice")
+	}
+	if t.Interval != "" {
+		if _, err := model.ParseDuration(t.Interval); err != nil {
+			return false, fmt.Errorf("invalid scrape interval %q: %w", t.Interval, err)
+		}
+	}
+
 	return e.IntegrationInPhase(v1.IntegrationPhaseInitialization) || e.IntegrationInRunningPhases(), nil
 }
 
+// podMonitor returns whether the PodMonitor resource is created, that's the default,
+// unless the ServiceMonitor resource is created instead.
+func (t *prometheusTrait) podMonitor() bool {
+	return pointer.BoolDeref(t.PodMonitor, !pointer.BoolDeref(t.ServiceMonitor, false))
+}
+
 func (t *prometheusTrait) Apply(e *Environment) error {
 	if e.IntegrationInPhase(v1.IntegrationPhaseInitialization) {
 		// Add the Camel Quarkus MP Metrics extension
@@ -91,7 +107,14 @@ func (t *prometheusTrait) Apply(e *Environment) error {
 	condition.Message = fmt.Sprintf("%s(%d)", container.Name, containerPort.ContainerPort)
 
 	// Add the PodMonitor resource
-	if pointer.BoolDeref(t.PodMonitor, false) {
+	switch {
+	case t.podMonitor():
+		// The PodMonitor selects the integration pods directly, so that it also works
+		// for the integrations that are not exposed by a Service
+		if err := t.checkMonitorInstalled(e, monitoringv1.PodMonitorsKind, "pod-monitor"); err != nil {
+			return err
+		}
+
 		portName := containerPort.Name
 		// Knative defaults to naming the userland container port "user-port".
 		// Let's rely on that default, granted it is not officially part of the Knative
@@ -107,7 +130,28 @@ func (t *prometheusTrait) Apply(e *Environment) error {
 		}
 		e.Resources.Add(podMonitor)
 		condition.Message = fmt.Sprintf("PodMonitor (%s) -> ", podMonitor.Name) + condition.Message
-	} else {
+	case pointer.BoolDeref(t.ServiceMonitor, false):
+		if controller == ControllerStrategyKnativeService {
+			return errors.New("service-monitor isn't supported with the knative-service controller strategy, pod-monitor can be used instead")
+		}
+		if err := t.checkMonitorInstalled(e, monitoringv1.ServiceMonitorsKind, "service-monitor"); err != nil {
+			return err
+		}
+
+		service := e.Resources.GetServiceForIntegration(e.Integration)
+		servicePort := getServicePortFor(service, containerPort)
+		if servicePort == nil {
+			return fmt.Errorf("service-monitor requires the integration Service to expose the %s container port, "+
+				"e.g., with the service trait", containerPort.Name)
+		}
+
+		serviceMonitor, err := t.getServiceMonitorFor(e, servicePort.Name)
+		if err != nil {
+			return err
+		}
+		e.Resources.Add(serviceMonitor)
+		condition.Message = fmt.Sprintf("ServiceMonitor (%s) -> Service (%s) -> ", serviceMonitor.Name, service.Name) + condition.Message
+	default:
 		condition.Message = "ContainerPort " + condition.Message
 	}
 
@@ -116,6 +160,34 @@ func (t *prometheusTrait) Apply(e *Environment) error {
 	return nil
 }
 
+func (t *prometheusTrait) checkMonitorInstalled(e *Environment, kind string, option string) error {
+	ok, err := kubernetes.IsAPIResourceInstalled(e.Client, monitoringv1.SchemeGroupVersion.String(), kind)
+	if err != nil {
+		return fmt.Errorf("unable to check whether the %s custom resource definition is installed: %w", kind, err)
+	}
+	if !ok {
+		return fmt.Errorf("the %s custom resource definition (%s) is not installed: the Prometheus operator is required "+
+			"for the prometheus trait to create the %s resource, unless %s is set to false", kind, monitoringv1.SchemeGroupVersion, kind, option)
+	}
+
+	return nil
+}
+
+// getServicePortFor returns the port of the Service that targets the given container port.
+func getServicePortFor(service *corev1.Service, containerPort *corev1.ContainerPort) *corev1.ServicePort {
+	if service == nil {
+		return nil
+	}
+	for i, port := range service.Spec.Ports {
+		target := port.TargetPort
+		if (target.Type == intstr.String && target.StrVal != "" && target.StrVal == containerPort.Name) ||
+			(target.Type == intstr.Int && target.IntVal == containerPort.ContainerPort) {
+			return &service.Spec.Ports[i]
+		}
+	}
+	return nil
+}
+
 func (t *prometheusTrait) getContainerPort(e *Environment, controller ControllerStrategy) *corev1.ContainerPort {
 	var name string
 	var port int
@@ -164,8 +236,9 @@ func (t *prometheusTrait) getPodMonitorFor(e *Environment, portName string) (*mo
 			},
 			PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
 				{
-					Port: portName,
-					Path: "/q/metrics",
+					Port:     portName,
+					Path:     "/q/metrics",
+					Interval: t.Interval,
 				},
 			},
 		},
@@ -173,3 +246,41 @@ func (t *prometheusTrait) getPodMonitorFor(e *Environment, portName string) (*mo
 
 	return &podMonitor, nil
 }
+
+func (t *prometheusTrait) getServiceMonitorFor(e *Environment, portName string) (*monitoringv1.ServiceMonitor, error) {
+	labels, err := keyValuePairArrayAsStringMap(t.ServiceMonitorLabels)
+	if err != nil {
+		return nil, err
+	}
+	labels[v1.IntegrationLabel] = e.Integration.Name
+
+	serviceMonitor := monitoringv1.ServiceMonitor{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ServiceMonitor",
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+			Labels:    labels,
+		},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			// Only the integration Service is selected, so that the pods aren't scraped through the additional Services
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					v1.IntegrationLabel:             e.Integration.Name,
+					"camel.apache.org/service.type": v1.ServiceTypeUser,
+				},
+			},
+			Endpoints: []monitoringv1.Endpoint{
+				{
+					Port:     portName,
+					Path:     "/q/metrics",
+					Interval: t.Interval,
+				},
+			},
+		},
+	}
+
+	return &serviceMonitor, nil
+}
@@ -25,12 +25,16 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/utils/pointer"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/util/camel"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/apache/camel-k/pkg/util/test"
 )
 
 func TestConfigurePrometheusTraitInRightPhaseDoesSucceed(t *testing.T) {
@@ -80,6 +84,122 @@ func TestApplyNominalPrometheusTraitDoesSucceed(t *testing.T) {
 	assert.Equal(t, corev1.ConditionTrue, condition.Status)
 }
 
+func TestApplyPrometheusTraitWithoutPodMonitorCRD(t *testing.T) {
+	trait, environment := createNominalPrometheusTest()
+	client, _ := test.NewFakeClient()
+	environment.Client = client
+
+	err := trait.Apply(environment)
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "the PodMonitor custom resource definition (monitoring.coreos.com/v1) is not installed")
+	assert.Nil(t, environment.Resources.GetPodMonitor(func(pm *monitoringv1.PodMonitor) bool { return true }))
+}
+
+func TestApplyPrometheusTraitWithoutPodMonitor(t *testing.T) {
+	trait, environment := createNominalPrometheusTest()
+	client, _ := test.NewFakeClient()
+	environment.Client = client
+	trait.PodMonitor = pointer.Bool(false)
+
+	err := trait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Nil(t, environment.Resources.GetPodMonitor(func(pm *monitoringv1.PodMonitor) bool { return true }))
+
+	assert.Len(t, environment.Integration.Status.Conditions, 1)
+	condition := environment.Integration.Status.Conditions[0]
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "ContainerPort integration(8080)", condition.Message)
+}
+
+func TestConfigurePrometheusTraitWithInvalidConfiguration(t *testing.T) {
+	trait, environment := createNominalPrometheusTest()
+	trait.PodMonitor = pointer.Bool(true)
+	trait.ServiceMonitor = pointer.Bool(true)
+
+	_, err := trait.Configure(environment)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "pod-monitor and service-monitor can't be both enabled, as the integration pods would be scraped twice", err.Error())
+
+	trait.PodMonitor = nil
+	trait.Interval = "30 seconds"
+
+	_, err = trait.Configure(environment)
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `invalid scrape interval "30 seconds"`)
+}
+
+func TestApplyPrometheusTraitWithServiceMonitor(t *testing.T) {
+	trait, environment := createNominalPrometheusTest()
+	trait.ServiceMonitor = pointer.Bool(true)
+	trait.ServiceMonitorLabels = []string{"release=monitoring"}
+	trait.Interval = "30s"
+	environment.Resources.Add(&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "integration-name",
+			Namespace: "integration-namespace",
+			Labels: map[string]string{
+				v1.IntegrationLabel:             "integration-name",
+				"camel.apache.org/service.type": v1.ServiceTypeUser,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http",
+					Port:       80,
+					TargetPort: intstr.FromString(defaultContainerPortName),
+				},
+			},
+		},
+	})
+
+	_, err := trait.Configure(environment)
+	assert.Nil(t, err)
+	err = trait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Nil(t, environment.Resources.GetPodMonitor(func(pm *monitoringv1.PodMonitor) bool { return true }))
+	var serviceMonitor *monitoringv1.ServiceMonitor
+	for _, r := range environment.Resources.Items() {
+		if sm, ok := r.(*monitoringv1.ServiceMonitor); ok {
+			serviceMonitor = sm
+		}
+	}
+	assert.NotNil(t, serviceMonitor)
+	assert.Equal(t, "integration-name", serviceMonitor.Name)
+	assert.Equal(t, "monitoring", serviceMonitor.Labels["release"])
+	assert.Equal(t, map[string]string{
+		v1.IntegrationLabel:             "integration-name",
+		"camel.apache.org/service.type": v1.ServiceTypeUser,
+	}, serviceMonitor.Spec.Selector.MatchLabels)
+	assert.Equal(t, []monitoringv1.Endpoint{
+		{
+			Port:     "http",
+			Path:     "/q/metrics",
+			Interval: "30s",
+		},
+	}, serviceMonitor.Spec.Endpoints)
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionPrometheusAvailable)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "ServiceMonitor (integration-name) -> Service (integration-name) -> integration(8080)", condition.Message)
+}
+
+func TestApplyPrometheusTraitWithServiceMonitorWithoutService(t *testing.T) {
+	trait, environment := createNominalPrometheusTest()
+	trait.ServiceMonitor = pointer.Bool(true)
+
+	err := trait.Apply(environment)
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "service-monitor requires the integration Service to expose the http container port")
+}
+
 func TestApplyPrometheusTraitWithoutContainerDoesNotSucceed(t *testing.T) {
 	trait, environment := createNominalPrometheusTest()
 	environment.Resources = kubernetes.NewCollection()
@@ -122,8 +242,29 @@ func createNominalPrometheusTest() (*prometheusTrait, *Environment) {
 		panic(err)
 	}
 
+	client, _ := test.NewFakeClient()
+	fakeClient, _ := client.(*test.FakeClient)
+	// Fake the Prometheus operator custom resource definitions installation
+	discovery, _ := fakeClient.Interface.Discovery().(*fakediscovery.FakeDiscovery)
+	discovery.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: monitoringv1.SchemeGroupVersion.String(),
+			APIResources: []metav1.APIResource{
+				{
+					Name: monitoringv1.PodMonitorName,
+					Kind: monitoringv1.PodMonitorsKind,
+				},
+				{
+					Name: monitoringv1.ServiceMonitorName,
+					Kind: monitoringv1.ServiceMonitorsKind,
+				},
+			},
+		},
+	}
+
 	environment := &Environment{
 		Catalog:      NewCatalog(nil),
+		Client:       client,
 		CamelCatalog: camelCatalog,
 		Integration: &v1.Integration{
 			ObjectMeta: metav1.ObjectMeta{
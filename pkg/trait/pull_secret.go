@@ -24,7 +24,9 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/utils/pointer"
 
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
@@ -95,6 +97,11 @@ func (t *pullSecretTrait) Apply(e *Environment) error {
 				Name: t.SecretName,
 			})
 		})
+		if pointer.BoolDeref(t.LinkServiceAccount, false) {
+			// The ServiceAccount is updated once the integration resources are applied,
+			// so that it's left untouched when the resources are generated in dry-run mode
+			e.PostActions = append(e.PostActions, t.linkServiceAccount)
+		}
 	}
 	if pointer.BoolDeref(t.ImagePullerDelegation, false) {
 		if err := t.delegateImagePuller(e); err != nil {
@@ -105,6 +112,39 @@ func (t *pullSecretTrait) Apply(e *Environment) error {
 	return nil
 }
 
+func (t *pullSecretTrait) linkServiceAccount(e *Environment) error {
+	if isReconciliationPaused(e) || isImageSignatureUnverified(e) {
+		return nil
+	}
+
+	name := e.Integration.Spec.ServiceAccountName
+	if name == "" {
+		name = "default"
+	}
+	key := ctrl.ObjectKey{Namespace: e.Integration.Namespace, Name: name}
+	// Updating the ServiceAccount directly because it's not owned by the integration,
+	// and may be concurrently updated, e.g., by the other integrations sharing it
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		sa := corev1.ServiceAccount{}
+		if err := t.Client.Get(e.Ctx, key, &sa); err != nil {
+			return errors.Wrapf(err, "unable to get service account %s", name)
+		}
+		for _, secret := range sa.ImagePullSecrets {
+			if secret.Name == t.SecretName {
+				return nil
+			}
+		}
+		sa.ImagePullSecrets = append(sa.ImagePullSecrets, corev1.LocalObjectReference{Name: t.SecretName})
+		if err := t.Client.Update(e.Ctx, &sa); err != nil {
+			if k8serrors.IsConflict(err) {
+				return err
+			}
+			return errors.Wrapf(err, "unable to link pull secret %s to service account %s", t.SecretName, name)
+		}
+		return nil
+	})
+}
+
 func (t *pullSecretTrait) delegateImagePuller(e *Environment) error {
 	// Applying the RoleBinding directly because it's a resource in the operator namespace
 	// (different from the integration namespace when delegation is enabled).
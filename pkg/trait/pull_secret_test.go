@@ -49,6 +49,92 @@ func TestPullSecret(t *testing.T) {
 	assert.Contains(t, deployment.Spec.Template.Spec.ImagePullSecrets, corev1.LocalObjectReference{Name: "xxxy"})
 }
 
+func TestPullSecretLinkServiceAccount(t *testing.T) {
+	e, _ := getEnvironmentAndDeployment(t)
+	sa := corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "default",
+		},
+	}
+	assert.NoError(t, e.Client.Create(e.Ctx, &sa))
+
+	trait, _ := newPullSecretTrait().(*pullSecretTrait)
+	trait.SecretName = "xxxy"
+	trait.LinkServiceAccount = pointer.Bool(true)
+	trait.Client = e.Client
+	enabled, err := trait.Configure(e)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	// The service account is only updated by the post action
+	assert.NoError(t, trait.Apply(e))
+	err = e.Client.Get(e.Ctx, client.ObjectKeyFromObject(&sa), &sa)
+	assert.NoError(t, err)
+	assert.Empty(t, sa.ImagePullSecrets)
+
+	assert.Len(t, e.PostActions, 1)
+	assert.NoError(t, e.PostActions[0](e))
+	err = e.Client.Get(e.Ctx, client.ObjectKeyFromObject(&sa), &sa)
+	assert.NoError(t, err)
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "xxxy"}}, sa.ImagePullSecrets)
+
+	// Linking again neither duplicates the secret, nor updates the service account
+	resourceVersion := sa.ResourceVersion
+	assert.NoError(t, e.PostActions[0](e))
+	err = e.Client.Get(e.Ctx, client.ObjectKeyFromObject(&sa), &sa)
+	assert.NoError(t, err)
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "xxxy"}}, sa.ImagePullSecrets)
+	assert.Equal(t, resourceVersion, sa.ResourceVersion)
+}
+
+func TestPullSecretLinkServiceAccountWithPausedReconciliation(t *testing.T) {
+	e, _ := getEnvironmentAndDeployment(t)
+	sa := corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "default",
+		},
+	}
+	assert.NoError(t, e.Client.Create(e.Ctx, &sa))
+	deployerTrait, _ := newDeployerTrait().(*deployerTrait)
+	deployerTrait.paused = true
+	e.ExecutedTraits = append(e.ExecutedTraits, deployerTrait)
+
+	trait, _ := newPullSecretTrait().(*pullSecretTrait)
+	trait.SecretName = "xxxy"
+	trait.LinkServiceAccount = pointer.Bool(true)
+	trait.Client = e.Client
+	enabled, err := trait.Configure(e)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	// The service account is left untouched while the reconciliation is paused
+	assert.NoError(t, trait.Apply(e))
+	assert.Len(t, e.PostActions, 1)
+	assert.NoError(t, e.PostActions[0](e))
+	err = e.Client.Get(e.Ctx, client.ObjectKeyFromObject(&sa), &sa)
+	assert.NoError(t, err)
+	assert.Empty(t, sa.ImagePullSecrets)
+}
+
+func TestPullSecretLinkMissingServiceAccount(t *testing.T) {
+	e, _ := getEnvironmentAndDeployment(t)
+
+	trait, _ := newPullSecretTrait().(*pullSecretTrait)
+	trait.SecretName = "xxxy"
+	trait.LinkServiceAccount = pointer.Bool(true)
+	trait.Client = e.Client
+	enabled, err := trait.Configure(e)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	assert.NoError(t, trait.Apply(e))
+	err = e.PostActions[0](e)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unable to get service account default")
+}
+
 func TestPullSecretDoesNothingWhenNotSetOnPlatform(t *testing.T) {
 	e, _ := getEnvironmentAndDeployment(t)
 	e.Platform = &v1.IntegrationPlatform{}
@@ -272,7 +272,14 @@ func (t *quarkusTrait) applyWhenBuildSubmitted(e *Environment) error {
 		}
 	} else {
 		build.Maven.Properties["quarkus.package.type"] = string(traitv1.FastJarPackageType)
-		steps = append(steps, builder.Quarkus.ComputeQuarkusDependencies, builder.Image.IncrementalImageContext)
+		steps = append(steps, builder.Quarkus.ComputeQuarkusDependencies)
+		// The incremental build reuses images of other kits, that are built from the platform base image,
+		// so the image is fully built when another base image is requested
+		if build.BaseImage == "" || build.BaseImage == e.Platform.Status.Build.BaseImage {
+			steps = append(steps, builder.Image.IncrementalImageContext)
+		} else {
+			steps = append(steps, builder.Image.StandardImageContext)
+		}
 		// Spectrum does not rely on Dockerfile to assemble the image
 		if e.Platform.Status.Build.PublishStrategy != v1.IntegrationPlatformBuildPublishStrategySpectrum {
 			steps = append(steps, builder.Image.JvmDockerfile)
@@ -47,6 +47,20 @@ func TestConfigureQuarkusTraitBuildSubmitted(t *testing.T) {
 	assert.Len(t, build.Steps, len(builder.Quarkus.CommonSteps)+3)
 }
 
+func TestApplyQuarkusTraitWithCustomBaseImage(t *testing.T) {
+	quarkusTrait, environment := createNominalQuarkusTest()
+	environment.IntegrationKit.Status.Phase = v1.IntegrationKitPhaseBuildSubmitted
+	environment.Platform.Status.Build.BaseImage = "docker.io/adoptopenjdk/openjdk11:slim"
+	environment.BuildTasks[0].Builder.BaseImage = "registry.example.com/jdk/openjdk-11:11.0.18"
+
+	err := quarkusTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	build := getBuilderTask(environment.BuildTasks)
+	assert.Contains(t, build.Steps, builder.StepIDsFor(builder.Image.StandardImageContext)[0])
+	assert.NotContains(t, build.Steps, builder.StepIDsFor(builder.Image.IncrementalImageContext)[0])
+}
+
 func TestConfigureDisabledQuarkusTraitShouldFail(t *testing.T) {
 	quarkusTrait, environment := createNominalQuarkusTest()
 	quarkusTrait.Enabled = pointer.Bool(false)
@@ -0,0 +1,159 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+)
+
+const (
+	// defaultJvmRunAsUser is the UID of the user the JVM integration images run as, see pkg/builder/image.go.
+	defaultJvmRunAsUser int64 = 1000
+	// defaultNativeRunAsUser is the UID of the nonroot user the native integration images run as,
+	// that's not numeric in the distroless base image, so that the kubelet can't verify it's non-root.
+	defaultNativeRunAsUser int64 = 65532
+)
+
+type securityContextTrait struct {
+	BaseTrait
+	traitv1.SecurityContextTrait `property:",squash"`
+}
+
+func newSecurityContextTrait() Trait {
+	return &securityContextTrait{
+		// Must run after the pod trait, so that the containers of the pod template are configured as well
+		BaseTrait: NewBaseTrait("security-context", 1850),
+	}
+}
+
+func (t *securityContextTrait) Configure(e *Environment) (bool, error) {
+	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, false) {
+		return false, nil
+	}
+
+	if !e.IntegrationInRunningPhases() {
+		return false, nil
+	}
+
+	switch corev1.SeccompProfileType(t.SeccompProfileType) {
+	case "", corev1.SeccompProfileTypeRuntimeDefault, corev1.SeccompProfileTypeUnconfined:
+	default:
+		return false, fmt.Errorf("unsupported seccomp profile type %q: it must be one of %s, %s",
+			t.SeccompProfileType, corev1.SeccompProfileTypeRuntimeDefault, corev1.SeccompProfileTypeUnconfined)
+	}
+
+	return true, nil
+}
+
+func (t *securityContextTrait) Apply(e *Environment) error {
+	if e.GetIntegrationPodSpec() == nil {
+		return fmt.Errorf("could not find any integration deployment for %v", e.Integration.Name)
+	}
+
+	// The containers may be added by the traits applied afterwards, e.g., the sidecars
+	e.PostProcessors = append(e.PostProcessors, t.configureSecurityContexts)
+
+	return nil
+}
+
+func (t *securityContextTrait) configureSecurityContexts(e *Environment) error {
+	podSpec := e.GetIntegrationPodSpec()
+	if podSpec == nil {
+		return nil
+	}
+
+	for i := range podSpec.InitContainers {
+		t.configureSecurityContext(&podSpec.InitContainers[i])
+	}
+	for i := range podSpec.Containers {
+		t.configureSecurityContext(&podSpec.Containers[i])
+	}
+
+	// The integration image user isn't numeric for the native images, so that a UID is required to run as non-root
+	if container := e.GetIntegrationContainer(); container != nil {
+		if sc := container.SecurityContext; sc.RunAsUser == nil && pointer.BoolDeref(sc.RunAsNonRoot, false) {
+			sc.RunAsUser = t.defaultRunAsUser(e)
+		}
+	}
+
+	return nil
+}
+
+// defaultRunAsUser returns the UID of the user the integration image built by the operator runs as, if any.
+// It's not set on OpenShift, that assigns the UID from the namespace range.
+func (t *securityContextTrait) defaultRunAsUser(e *Environment) *int64 {
+	if e.Platform != nil && e.Platform.Status.Cluster == v1.IntegrationPlatformClusterOpenShift {
+		return nil
+	}
+	if e.IntegrationKit == nil || e.IntegrationKit.Labels[v1.IntegrationKitTypeLabel] == v1.IntegrationKitTypeExternal {
+		return nil
+	}
+	if e.IntegrationKit.Labels[v1.IntegrationKitLayoutLabel] == v1.IntegrationKitLayoutNative {
+		return pointer.Int64(defaultNativeRunAsUser)
+	}
+	return pointer.Int64(defaultJvmRunAsUser)
+}
+
+// configureSecurityContext sets the fields of the container security context that are not already set.
+// The container security context is used rather than the pod one, as Knative Serving doesn't allow the latter by default.
+func (t *securityContextTrait) configureSecurityContext(container *corev1.Container) {
+	if container.SecurityContext == nil {
+		container.SecurityContext = &corev1.SecurityContext{}
+	}
+	sc := container.SecurityContext
+
+	if sc.RunAsNonRoot == nil {
+		sc.RunAsNonRoot = pointer.Bool(pointer.BoolDeref(t.RunAsNonRoot, true))
+	}
+	if sc.RunAsUser == nil && t.RunAsUser != nil {
+		sc.RunAsUser = pointer.Int64(*t.RunAsUser)
+	}
+	if sc.ReadOnlyRootFilesystem == nil && t.ReadOnlyRootFilesystem != nil {
+		sc.ReadOnlyRootFilesystem = pointer.Bool(*t.ReadOnlyRootFilesystem)
+	}
+	if sc.AllowPrivilegeEscalation == nil {
+		sc.AllowPrivilegeEscalation = pointer.Bool(pointer.BoolDeref(t.AllowPrivilegeEscalation, false))
+	}
+	if sc.Capabilities == nil {
+		sc.Capabilities = &corev1.Capabilities{}
+	}
+	if len(sc.Capabilities.Drop) == 0 {
+		drop := t.DropCapabilities
+		if len(drop) == 0 {
+			drop = []string{"ALL"}
+		}
+		for _, c := range drop {
+			sc.Capabilities.Drop = append(sc.Capabilities.Drop, corev1.Capability(c))
+		}
+	}
+	if sc.SeccompProfile == nil {
+		profileType := corev1.SeccompProfileType(t.SeccompProfileType)
+		if profileType == "" {
+			profileType = corev1.SeccompProfileTypeRuntimeDefault
+		}
+		sc.SeccompProfile = &corev1.SeccompProfile{
+			Type: profileType,
+		}
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestSecurityContextDisabledByDefault(t *testing.T) {
+	trait, environment := createSecurityContextTest()
+	trait.Enabled = nil
+
+	configured, err := trait.Configure(environment)
+	assert.Nil(t, err)
+	assert.False(t, configured)
+}
+
+func TestSecurityContextRestrictedDefaults(t *testing.T) {
+	trait, environment := createSecurityContextTest()
+
+	configured, err := trait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+
+	applySecurityContextTest(t, trait, environment)
+
+	podSpec := environment.GetIntegrationPodSpec()
+	for _, container := range append(podSpec.InitContainers, podSpec.Containers...) {
+		assert.Equal(t, &corev1.SecurityContext{
+			RunAsNonRoot:             pointer.Bool(true),
+			AllowPrivilegeEscalation: pointer.Bool(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+			SeccompProfile: &corev1.SeccompProfile{
+				Type: corev1.SeccompProfileTypeRuntimeDefault,
+			},
+		}, container.SecurityContext)
+	}
+}
+
+func TestSecurityContextWithOptions(t *testing.T) {
+	trait, environment := createSecurityContextTest()
+	trait.RunAsUser = pointer.Int64(1000)
+	trait.ReadOnlyRootFilesystem = pointer.Bool(true)
+	trait.DropCapabilities = []string{"NET_RAW", "SYS_ADMIN"}
+	trait.SeccompProfileType = string(corev1.SeccompProfileTypeUnconfined)
+
+	configured, err := trait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+
+	applySecurityContextTest(t, trait, environment)
+
+	sc := environment.GetIntegrationContainer().SecurityContext
+	assert.Equal(t, int64(1000), *sc.RunAsUser)
+	assert.True(t, *sc.ReadOnlyRootFilesystem)
+	assert.Equal(t, []corev1.Capability{"NET_RAW", "SYS_ADMIN"}, sc.Capabilities.Drop)
+	assert.Equal(t, corev1.SeccompProfileTypeUnconfined, sc.SeccompProfile.Type)
+}
+
+func TestSecurityContextKeepsContainerFields(t *testing.T) {
+	trait, environment := createSecurityContextTest()
+	environment.GetIntegrationContainer().SecurityContext = &corev1.SecurityContext{
+		RunAsNonRoot: pointer.Bool(false),
+		Capabilities: &corev1.Capabilities{
+			Add: []corev1.Capability{"NET_BIND_SERVICE"},
+		},
+	}
+
+	applySecurityContextTest(t, trait, environment)
+
+	sc := environment.GetIntegrationContainer().SecurityContext
+	assert.False(t, *sc.RunAsNonRoot)
+	assert.Equal(t, []corev1.Capability{"NET_BIND_SERVICE"}, sc.Capabilities.Add)
+	assert.Equal(t, []corev1.Capability{"ALL"}, sc.Capabilities.Drop)
+	assert.False(t, *sc.AllowPrivilegeEscalation)
+}
+
+func TestSecurityContextDefaultRunAsUser(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cluster   v1.IntegrationPlatformCluster
+		labels    map[string]string
+		runAsUser *int64
+	}{
+		{name: "jvm", labels: map[string]string{}, runAsUser: pointer.Int64(defaultJvmRunAsUser)},
+		{name: "native", labels: map[string]string{v1.IntegrationKitLayoutLabel: v1.IntegrationKitLayoutNative}, runAsUser: pointer.Int64(defaultNativeRunAsUser)},
+		{name: "external", labels: map[string]string{v1.IntegrationKitTypeLabel: v1.IntegrationKitTypeExternal}},
+		{name: "openshift", cluster: v1.IntegrationPlatformClusterOpenShift, labels: map[string]string{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trait, environment := createSecurityContextTest()
+			environment.IntegrationKit = &v1.IntegrationKit{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: tc.labels,
+				},
+			}
+			environment.Platform = &v1.IntegrationPlatform{}
+			environment.Platform.Status.Cluster = tc.cluster
+
+			applySecurityContextTest(t, trait, environment)
+
+			assert.Equal(t, tc.runAsUser, environment.GetIntegrationContainer().SecurityContext.RunAsUser)
+			// The other containers images are unknown
+			podSpec := environment.GetIntegrationPodSpec()
+			assert.Nil(t, podSpec.Containers[1].SecurityContext.RunAsUser)
+		})
+	}
+}
+
+func TestSecurityContextWithContainersAddedAfterwards(t *testing.T) {
+	trait, environment := createSecurityContextTest()
+
+	err := trait.Apply(environment)
+	assert.Nil(t, err)
+	podSpec := environment.GetIntegrationPodSpec()
+	podSpec.Containers = append(podSpec.Containers, corev1.Container{Name: "added"})
+	for _, processor := range environment.PostProcessors {
+		assert.Nil(t, processor(environment))
+	}
+
+	assert.NotNil(t, podSpec.Containers[2].SecurityContext)
+	assert.True(t, *podSpec.Containers[2].SecurityContext.RunAsNonRoot)
+}
+
+func TestSecurityContextWithInvalidSeccompProfileType(t *testing.T) {
+	trait, environment := createSecurityContextTest()
+	trait.SeccompProfileType = "Localhost"
+
+	configured, err := trait.Configure(environment)
+	assert.NotNil(t, err)
+	assert.False(t, configured)
+}
+
+func applySecurityContextTest(t *testing.T, trait *securityContextTrait, environment *Environment) {
+	t.Helper()
+
+	err := trait.Apply(environment)
+	assert.Nil(t, err)
+	for _, processor := range environment.PostProcessors {
+		assert.Nil(t, processor(environment))
+	}
+}
+
+func createSecurityContextTest() (*securityContextTrait, *Environment) {
+	trait, _ := newSecurityContextTrait().(*securityContextTrait)
+	trait.Enabled = pointer.Bool(true)
+
+	environment := &Environment{
+		Catalog: NewCatalog(nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(
+			&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "ns",
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							InitContainers: []corev1.Container{
+								{Name: "init"},
+							},
+							Containers: []corev1.Container{
+								{Name: defaultContainerName},
+								{Name: "sidecar"},
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	return trait, environment
+}
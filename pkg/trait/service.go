@@ -19,20 +19,32 @@ package trait
 
 import (
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/pointer"
 
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/pkg/metadata"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
+	src "github.com/apache/camel-k/pkg/util/source"
 )
 
 type serviceTrait struct {
 	BaseTrait
 	traitv1.ServiceTrait `property:",squash"`
+
+	consumerPorts []src.ConsumerPort
+	// exposesHTTP is false when the Service is only required by the consumer ports
+	exposesHTTP bool
 }
 
 const serviceTraitID = "service"
@@ -70,7 +82,9 @@ func (t *serviceTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
-	if pointer.BoolDeref(t.Auto, true) {
+	auto := pointer.BoolDeref(t.Auto, true)
+	t.exposesHTTP = true
+	if auto || pointer.BoolDeref(t.ConsumerPorts, false) {
 		sources, err := kubernetes.ResolveIntegrationSources(e.Ctx, t.Client, e.Integration, e.Resources)
 		if err != nil {
 			e.Integration.Status.SetCondition(
@@ -87,20 +101,66 @@ func (t *serviceTrait) Configure(e *Environment) (bool, error) {
 		if err != nil {
 			return false, err
 		}
-		if !meta.ExposesHTTPServices {
+		if pointer.BoolDeref(t.ConsumerPorts, true) {
+			t.consumerPorts = meta.ConsumerPorts
+		}
+		if auto && !meta.ExposesHTTPServices {
+			if len(t.consumerPorts) == 0 {
+				e.Integration.Status.SetCondition(
+					v1.IntegrationConditionServiceAvailable,
+					corev1.ConditionFalse,
+					v1.IntegrationConditionServiceNotAvailableReason,
+					"no http service required",
+				)
+
+				return false, nil
+			}
+			// The Service only exposes the consumer ports
+			t.exposesHTTP = false
+		}
+	}
+
+	if _, err := parseServicePorts(t.Ports); err != nil {
+		return false, err
+	}
+	if _, err := parseAnnotations("service", t.Annotations); err != nil {
+		return false, err
+	}
+
+	if t.ExistingService != "" {
+		if err := t.validateExistingService(e); err != nil {
 			e.Integration.Status.SetCondition(
 				v1.IntegrationConditionServiceAvailable,
 				corev1.ConditionFalse,
 				v1.IntegrationConditionServiceNotAvailableReason,
-				"no http service required",
+				err.Error(),
 			)
 
-			return false, nil
+			return false, err
 		}
 	}
+
 	return true, nil
 }
 
+func (t *serviceTrait) validateExistingService(e *Environment) error {
+	if errs := validation.IsDNS1035Label(t.ExistingService); len(errs) > 0 {
+		return fmt.Errorf("invalid existing service name %s: %s", t.ExistingService, strings.Join(errs, ", "))
+	}
+	if t.Type != nil || pointer.BoolDeref(t.NodePort, false) {
+		return fmt.Errorf("the service type can't be set with the existing service %s", t.ExistingService)
+	}
+	if t.Client == nil {
+		return nil
+	}
+	existing := &corev1.Service{}
+	err := t.Client.Get(e.Ctx, ctrl.ObjectKey{Namespace: e.Integration.Namespace, Name: t.ExistingService}, existing)
+	if k8serrors.IsNotFound(err) {
+		return fmt.Errorf("existing service %s not found in namespace %s", t.ExistingService, e.Integration.Namespace)
+	}
+	return err
+}
+
 func (t *serviceTrait) Apply(e *Environment) error {
 	svc := e.Resources.GetServiceForIntegration(e.Integration)
 	// add a new service if not already created
@@ -125,10 +185,362 @@ func (t *serviceTrait) Apply(e *Environment) error {
 		}
 		svc.Spec.Type = serviceType
 	}
+	// The annotations have been validated when the trait has been configured
+	annotations, _ := parseAnnotations("service", t.Annotations)
+	if len(annotations) > 0 {
+		if svc.Annotations == nil {
+			svc.Annotations = make(map[string]string)
+		}
+		for k, v := range annotations {
+			svc.Annotations[k] = v
+		}
+	}
 	e.Resources.Add(svc)
+
+	if len(t.consumerPorts) > 0 {
+		// Must run before the other port post processors, that may select the consumer ports
+		e.PostProcessors = append(e.PostProcessors, t.exposeConsumerPorts)
+	}
+	if pointer.BoolDeref(t.AllPorts, false) || len(t.Ports) > 0 {
+		// The container ports are only known once all the traits have been applied
+		e.PostProcessors = append(e.PostProcessors, t.exposeContainerPorts)
+	}
+	if pointer.BoolDeref(t.PortServices, false) {
+		e.PostProcessors = append(e.PostProcessors, t.createPortServices)
+	}
+	if t.ExistingService != "" {
+		// The other traits reference the existing Service, e.g., the route and ingress traits
+		svc.Name = t.ExistingService
+		// Must run after the other service post processors, that complete the Service ports
+		e.PostProcessors = append(e.PostProcessors, t.unmanageExistingService)
+	}
+
 	return nil
 }
 
+// unmanageExistingService removes the integration Service from the managed resources, so that the existing Service
+// is neither owned nor garbage collected, and registers a post action that updates its selector and ports instead.
+func (t *serviceTrait) unmanageExistingService(e *Environment) error {
+	service := e.Resources.GetServiceForIntegration(e.Integration)
+	if service == nil || service.Name != t.ExistingService {
+		return nil
+	}
+	e.Resources.Remove(func(o runtime.Object) bool {
+		return o == service
+	})
+
+	e.PostActions = append(e.PostActions, func(env *Environment) error {
+		if isReconciliationPaused(env) || isImageSignatureUnverified(env) {
+			return nil
+		}
+		return t.patchExistingService(env, service)
+	})
+
+	return nil
+}
+
+// patchExistingService updates the selector and ports of the existing Service, while retaining the node ports
+// that are allocated to the ports it already exposes.
+func (t *serviceTrait) patchExistingService(e *Environment, service *corev1.Service) error {
+	existing := &corev1.Service{}
+	if err := t.Client.Get(e.Ctx, ctrl.ObjectKey{Namespace: e.Integration.Namespace, Name: t.ExistingService}, existing); err != nil {
+		return fmt.Errorf("unable to get existing service %s: %w", t.ExistingService, err)
+	}
+
+	ports := make([]corev1.ServicePort, 0, len(service.Spec.Ports))
+	for _, port := range service.Spec.Ports {
+		for _, p := range existing.Spec.Ports {
+			if p.Name == port.Name && p.Port == port.Port && serviceProtocol(p) == serviceProtocol(port) {
+				port.NodePort = p.NodePort
+			}
+		}
+		ports = append(ports, port)
+	}
+	if len(service.Annotations) > 0 {
+		if existing.Annotations == nil {
+			existing.Annotations = make(map[string]string)
+		}
+		for k, v := range service.Annotations {
+			existing.Annotations[k] = v
+		}
+	}
+	existing.Spec.Selector = service.Spec.Selector
+	if len(ports) > 0 {
+		existing.Spec.Ports = ports
+	}
+
+	// The update fails on conflict, in which case the integration is reconciled again
+	return t.Client.Update(e.Ctx, existing)
+}
+
+// servicePortMapping maps a Service port name to a named port of the integration container.
+type servicePortMapping struct {
+	name          string
+	containerPort string
+}
+
+func parseServicePorts(ports []string) ([]servicePortMapping, error) {
+	mappings := make([]servicePortMapping, 0, len(ports))
+	names := make(map[string]bool)
+	for _, port := range ports {
+		name, containerPort := port, port
+		if i := strings.Index(port, ":"); i >= 0 {
+			name, containerPort = port[:i], port[i+1:]
+		}
+		if name == "" || containerPort == "" {
+			return nil, fmt.Errorf("invalid service port %q, expected <container-port-name> or <service-port-name>:<container-port-name>", port)
+		}
+		if errs := validation.IsValidPortName(name); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid service port name %s: %s", name, strings.Join(errs, ", "))
+		}
+		if names[name] {
+			return nil, fmt.Errorf("duplicate service port name %s", name)
+		}
+		names[name] = true
+		mappings = append(mappings, servicePortMapping{name: name, containerPort: containerPort})
+	}
+	return mappings, nil
+}
+
+func (t *serviceTrait) exposeContainerPorts(e *Environment) error {
+	service := e.Resources.GetServiceForIntegration(e.Integration)
+	container := e.GetIntegrationContainer()
+	if service == nil || container == nil {
+		return nil
+	}
+
+	mappings, err := parseServicePorts(t.Ports)
+	if err != nil {
+		return err
+	}
+	if pointer.BoolDeref(t.AllPorts, false) {
+		selected := make(map[string]bool)
+		for _, m := range mappings {
+			selected[m.containerPort] = true
+		}
+		for _, port := range container.Ports {
+			// The debug port is only exposed when explicitly selected
+			if port.Name == "" || port.Name == debugContainerPortName || selected[port.Name] || isTargetedByService(service, port) {
+				continue
+			}
+			mappings = append(mappings, servicePortMapping{name: port.Name, containerPort: port.Name})
+		}
+	}
+
+	for _, m := range mappings {
+		containerPort := findContainerPort(container, m.containerPort)
+		if containerPort == nil {
+			return fmt.Errorf("container %s has no port named %s", container.Name, m.containerPort)
+		}
+
+		protocol := containerPortProtocol(*containerPort)
+
+		exposed := false
+		for _, port := range service.Spec.Ports {
+			if port.Name == m.name {
+				if !isTargetPort(port, *containerPort) {
+					return fmt.Errorf("service port %s is already mapped to a different container port", m.name)
+				}
+				exposed = true
+			} else if port.Port == containerPort.ContainerPort && port.Protocol == protocol {
+				return fmt.Errorf("service port %s conflicts with service port %s on port %d", m.name, port.Name, port.Port)
+			}
+		}
+		if exposed {
+			continue
+		}
+
+		service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+			Name:       m.name,
+			Port:       containerPort.ContainerPort,
+			Protocol:   protocol,
+			TargetPort: intstr.FromString(containerPort.Name),
+		})
+	}
+
+	return nil
+}
+
+// exposeConsumerPorts adds the ports the integration consumers listen on to the integration container and Service.
+func (t *serviceTrait) exposeConsumerPorts(e *Environment) error {
+	service := e.Resources.GetServiceForIntegration(e.Integration)
+	container := e.GetIntegrationContainer()
+	if service == nil || container == nil {
+		return nil
+	}
+
+	if !t.exposesHTTP {
+		// The Service isn't configured by the container trait
+		service.Labels["camel.apache.org/service.type"] = v1.ServiceTypeUser
+		names := make([]string, 0, len(t.consumerPorts))
+		for _, p := range t.consumerPorts {
+			names = append(names, p.Name)
+		}
+		e.Integration.Status.SetCondition(
+			v1.IntegrationConditionServiceAvailable,
+			corev1.ConditionTrue,
+			v1.IntegrationConditionServiceAvailableReason,
+			fmt.Sprintf("%s(%s) -> %s", service.Name, strings.Join(names, ", "), container.Name),
+		)
+	}
+
+	for _, p := range t.consumerPorts {
+		protocol := corev1.Protocol(p.Protocol)
+
+		var containerPort *corev1.ContainerPort
+		for i, port := range container.Ports {
+			if port.ContainerPort == p.Port && containerPortProtocol(port) == protocol {
+				containerPort = &container.Ports[i]
+			}
+		}
+		if containerPort == nil {
+			container.Ports = append(container.Ports, corev1.ContainerPort{
+				Name:          p.Name,
+				ContainerPort: p.Port,
+				Protocol:      protocol,
+			})
+			containerPort = &container.Ports[len(container.Ports)-1]
+		}
+		if isTargetedByService(service, *containerPort) {
+			continue
+		}
+
+		for _, port := range service.Spec.Ports {
+			if port.Name == p.Name || (port.Port == p.Port && serviceProtocol(port) == protocol) {
+				return fmt.Errorf("consumer port %s conflicts with service port %s", p.Name, port.Name)
+			}
+		}
+		service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+			Name:       p.Name,
+			Port:       p.Port,
+			Protocol:   protocol,
+			TargetPort: intstr.FromInt(int(p.Port)),
+		})
+	}
+
+	return nil
+}
+
+// createPortServices generates a Service for each of the selected named ports of the integration container.
+func (t *serviceTrait) createPortServices(e *Environment) error {
+	service := e.Resources.GetServiceForIntegration(e.Integration)
+	container := e.GetIntegrationContainer()
+	if service == nil || container == nil {
+		return nil
+	}
+
+	mappings, err := parseServicePorts(t.Ports)
+	if err != nil {
+		return err
+	}
+	if len(mappings) == 0 {
+		for _, port := range container.Ports {
+			if port.Name != "" && port.Name != debugContainerPortName {
+				mappings = append(mappings, servicePortMapping{name: port.Name, containerPort: port.Name})
+			}
+		}
+	}
+
+	for _, m := range mappings {
+		containerPort := findContainerPort(container, m.containerPort)
+		if containerPort == nil {
+			return fmt.Errorf("container %s has no port named %s", container.Name, m.containerPort)
+		}
+
+		name := fmt.Sprintf("%s-%s", e.Integration.Name, m.name)
+		if errs := validation.IsDNS1035Label(name); len(errs) > 0 {
+			return fmt.Errorf("invalid service name %s for port %s: %s", name, m.name, strings.Join(errs, ", "))
+		}
+		if e.Resources.GetService(func(s *corev1.Service) bool { return s.Name == name }) != nil {
+			return fmt.Errorf("service %s for port %s collides with another service of the integration", name, m.name)
+		}
+		if err := t.checkServiceNameAvailable(e, name); err != nil {
+			return err
+		}
+
+		portService := getServiceFor(e)
+		portService.Name = name
+		// The Services are created once the owner and gc traits have been applied,
+		// so they inherit the owner references and the labels of the integration Service
+		portService.OwnerReferences = service.OwnerReferences
+		for k, v := range service.Labels {
+			portService.Labels[k] = v
+		}
+		portService.Labels["camel.apache.org/service.type"] = v1.ServiceTypeAdditional
+		portService.Annotations = service.Annotations
+		portService.Spec.Type = service.Spec.Type
+		portService.Spec.Ports = []corev1.ServicePort{
+			{
+				Name:       m.name,
+				Port:       containerPort.ContainerPort,
+				Protocol:   containerPortProtocol(*containerPort),
+				TargetPort: intstr.FromString(containerPort.Name),
+			},
+		}
+		e.Resources.Add(portService)
+	}
+
+	return nil
+}
+
+// checkServiceNameAvailable verifies the given name is not already used by a Service that's not owned
+// by the integration.
+func (t *serviceTrait) checkServiceNameAvailable(e *Environment, name string) error {
+	if t.Client == nil {
+		return nil
+	}
+	existing := &corev1.Service{}
+	err := t.Client.Get(e.Ctx, ctrl.ObjectKey{Namespace: e.Integration.Namespace, Name: name}, existing)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if existing.Labels[v1.IntegrationLabel] != e.Integration.Name {
+		return fmt.Errorf("service %s already exists and is not owned by integration %s", name, e.Integration.Name)
+	}
+	return nil
+}
+
+func findContainerPort(container *corev1.Container, name string) *corev1.ContainerPort {
+	for i, port := range container.Ports {
+		if port.Name == name {
+			return &container.Ports[i]
+		}
+	}
+	return nil
+}
+
+func containerPortProtocol(port corev1.ContainerPort) corev1.Protocol {
+	if port.Protocol == "" {
+		return corev1.ProtocolTCP
+	}
+	return port.Protocol
+}
+
+func serviceProtocol(port corev1.ServicePort) corev1.Protocol {
+	if port.Protocol == "" {
+		return corev1.ProtocolTCP
+	}
+	return port.Protocol
+}
+
+func isTargetedByService(service *corev1.Service, containerPort corev1.ContainerPort) bool {
+	for _, port := range service.Spec.Ports {
+		if isTargetPort(port, containerPort) {
+			return true
+		}
+	}
+	return false
+}
+
+func isTargetPort(servicePort corev1.ServicePort, containerPort corev1.ContainerPort) bool {
+	if servicePort.TargetPort.Type == intstr.String {
+		return servicePort.TargetPort.StrVal == containerPort.Name
+	}
+	return servicePort.TargetPort.IntVal == containerPort.ContainerPort
+}
+
 func getServiceFor(e *Environment) *corev1.Service {
 	return &corev1.Service{
 		TypeMeta: metav1.TypeMeta{
@@ -0,0 +1,172 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/openshift"
+)
+
+const (
+	serviceCATraitID = "service-ca"
+
+	serviceCADefaultConfigMap = "openshift-service-ca.crt"
+	serviceCADefaultKey       = "service-ca.crt"
+	serviceCAVolumeName       = "service-ca"
+	serviceCATruststoreVolume = "service-ca-truststore"
+	serviceCAContainerName    = "service-ca-truststore"
+	// The JVM default truststore password, that's kept for the generated truststore.
+	serviceCATruststorePassword = "changeit"
+)
+
+var (
+	serviceCAMountPath         = filepath.Join(camel.BasePath, "service-ca")
+	serviceCATruststorePath    = filepath.Join(camel.BasePath, "truststore")
+	serviceCATruststoreFile    = filepath.Join(serviceCATruststorePath, "truststore")
+	serviceCATruststoreCommand = `JAVA_HOME="${JAVA_HOME:-$(dirname "$(dirname "$(readlink -f "$(command -v java)")")")}" && ` +
+		`cp "${JAVA_HOME}/lib/security/cacerts" %[1]s && chmod u+w %[1]s && ` +
+		`keytool -importcert -noprompt -trustcacerts -alias service-ca -file %[2]s -keystore %[1]s -storepass %[3]s`
+)
+
+type serviceCATrait struct {
+	BaseTrait
+	traitv1.ServiceCATrait `property:",squash"`
+}
+
+func newServiceCATrait() Trait {
+	return &serviceCATrait{
+		BaseTrait: NewBaseTrait(serviceCATraitID, 1620),
+	}
+}
+
+func (t *serviceCATrait) Configure(e *Environment) (bool, error) {
+	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, false) {
+		return false, nil
+	}
+
+	if !e.IntegrationInRunningPhases() {
+		return false, nil
+	}
+
+	if trait := e.Catalog.GetTrait(quarkusTraitID); trait != nil && e.IntegrationKit != nil {
+		// The truststore is generated with the JDK tooling, that's not available in native images
+		if quarkus, ok := trait.(*quarkusTrait); ok && pointer.BoolDeref(quarkus.Enabled, true) && quarkus.isNativeIntegration(e) {
+			return false, nil
+		}
+	}
+
+	// The service CA is only provided by OpenShift
+	if t.Client == nil {
+		return false, nil
+	}
+	isOpenShift, err := openshift.IsOpenShift(t.Client)
+	if err != nil {
+		return false, err
+	}
+	if !isOpenShift {
+		return false, nil
+	}
+
+	if t.ConfigMap == "" {
+		t.ConfigMap = serviceCADefaultConfigMap
+	}
+	if t.Key == "" {
+		t.Key = serviceCADefaultKey
+	}
+
+	return true, nil
+}
+
+func (t *serviceCATrait) Apply(e *Environment) error {
+	container := e.GetIntegrationContainer()
+	if container == nil {
+		return fmt.Errorf("unable to find integration container: %s", e.Integration.Name)
+	}
+	podSpec := e.GetIntegrationPodSpec()
+	if podSpec == nil {
+		return fmt.Errorf("could not find any integration deployment for %v", e.Integration.Name)
+	}
+
+	podSpec.Volumes = append(podSpec.Volumes,
+		corev1.Volume{
+			Name: serviceCAVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: t.ConfigMap,
+					},
+					Items: []corev1.KeyToPath{
+						{
+							Key:  t.Key,
+							Path: serviceCADefaultKey,
+						},
+					},
+				},
+			},
+		},
+		corev1.Volume{
+			Name: serviceCATruststoreVolume,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+	)
+
+	// The truststore is generated by an init container, that runs the integration image, so that the JVM
+	// default CA certificates are preserved
+	podSpec.InitContainers = append(podSpec.InitContainers, corev1.Container{
+		Name:  serviceCAContainerName,
+		Image: container.Image,
+		Command: []string{
+			"/bin/sh",
+			"-c",
+			fmt.Sprintf(serviceCATruststoreCommand,
+				serviceCATruststoreFile, filepath.Join(serviceCAMountPath, serviceCADefaultKey), serviceCATruststorePassword),
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      serviceCAVolumeName,
+				MountPath: serviceCAMountPath,
+				ReadOnly:  true,
+			},
+			{
+				Name:      serviceCATruststoreVolume,
+				MountPath: serviceCATruststorePath,
+			},
+		},
+	})
+
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      serviceCATruststoreVolume,
+		MountPath: serviceCATruststorePath,
+		ReadOnly:  true,
+	})
+	container.Args = append(container.Args,
+		"-Djavax.net.ssl.trustStore="+serviceCATruststoreFile,
+		"-Djavax.net.ssl.trustStorePassword="+serviceCATruststorePassword,
+	)
+
+	return nil
+}
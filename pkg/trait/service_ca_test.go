@@ -0,0 +1,146 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestConfigureServiceCATraitIsNoopOnKubernetes(t *testing.T) {
+	trait, environment := createNominalServiceCATest()
+
+	client, err := test.NewFakeClient()
+	assert.Nil(t, err)
+	trait.Client = client
+	environment.Ctx = context.TODO()
+
+	configured, err := trait.Configure(environment)
+
+	assert.Nil(t, err)
+	assert.False(t, configured)
+}
+
+func TestConfigureDisabledServiceCATraitDoesNotSucceed(t *testing.T) {
+	trait, environment := createNominalServiceCATest()
+	trait.Enabled = pointer.Bool(false)
+
+	configured, err := trait.Configure(environment)
+
+	assert.Nil(t, err)
+	assert.False(t, configured)
+}
+
+func TestApplyServiceCATraitNominalShouldSucceed(t *testing.T) {
+	trait, environment := createNominalServiceCATest()
+	trait.ConfigMap = serviceCADefaultConfigMap
+	trait.Key = serviceCADefaultKey
+
+	err := trait.Apply(environment)
+
+	assert.Nil(t, err)
+
+	podSpec := environment.GetIntegrationPodSpec()
+	assert.Len(t, podSpec.Volumes, 2)
+	assert.Equal(t, serviceCAVolumeName, podSpec.Volumes[0].Name)
+	assert.Equal(t, "openshift-service-ca.crt", podSpec.Volumes[0].ConfigMap.Name)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "service-ca.crt", Path: "service-ca.crt"}}, podSpec.Volumes[0].ConfigMap.Items)
+	assert.Equal(t, serviceCATruststoreVolume, podSpec.Volumes[1].Name)
+	assert.NotNil(t, podSpec.Volumes[1].EmptyDir)
+
+	assert.Len(t, podSpec.InitContainers, 1)
+	initContainer := podSpec.InitContainers[0]
+	assert.Equal(t, serviceCAContainerName, initContainer.Name)
+	assert.Equal(t, "integration-image", initContainer.Image)
+	assert.Contains(t, initContainer.Command[2], "-file /etc/camel/service-ca/service-ca.crt -keystore /etc/camel/truststore/truststore")
+	assert.Len(t, initContainer.VolumeMounts, 2)
+
+	container := environment.GetIntegrationContainer()
+	assert.Equal(t, []corev1.VolumeMount{
+		{Name: serviceCATruststoreVolume, MountPath: "/etc/camel/truststore", ReadOnly: true},
+	}, container.VolumeMounts)
+	assert.Equal(t, []string{
+		"-Djavax.net.ssl.trustStore=/etc/camel/truststore/truststore",
+		"-Djavax.net.ssl.trustStorePassword=changeit",
+	}, container.Args)
+}
+
+func TestApplyServiceCATraitWithCustomConfigMapShouldSucceed(t *testing.T) {
+	trait, environment := createNominalServiceCATest()
+	trait.ConfigMap = "my-ca-bundle"
+	trait.Key = "ca-bundle.crt"
+
+	err := trait.Apply(environment)
+
+	assert.Nil(t, err)
+
+	podSpec := environment.GetIntegrationPodSpec()
+	assert.Equal(t, "my-ca-bundle", podSpec.Volumes[0].ConfigMap.Name)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "ca-bundle.crt", Path: "service-ca.crt"}}, podSpec.Volumes[0].ConfigMap.Items)
+}
+
+func TestApplyServiceCATraitWithoutContainerShouldFail(t *testing.T) {
+	trait, environment := createNominalServiceCATest()
+	environment.Resources = kubernetes.NewCollection()
+
+	err := trait.Apply(environment)
+
+	assert.NotNil(t, err)
+}
+
+func createNominalServiceCATest() (*serviceCATrait, *Environment) {
+	trait, _ := newServiceCATrait().(*serviceCATrait)
+	trait.Enabled = pointer.Bool(true)
+
+	environment := &Environment{
+		Catalog: NewCatalog(nil),
+		Integration: &v1.Integration{
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(
+			&appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name:  defaultContainerName,
+									Image: "integration-image",
+								},
+							},
+						},
+					},
+				},
+			},
+		),
+	}
+
+	return trait, environment
+}
@@ -18,6 +18,7 @@ limitations under the License.
 package trait
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,13 +26,17 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
 
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/pkg/util/camel"
 	"github.com/apache/camel-k/pkg/util/gzip"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/apache/camel-k/pkg/util/test"
 )
 
 const (
@@ -614,3 +619,540 @@ func TestServiceWithKnativeServiceDisabledInIntegrationPlatform(t *testing.T) {
 	assert.NotNil(t, environment.GetTrait(serviceTraitID))
 	assert.Nil(t, environment.GetTrait(knativeServiceTraitID))
 }
+
+func TestServiceWithAllPorts(t *testing.T) {
+	environment := createServicePortsTestEnvironment(t, &traitv1.ServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+		AllPorts: pointer.Bool(true),
+	})
+
+	err := environment.Catalog.apply(environment)
+	assert.Nil(t, err)
+
+	s := environment.Resources.GetServiceForIntegration(environment.Integration)
+	assert.NotNil(t, s)
+	assert.Equal(t, []corev1.ServicePort{
+		{
+			Name:       "http",
+			Port:       80,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromString("http"),
+		},
+		{
+			Name:       "jolokia",
+			Port:       8778,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromString("jolokia"),
+		},
+	}, s.Spec.Ports)
+}
+
+func TestServiceWithAllPortsAndJvmDebug(t *testing.T) {
+	environment := createServicePortsTestEnvironment(t, &traitv1.ServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+		AllPorts: pointer.Bool(true),
+	})
+	environment.Integration.Spec.Traits.JVM = &traitv1.JVMTrait{
+		Debug: pointer.Bool(true),
+	}
+	environment.Integration.Spec.Traits.NetworkPolicy = &traitv1.NetworkPolicyTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+	}
+
+	err := environment.Catalog.apply(environment)
+	assert.Nil(t, err)
+
+	c := environment.GetIntegrationContainer()
+	assert.NotNil(t, c)
+	assert.Contains(t, c.Ports, corev1.ContainerPort{Name: debugContainerPortName, ContainerPort: 5005, Protocol: corev1.ProtocolTCP})
+
+	// The debug port is neither exposed by the Service, nor allowed by the NetworkPolicy
+	s := environment.Resources.GetServiceForIntegration(environment.Integration)
+	assert.NotNil(t, s)
+	assert.Len(t, s.Spec.Ports, 2)
+	assert.Equal(t, "http", s.Spec.Ports[0].Name)
+	assert.Equal(t, "jolokia", s.Spec.Ports[1].Name)
+
+	networkPolicy := findNetworkPolicy(environment.Resources)
+	assert.NotNil(t, networkPolicy)
+	assert.Len(t, networkPolicy.Spec.Ingress, 1)
+	for _, port := range networkPolicy.Spec.Ingress[0].Ports {
+		assert.NotEqual(t, int32(5005), port.Port.IntVal)
+	}
+}
+
+func TestServiceWithSelectedJvmDebugPort(t *testing.T) {
+	environment := createServicePortsTestEnvironment(t, &traitv1.ServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+		Ports: []string{"http", debugContainerPortName},
+	})
+	environment.Integration.Spec.Traits.JVM = &traitv1.JVMTrait{
+		Debug: pointer.Bool(true),
+	}
+
+	err := environment.Catalog.apply(environment)
+	assert.Nil(t, err)
+
+	s := environment.Resources.GetServiceForIntegration(environment.Integration)
+	assert.NotNil(t, s)
+	assert.Contains(t, s.Spec.Ports, corev1.ServicePort{
+		Name:       debugContainerPortName,
+		Port:       5005,
+		Protocol:   corev1.ProtocolTCP,
+		TargetPort: intstr.FromString(debugContainerPortName),
+	})
+}
+
+func TestServiceWithSelectedPorts(t *testing.T) {
+	environment := createServicePortsTestEnvironment(t, &traitv1.ServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+		Ports: []string{"http", "management:jolokia"},
+	})
+
+	err := environment.Catalog.apply(environment)
+	assert.Nil(t, err)
+
+	s := environment.Resources.GetServiceForIntegration(environment.Integration)
+	assert.NotNil(t, s)
+	assert.Len(t, s.Spec.Ports, 2)
+	assert.Equal(t, "http", s.Spec.Ports[0].Name)
+	assert.Equal(t, corev1.ServicePort{
+		Name:       "management",
+		Port:       8778,
+		Protocol:   corev1.ProtocolTCP,
+		TargetPort: intstr.FromString("jolokia"),
+	}, s.Spec.Ports[1])
+}
+
+func TestServiceWithMissingContainerPort(t *testing.T) {
+	environment := createServicePortsTestEnvironment(t, &traitv1.ServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+		Ports: []string{"metrics:prometheus"},
+	})
+
+	err := environment.Catalog.apply(environment)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "container integration has no port named prometheus")
+}
+
+func TestServiceWithInvalidPorts(t *testing.T) {
+	_, err := parseServicePorts([]string{"web:"})
+	assert.EqualError(t, err, `invalid service port "web:", expected <container-port-name> or <service-port-name>:<container-port-name>`)
+
+	_, err = parseServicePorts([]string{"Web_Port:http"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid service port name Web_Port")
+
+	_, err = parseServicePorts([]string{"web:http", "web:jolokia"})
+	assert.EqualError(t, err, "duplicate service port name web")
+
+	mappings, err := parseServicePorts([]string{"http", "management:jolokia"})
+	assert.Nil(t, err)
+	assert.Equal(t, []servicePortMapping{
+		{name: "http", containerPort: "http"},
+		{name: "management", containerPort: "jolokia"},
+	}, mappings)
+}
+
+func TestServiceWithPortServices(t *testing.T) {
+	environment := createServicePortsTestEnvironment(t, &traitv1.ServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+		PortServices: pointer.Bool(true),
+	})
+
+	err := environment.Catalog.apply(environment)
+	assert.Nil(t, err)
+
+	s := environment.Resources.GetServiceForIntegration(environment.Integration)
+	assert.NotNil(t, s)
+	assert.Equal(t, ServiceTestName, s.Name)
+	assert.Len(t, s.Spec.Ports, 1)
+
+	s = environment.Resources.GetService(func(service *corev1.Service) bool {
+		return service.Name == ServiceTestName+"-http"
+	})
+	assert.NotNil(t, s)
+	assert.Equal(t, ServiceTestName, s.Labels[v1.IntegrationLabel])
+	assert.Equal(t, v1.ServiceTypeAdditional, s.Labels["camel.apache.org/service.type"])
+	assert.Contains(t, s.Labels, "camel.apache.org/generation")
+	assert.Len(t, s.OwnerReferences, 1)
+	assert.Equal(t, ServiceTestName, s.OwnerReferences[0].Name)
+	assert.Equal(t, map[string]string{v1.IntegrationLabel: ServiceTestName}, s.Spec.Selector)
+	assert.Equal(t, []corev1.ServicePort{
+		{
+			Name:       "http",
+			Port:       8080,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromString("http"),
+		},
+	}, s.Spec.Ports)
+
+	s = environment.Resources.GetService(func(service *corev1.Service) bool {
+		return service.Name == ServiceTestName+"-jolokia"
+	})
+	assert.NotNil(t, s)
+	assert.Equal(t, []corev1.ServicePort{
+		{
+			Name:       "jolokia",
+			Port:       8778,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromString("jolokia"),
+		},
+	}, s.Spec.Ports)
+}
+
+func TestServiceWithPortServicesCollidingWithExistingService(t *testing.T) {
+	environment := createServicePortsTestEnvironment(t, &traitv1.ServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+		Ports:        []string{"management:jolokia"},
+		PortServices: pointer.Bool(true),
+	})
+	client, err := test.NewFakeClient(&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ServiceTestName + "-management",
+			Namespace: ServiceTestNamespace,
+		},
+	})
+	assert.Nil(t, err)
+	environment.Catalog = NewCatalog(client)
+
+	err = environment.Catalog.apply(environment)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "service test-management already exists and is not owned by integration test")
+}
+
+func createServicePortsTestEnvironment(t *testing.T, service *traitv1.ServiceTrait) *Environment {
+	t.Helper()
+
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	compressedRoute, err := gzip.CompressBase64([]byte(`from("netty-http:test").log("hello")`))
+	assert.NoError(t, err)
+
+	environment := &Environment{
+		CamelCatalog: catalog,
+		Catalog:      NewCatalog(nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ServiceTestName,
+				Namespace: ServiceTestNamespace,
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+			Spec: v1.IntegrationSpec{
+				Profile: v1.TraitProfileKubernetes,
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name:        "routes.js",
+							Content:     string(compressedRoute),
+							Compression: true,
+						},
+						Language: v1.LanguageJavaScript,
+					},
+				},
+				Traits: v1.Traits{
+					Service: service,
+					Jolokia: &traitv1.JolokiaTrait{
+						Trait: traitv1.Trait{
+							Enabled: pointer.Bool(true),
+						},
+					},
+				},
+			},
+		},
+		IntegrationKit: &v1.IntegrationKit{
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		},
+		Platform: &v1.IntegrationPlatform{
+			Spec: v1.IntegrationPlatformSpec{
+				Cluster: v1.IntegrationPlatformClusterOpenShift,
+				Build: v1.IntegrationPlatformBuildSpec{
+					PublishStrategy: v1.IntegrationPlatformBuildPublishStrategyS2I,
+					Registry:        v1.RegistrySpec{Address: "registry"},
+					RuntimeVersion:  catalog.Runtime.Version,
+				},
+			},
+			Status: v1.IntegrationPlatformStatus{
+				Phase: v1.IntegrationPlatformPhaseReady,
+			},
+		},
+		EnvVars:        make([]corev1.EnvVar, 0),
+		ExecutedTraits: make([]Trait, 0),
+		Resources:      kubernetes.NewCollection(),
+	}
+	environment.Platform.ResyncStatusFullConfig()
+
+	return environment
+}
+
+func TestServiceWithExistingService(t *testing.T) {
+	environment := createServicePortsTestEnvironment(t, &traitv1.ServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+		ExistingService: "external",
+	})
+	environment.Ctx = context.TODO()
+	environment.Integration.Spec.Traits.Jolokia = nil
+	client, err := test.NewFakeClient(&corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "external",
+			Namespace:  ServiceTestNamespace,
+			Finalizers: []string{"service.kubernetes.io/load-balancer-cleanup"},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:           corev1.ServiceTypeLoadBalancer,
+			LoadBalancerIP: "10.0.0.10",
+			Selector:       map[string]string{"app": "legacy"},
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http",
+					Port:       80,
+					Protocol:   corev1.ProtocolTCP,
+					TargetPort: intstr.FromString("http"),
+					NodePort:   30080,
+				},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	environment.Client = client
+	environment.Catalog = NewCatalog(client)
+
+	err = environment.Catalog.apply(environment)
+	assert.Nil(t, err)
+
+	// The existing Service is not managed
+	assert.Nil(t, environment.Resources.GetServiceForIntegration(environment.Integration))
+
+	// Run the post action that updates the existing Service
+	err = environment.PostActions[len(environment.PostActions)-1](environment)
+	assert.Nil(t, err)
+	service := &corev1.Service{}
+	err = client.Get(environment.Ctx, ctrl.ObjectKey{Namespace: ServiceTestNamespace, Name: "external"}, service)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{v1.IntegrationLabel: ServiceTestName}, service.Spec.Selector)
+	assert.Equal(t, []corev1.ServicePort{
+		{
+			Name:       "http",
+			Port:       80,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromString("http"),
+			NodePort:   30080,
+		},
+	}, service.Spec.Ports)
+	assert.Equal(t, corev1.ServiceTypeLoadBalancer, service.Spec.Type)
+	assert.Equal(t, "10.0.0.10", service.Spec.LoadBalancerIP)
+	assert.Equal(t, []string{"service.kubernetes.io/load-balancer-cleanup"}, service.Finalizers)
+	assert.Empty(t, service.OwnerReferences)
+	assert.NotContains(t, service.Labels, v1.IntegrationLabel)
+}
+
+func TestServiceWithMissingExistingService(t *testing.T) {
+	environment := createServicePortsTestEnvironment(t, &traitv1.ServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+		ExistingService: "external",
+	})
+	environment.Ctx = context.TODO()
+	client, err := test.NewFakeClient()
+	assert.Nil(t, err)
+	environment.Catalog = NewCatalog(client)
+
+	err = environment.Catalog.apply(environment)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "existing service external not found in namespace ns")
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionServiceAvailable)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+}
+
+func TestServiceWithExistingServiceAndType(t *testing.T) {
+	serviceType := traitv1.ServiceTypeNodePort
+	environment := createServicePortsTestEnvironment(t, &traitv1.ServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+		ExistingService: "external",
+		Type:            &serviceType,
+	})
+
+	err := environment.Catalog.apply(environment)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "the service type can't be set with the existing service external")
+}
+
+func TestServiceWithConsumerPorts(t *testing.T) {
+	environment := createServicePortsTestEnvironment(t, &traitv1.ServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+	})
+	compressedRoute, err := gzip.CompressBase64([]byte(`
+		from("netty-http:test").log("hello");
+		from("netty:tcp://0.0.0.0:5150").log("tcp");
+		from("grpc://0.0.0.0:50051/org.acme.Greeter").log("grpc");
+	`))
+	assert.NoError(t, err)
+	environment.Integration.Spec.Sources[0].Content = string(compressedRoute)
+
+	err = environment.Catalog.apply(environment)
+	assert.Nil(t, err)
+
+	s := environment.Resources.GetServiceForIntegration(environment.Integration)
+	assert.NotNil(t, s)
+	assert.Equal(t, []corev1.ServicePort{
+		{
+			Name:       "http",
+			Port:       80,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromString("http"),
+		},
+		{
+			Name:       "netty-5150",
+			Port:       5150,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromInt(5150),
+		},
+		{
+			Name:       "grpc-50051",
+			Port:       50051,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromInt(50051),
+		},
+	}, s.Spec.Ports)
+
+	c := environment.GetIntegrationContainer()
+	assert.NotNil(t, c)
+	assert.Contains(t, c.Ports, corev1.ContainerPort{Name: "netty-5150", ContainerPort: 5150, Protocol: corev1.ProtocolTCP})
+	assert.Contains(t, c.Ports, corev1.ContainerPort{Name: "grpc-50051", ContainerPort: 50051, Protocol: corev1.ProtocolTCP})
+}
+
+func TestServiceWithOnlyConsumerPorts(t *testing.T) {
+	environment := createServicePortsTestEnvironment(t, &traitv1.ServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+	})
+	compressedRoute, err := gzip.CompressBase64([]byte(`
+		from("netty:tcp://0.0.0.0:5150").log("tcp");
+	`))
+	assert.NoError(t, err)
+	environment.Integration.Spec.Sources[0].Content = string(compressedRoute)
+
+	err = environment.Catalog.apply(environment)
+	assert.Nil(t, err)
+
+	assert.NotNil(t, environment.GetTrait(serviceTraitID))
+	s := environment.Resources.GetServiceForIntegration(environment.Integration)
+	assert.NotNil(t, s)
+	assert.Equal(t, v1.ServiceTypeUser, s.Labels["camel.apache.org/service.type"])
+	// The HTTP port isn't exposed
+	assert.Equal(t, []corev1.ServicePort{
+		{
+			Name:       "netty-5150",
+			Port:       5150,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromInt(5150),
+		},
+	}, s.Spec.Ports)
+
+	c := environment.GetIntegrationContainer()
+	assert.NotNil(t, c)
+	assert.Contains(t, c.Ports, corev1.ContainerPort{Name: "netty-5150", ContainerPort: 5150, Protocol: corev1.ProtocolTCP})
+	for _, port := range c.Ports {
+		assert.NotEqual(t, defaultContainerPortName, port.Name)
+	}
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionServiceAvailable)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "test(netty-5150) -> integration", condition.Message)
+}
+
+func TestServiceWithoutConsumerPorts(t *testing.T) {
+	environment := createServicePortsTestEnvironment(t, &traitv1.ServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+		ConsumerPorts: pointer.Bool(false),
+	})
+	compressedRoute, err := gzip.CompressBase64([]byte(`
+		from("netty-http:test").log("hello");
+		from("netty:tcp://0.0.0.0:5150").log("tcp");
+	`))
+	assert.NoError(t, err)
+	environment.Integration.Spec.Sources[0].Content = string(compressedRoute)
+
+	err = environment.Catalog.apply(environment)
+	assert.Nil(t, err)
+
+	s := environment.Resources.GetServiceForIntegration(environment.Integration)
+	assert.NotNil(t, s)
+	assert.Len(t, s.Spec.Ports, 1)
+	assert.Equal(t, "http", s.Spec.Ports[0].Name)
+}
+
+func TestServiceWithAnnotations(t *testing.T) {
+	serviceType := traitv1.ServiceTypeLoadBalancer
+	environment := createServicePortsTestEnvironment(t, &traitv1.ServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+		Type: &serviceType,
+		Annotations: []string{
+			"service.beta.kubernetes.io/aws-load-balancer-type=nlb",
+			"service.beta.kubernetes.io/aws-load-balancer-internal=true",
+		},
+	})
+
+	err := environment.Catalog.apply(environment)
+	assert.Nil(t, err)
+
+	s := environment.Resources.GetServiceForIntegration(environment.Integration)
+	assert.NotNil(t, s)
+	assert.Equal(t, corev1.ServiceTypeLoadBalancer, s.Spec.Type)
+	assert.Equal(t, map[string]string{
+		"service.beta.kubernetes.io/aws-load-balancer-type":     "nlb",
+		"service.beta.kubernetes.io/aws-load-balancer-internal": "true",
+	}, s.Annotations)
+}
+
+func TestServiceWithInvalidAnnotations(t *testing.T) {
+	environment := createServicePortsTestEnvironment(t, &traitv1.ServiceTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+		Annotations: []string{"invalid"},
+	})
+
+	err := environment.Catalog.apply(environment)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `invalid service annotation "invalid"`)
+}
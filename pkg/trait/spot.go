@@ -0,0 +1,227 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+const (
+	spotTraitID = "spot"
+
+	// defaultSpotNoticePeriod is the shortest preemption notice period of the main cloud providers.
+	defaultSpotNoticePeriod = int64(30)
+	defaultSpotDrainTimeout = int64(20)
+	// spotEndpointsRemovalSeconds is the time the evicted pods wait for, before shutting down,
+	// so that they're removed from the Service endpoints and stop receiving new requests.
+	spotEndpointsRemovalSeconds = int64(5)
+
+	// SpotNodeNotPreemptedCondition is the condition of the integration pods readiness gate, that the operator sets
+	// to false once the node the pod is scheduled on is preempted.
+	SpotNodeNotPreemptedCondition corev1.PodConditionType = "camel.apache.org/spot-node-not-preempted"
+)
+
+// The taints of the GKE and AKS spot nodes.
+var defaultSpotTaints = []string{
+	"cloud.google.com/gke-spot=true:NoSchedule",
+	"kubernetes.azure.com/scalesetpriority=spot:NoSchedule",
+}
+
+// The keys of the taints set by the AWS Node Termination Handler, and by GKE, on the preempted nodes.
+var defaultSpotPreemptionTaints = []string{
+	"aws-node-termination-handler/spot-itn",
+	"cloud.google.com/impending-node-termination",
+}
+
+type spotTrait struct {
+	BaseTrait
+	traitv1.SpotTrait `property:",squash"`
+}
+
+func newSpotTrait() Trait {
+	return &spotTrait{
+		// Must be applied after the deployment trait, whose graceful shutdown options prevail
+		BaseTrait: NewBaseTrait(spotTraitID, 1500),
+	}
+}
+
+func (t *spotTrait) Configure(e *Environment) (bool, error) {
+	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, false) {
+		return false, nil
+	}
+
+	if _, err := kubernetes.NewTolerations(t.taints()); err != nil {
+		return false, err
+	}
+	if err := t.validateDrainTimeout(e); err != nil {
+		return false, err
+	}
+
+	if !e.IntegrationInRunningPhases() {
+		return false, nil
+	}
+	strategy, err := e.DetermineControllerStrategy()
+	if err != nil {
+		return false, err
+	}
+	return strategy == ControllerStrategyDeployment, nil
+}
+
+// validateDrainTimeout checks the graceful shutdown of the evicted pods fits in the preemption notice period.
+func (t *spotTrait) validateDrainTimeout(e *Environment) error {
+	notice := t.noticePeriod()
+	if notice <= 0 {
+		return fmt.Errorf("notice period (%ds) must be positive", notice)
+	}
+	if t.DrainTimeout != nil && *t.DrainTimeout < 0 {
+		return fmt.Errorf("drain timeout (%ds) must not be negative", *t.DrainTimeout)
+	}
+
+	drain := t.drainTimeout()
+	name := "drain timeout"
+	if dt := t.deploymentTrait(e); dt != nil {
+		if shutdownTimeout := dt.shutdownTimeout(); shutdownTimeout != nil {
+			if t.DrainTimeout != nil {
+				return fmt.Errorf("drain timeout can't be set along with the deployment trait shutdown timeout")
+			}
+			drain = *shutdownTimeout
+			name = "deployment trait shutdown timeout"
+		}
+		if dt.TerminationGracePeriodSeconds != nil && *dt.TerminationGracePeriodSeconds > notice {
+			return fmt.Errorf("deployment trait termination grace period (%ds) must not exceed the notice period (%ds)",
+				*dt.TerminationGracePeriodSeconds, notice)
+		}
+	}
+	if drain+spotEndpointsRemovalSeconds > notice {
+		return fmt.Errorf("%s (%ds) must not exceed the notice period (%ds), minus the %ds granted for the pods to be "+
+			"removed from the Service endpoints", name, drain, notice, spotEndpointsRemovalSeconds)
+	}
+
+	return nil
+}
+
+func (t *spotTrait) Apply(e *Environment) error {
+	var deployment *appsv1.Deployment
+	e.Resources.VisitDeployment(func(d *appsv1.Deployment) {
+		if d.Name == e.Integration.Name {
+			deployment = d
+		}
+	})
+	if deployment == nil {
+		return fmt.Errorf("could not find any integration deployment for %v", e.Integration.Name)
+	}
+
+	tolerations, err := kubernetes.NewTolerations(t.taints())
+	if err != nil {
+		return err
+	}
+	podSpec := &deployment.Spec.Template.Spec
+	podSpec.Tolerations = append(podSpec.Tolerations, tolerations...)
+
+	if pointer.BoolDeref(t.ReadinessGate, true) {
+		podSpec.ReadinessGates = append(podSpec.ReadinessGates, corev1.PodReadinessGate{
+			ConditionType: SpotNodeNotPreemptedCondition,
+		})
+	}
+
+	dt := t.deploymentTrait(e)
+	if dt == nil || dt.shutdownTimeout() == nil {
+		e.setShutdownTimeout(t.drainTimeout())
+	}
+	if dt == nil || dt.TerminationGracePeriodSeconds == nil {
+		// The pods are killed when the node is reclaimed anyway
+		podSpec.TerminationGracePeriodSeconds = pointer.Int64(t.noticePeriod())
+	}
+	if dt == nil || dt.PreStopPath == "" {
+		// The integration container is only created by the container trait
+		e.PostProcessors = append(e.PostProcessors, t.addPreStopDelay)
+	}
+
+	return nil
+}
+
+// addPreStopDelay delays the shutdown of the evicted pods, until they're removed from the Service endpoints.
+func (t *spotTrait) addPreStopDelay(e *Environment) error {
+	container := e.GetIntegrationContainer()
+	if container == nil {
+		return fmt.Errorf("unable to find integration container: %s", e.Integration.Name)
+	}
+	if container.Lifecycle == nil {
+		container.Lifecycle = &corev1.Lifecycle{}
+	}
+	if container.Lifecycle.PreStop == nil {
+		container.Lifecycle.PreStop = &corev1.LifecycleHandler{
+			Exec: &corev1.ExecAction{
+				Command: []string{"sleep", strconv.FormatInt(spotEndpointsRemovalSeconds, 10)},
+			},
+		}
+	}
+	return nil
+}
+
+// SpotNodePreemption returns the reason why the given node is considered preempted by the spot trait,
+// or an empty string when it's not.
+func SpotNodePreemption(e *Environment, node *corev1.Node) string {
+	if node.Spec.Unschedulable {
+		return fmt.Sprintf("node %s is cordoned", node.Name)
+	}
+	taints := defaultSpotPreemptionTaints
+	if t, ok := e.GetTrait(spotTraitID).(*spotTrait); ok && t.PreemptionTaints != nil {
+		taints = t.PreemptionTaints
+	}
+	for _, taint := range node.Spec.Taints {
+		if util.StringSliceExists(taints, taint.Key) {
+			return fmt.Sprintf("node %s is tainted with %s", node.Name, taint.Key)
+		}
+	}
+	return ""
+}
+
+func (t *spotTrait) deploymentTrait(e *Environment) *deploymentTrait {
+	if e.Catalog == nil {
+		return nil
+	}
+	if dt, ok := e.Catalog.GetTrait("deployment").(*deploymentTrait); ok && pointer.BoolDeref(dt.Enabled, true) {
+		return dt
+	}
+	return nil
+}
+
+func (t *spotTrait) taints() []string {
+	if t.Taints == nil {
+		return defaultSpotTaints
+	}
+	return t.Taints
+}
+
+func (t *spotTrait) noticePeriod() int64 {
+	return pointer.Int64Deref(t.NoticePeriod, defaultSpotNoticePeriod)
+}
+
+func (t *spotTrait) drainTimeout() int64 {
+	return pointer.Int64Deref(t.DrainTimeout, defaultSpotDrainTimeout)
+}
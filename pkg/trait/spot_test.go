@@ -0,0 +1,284 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestConfigureSpotTraitIsDisabledByDefault(t *testing.T) {
+	spotTrait, environment := createNominalSpotTest()
+	spotTrait.Enabled = nil
+
+	configured, err := spotTrait.Configure(environment)
+	assert.Nil(t, err)
+	assert.False(t, configured)
+}
+
+func TestApplySpotTraitWithDefaults(t *testing.T) {
+	spotTrait, environment := createNominalSpotTest()
+
+	configured, err := spotTrait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+	err = spotTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	deployment := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	assert.NotNil(t, deployment)
+	assert.Equal(t, []corev1.Toleration{
+		{
+			Key:      "cloud.google.com/gke-spot",
+			Operator: corev1.TolerationOpEqual,
+			Value:    "true",
+			Effect:   corev1.TaintEffectNoSchedule,
+		},
+		{
+			Key:      "kubernetes.azure.com/scalesetpriority",
+			Operator: corev1.TolerationOpEqual,
+			Value:    "spot",
+			Effect:   corev1.TaintEffectNoSchedule,
+		},
+	}, deployment.Spec.Template.Spec.Tolerations)
+	assert.Equal(t, []corev1.PodReadinessGate{
+		{ConditionType: SpotNodeNotPreemptedCondition},
+	}, deployment.Spec.Template.Spec.ReadinessGates)
+	assert.Equal(t, pointer.Int64(30), deployment.Spec.Template.Spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, "20s", environment.ApplicationProperties["quarkus.shutdown.timeout"])
+	assert.Equal(t, "20", environment.ApplicationProperties["camel.main.shutdown-timeout"])
+
+	assert.Len(t, environment.PostProcessors, 1)
+	err = environment.PostProcessors[0](environment)
+	assert.Nil(t, err)
+	container := deployment.Spec.Template.Spec.Containers[0]
+	assert.NotNil(t, container.Lifecycle)
+	assert.Equal(t, []string{"sleep", "5"}, container.Lifecycle.PreStop.Exec.Command)
+}
+
+func TestApplySpotTraitWithCustomTaintsAndNoticePeriod(t *testing.T) {
+	spotTrait, environment := createNominalSpotTest()
+	spotTrait.Taints = []string{"eks.amazonaws.com/capacityType=SPOT:NoExecute:120"}
+	spotTrait.NoticePeriod = pointer.Int64(120)
+	spotTrait.DrainTimeout = pointer.Int64(90)
+
+	configured, err := spotTrait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+	err = spotTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	deployment := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	assert.Equal(t, []corev1.Toleration{
+		{
+			Key:               "eks.amazonaws.com/capacityType",
+			Operator:          corev1.TolerationOpEqual,
+			Value:             "SPOT",
+			Effect:            corev1.TaintEffectNoExecute,
+			TolerationSeconds: pointer.Int64(120),
+		},
+	}, deployment.Spec.Template.Spec.Tolerations)
+	assert.Equal(t, pointer.Int64(120), deployment.Spec.Template.Spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, "90s", environment.ApplicationProperties["quarkus.shutdown.timeout"])
+}
+
+func TestApplySpotTraitWithoutReadinessGate(t *testing.T) {
+	spotTrait, environment := createNominalSpotTest()
+	spotTrait.ReadinessGate = pointer.Bool(false)
+
+	err := spotTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	deployment := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	assert.Empty(t, deployment.Spec.Template.Spec.ReadinessGates)
+}
+
+func TestSpotNodePreemption(t *testing.T) {
+	spotTrait, environment := createNominalSpotTest()
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node",
+		},
+	}
+	assert.Empty(t, SpotNodePreemption(environment, node))
+
+	node.Spec.Taints = []corev1.Taint{
+		{Key: "cloud.google.com/gke-spot", Value: "true", Effect: corev1.TaintEffectNoSchedule},
+		{Key: "aws-node-termination-handler/spot-itn", Value: "1234", Effect: corev1.TaintEffectNoSchedule},
+	}
+	assert.Equal(t, "node node is tainted with aws-node-termination-handler/spot-itn", SpotNodePreemption(environment, node))
+
+	// The preemption taints of the executed trait prevail
+	spotTrait.PreemptionTaints = []string{"example.com/preempted"}
+	environment.ExecutedTraits = append(environment.ExecutedTraits, spotTrait)
+	assert.Empty(t, SpotNodePreemption(environment, node))
+
+	node.Spec.Unschedulable = true
+	assert.Equal(t, "node node is cordoned", SpotNodePreemption(environment, node))
+}
+
+func TestApplySpotTraitWithDeploymentGracefulShutdown(t *testing.T) {
+	spotTrait, environment := createNominalSpotTest()
+	deploymentTrait, _ := environment.Catalog.GetTrait("deployment").(*deploymentTrait)
+	deploymentTrait.PreStopPath = "/shutdown"
+	deploymentTrait.ShutdownTimeout = pointer.Int64(15)
+	deploymentTrait.TerminationGracePeriodSeconds = pointer.Int64(25)
+	environment.ApplicationProperties["quarkus.shutdown.timeout"] = "15s"
+
+	configured, err := spotTrait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+	err = spotTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	deployment := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	assert.Nil(t, deployment.Spec.Template.Spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, "15s", environment.ApplicationProperties["quarkus.shutdown.timeout"])
+	assert.Empty(t, environment.PostProcessors)
+}
+
+func TestConfigureSpotTraitWithInvalidOptionsFails(t *testing.T) {
+	testCases := []struct {
+		name      string
+		configure func(*spotTrait, *deploymentTrait)
+		error     string
+	}{
+		{
+			name: "invalid taint",
+			configure: func(st *spotTrait, _ *deploymentTrait) {
+				st.Taints = []string{"invalid"}
+			},
+			error: "could not match taint invalid",
+		},
+		{
+			name: "non positive notice period",
+			configure: func(st *spotTrait, _ *deploymentTrait) {
+				st.NoticePeriod = pointer.Int64(0)
+			},
+			error: "notice period (0s) must be positive",
+		},
+		{
+			name: "negative drain timeout",
+			configure: func(st *spotTrait, _ *deploymentTrait) {
+				st.DrainTimeout = pointer.Int64(-1)
+			},
+			error: "drain timeout (-1s) must not be negative",
+		},
+		{
+			name: "drain timeout exceeding notice period",
+			configure: func(st *spotTrait, _ *deploymentTrait) {
+				st.DrainTimeout = pointer.Int64(30)
+			},
+			error: "drain timeout (30s) must not exceed the notice period (30s), minus the 5s granted for the pods " +
+				"to be removed from the Service endpoints",
+		},
+		{
+			name: "deployment shutdown timeout exceeding notice period",
+			configure: func(_ *spotTrait, dt *deploymentTrait) {
+				dt.ShutdownTimeout = pointer.Int64(60)
+			},
+			error: "deployment trait shutdown timeout (60s) must not exceed the notice period (30s), minus the 5s " +
+				"granted for the pods to be removed from the Service endpoints",
+		},
+		{
+			name: "deployment termination grace period exceeding notice period",
+			configure: func(_ *spotTrait, dt *deploymentTrait) {
+				dt.TerminationGracePeriodSeconds = pointer.Int64(60)
+			},
+			error: "deployment trait termination grace period (60s) must not exceed the notice period (30s)",
+		},
+		{
+			name: "drain timeout along with deployment shutdown timeout",
+			configure: func(st *spotTrait, dt *deploymentTrait) {
+				st.DrainTimeout = pointer.Int64(10)
+				dt.ShutdownTimeout = pointer.Int64(10)
+			},
+			error: "drain timeout can't be set along with the deployment trait shutdown timeout",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			spotTrait, environment := createNominalSpotTest()
+			deploymentTrait, _ := environment.Catalog.GetTrait("deployment").(*deploymentTrait)
+			tc.configure(spotTrait, deploymentTrait)
+
+			configured, err := spotTrait.Configure(environment)
+			assert.False(t, configured)
+			assert.EqualError(t, err, tc.error)
+		})
+	}
+}
+
+func createNominalSpotTest() (*spotTrait, *Environment) {
+	trait, _ := newSpotTrait().(*spotTrait)
+	trait.Enabled = pointer.Bool(true)
+
+	environment := &Environment{
+		Catalog: NewCatalog(nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "namespace",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Platform: &v1.IntegrationPlatform{
+			Spec: v1.IntegrationPlatformSpec{
+				Cluster: v1.IntegrationPlatformClusterKubernetes,
+				Profile: v1.TraitProfileKubernetes,
+			},
+		},
+		Resources:             kubernetes.NewCollection(),
+		ApplicationProperties: make(map[string]string),
+	}
+	environment.Platform.ResyncStatusFullConfig()
+	environment.Resources.Add(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "integration-name",
+			Namespace: "namespace",
+			Labels: map[string]string{
+				v1.IntegrationLabel: "integration-name",
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: defaultContainerName,
+						},
+					},
+				},
+			},
+		},
+	})
+
+	return trait, environment
+}
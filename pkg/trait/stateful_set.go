@@ -0,0 +1,361 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/pointer"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util/label"
+)
+
+const (
+	statefulSetTraitID = "stateful-set"
+
+	// headlessServiceSuffix is appended to the integration name to name the Service governing the StatefulSet.
+	headlessServiceSuffix = "-headless"
+)
+
+type statefulSetTrait struct {
+	BaseTrait
+	traitv1.StatefulSetTrait `property:",squash"`
+}
+
+var _ ControllerStrategySelector = &statefulSetTrait{}
+
+// volumeClaim is a persistent volume claim template, parsed from its `name:size:/container/path[:storage-class]` representation.
+type volumeClaim struct {
+	name         string
+	size         resource.Quantity
+	path         string
+	storageClass string
+}
+
+func newStatefulSetTrait() Trait {
+	return &statefulSetTrait{
+		BaseTrait: NewBaseTrait(statefulSetTraitID, 1100),
+	}
+}
+
+func (t *statefulSetTrait) Configure(e *Environment) (bool, error) {
+	if !e.IntegrationInRunningPhases() {
+		return false, nil
+	}
+
+	if !pointer.BoolDeref(t.Enabled, true) {
+		e.Integration.Status.SetCondition(
+			v1.IntegrationConditionStatefulSetAvailable,
+			corev1.ConditionFalse,
+			v1.IntegrationConditionStatefulSetAvailableReason,
+			"explicitly disabled",
+		)
+		return false, nil
+	}
+
+	if e.IntegrationInPhase(v1.IntegrationPhaseRunning, v1.IntegrationPhaseError) {
+		condition := e.Integration.Status.GetCondition(v1.IntegrationConditionStatefulSetAvailable)
+		return condition != nil && condition.Status == corev1.ConditionTrue, nil
+	}
+
+	strategy, err := e.DetermineControllerStrategy()
+	if err != nil {
+		e.Integration.Status.SetErrorCondition(
+			v1.IntegrationConditionStatefulSetAvailable,
+			v1.IntegrationConditionStatefulSetAvailableReason,
+			err,
+		)
+
+		return false, err
+	}
+
+	if strategy != ControllerStrategyStatefulSet {
+		return false, nil
+	}
+
+	if _, err := t.parseVolumeClaims(); err != nil {
+		return false, err
+	}
+
+	return e.IntegrationInPhase(v1.IntegrationPhaseDeploying), nil
+}
+
+func (t *statefulSetTrait) SelectControllerStrategy(e *Environment) (*ControllerStrategy, error) {
+	if !pointer.BoolDeref(t.Enabled, false) {
+		return nil, nil
+	}
+	statefulSetStrategy := ControllerStrategyStatefulSet
+	return &statefulSetStrategy, nil
+}
+
+func (t *statefulSetTrait) ControllerStrategySelectorOrder() int {
+	return 50
+}
+
+func (t *statefulSetTrait) Apply(e *Environment) error {
+	claims, err := t.parseVolumeClaims()
+	if err != nil {
+		return err
+	}
+
+	service := t.getHeadlessServiceFor(e)
+	e.Resources.Add(service)
+
+	statefulSet := t.getStatefulSetFor(e, service.Name, claims)
+	e.Resources.Add(statefulSet)
+
+	if err := t.deleteOnImmutableFieldsChange(e, statefulSet); err != nil {
+		return err
+	}
+
+	if len(claims) > 0 {
+		// The integration container is only created by the container trait
+		e.PostProcessors = append(e.PostProcessors, func(e *Environment) error {
+			return mountVolumeClaims(e, claims)
+		})
+	}
+
+	e.Integration.Status.SetCondition(
+		v1.IntegrationConditionStatefulSetAvailable,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionStatefulSetAvailableReason,
+		fmt.Sprintf("stateful set name is %s", statefulSet.Name),
+	)
+
+	return nil
+}
+
+// IsPlatformTrait overrides base class method.
+func (t *statefulSetTrait) IsPlatformTrait() bool {
+	return true
+}
+
+func (t *statefulSetTrait) parseVolumeClaims() ([]volumeClaim, error) {
+	claims := make([]volumeClaim, 0, len(t.VolumeClaims))
+	names := make(map[string]bool)
+	for _, c := range t.VolumeClaims {
+		parts := strings.Split(c, ":")
+		if len(parts) != 3 && len(parts) != 4 {
+			return nil, fmt.Errorf("invalid volume claim %q: it must be in the name:size:/container/path[:storage-class] form", c)
+		}
+		name, path := parts[0], parts[2]
+		if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid volume claim %q, the name %q is invalid: %s", c, name, strings.Join(errs, ", "))
+		}
+		if names[name] {
+			return nil, fmt.Errorf("invalid volume claim %q: the name %q is already used", c, name)
+		}
+		names[name] = true
+		size, err := resource.ParseQuantity(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid volume claim %q: %w", c, err)
+		}
+		if size.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid volume claim %q: the size must be positive", c)
+		}
+		if !filepath.IsAbs(path) {
+			return nil, fmt.Errorf("invalid volume claim %q: the container path must be absolute", c)
+		}
+		claim := volumeClaim{name: name, size: size, path: path}
+		if len(parts) == 4 {
+			claim.storageClass = parts[3]
+		}
+		claims = append(claims, claim)
+	}
+	return claims, nil
+}
+
+func (t *statefulSetTrait) getHeadlessServiceFor(e *Environment) *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name + headlessServiceSuffix,
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+				// Not to be mistaken for the Service exposing the integration
+				"camel.apache.org/service.type": v1.ServiceTypeAdditional,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+		},
+	}
+}
+
+func (t *statefulSetTrait) getStatefulSetFor(e *Environment, serviceName string, claims []volumeClaim) *appsv1.StatefulSet {
+	// create a copy to avoid sharing the underlying annotation map
+	annotations := make(map[string]string)
+	if e.Integration.Annotations != nil {
+		for k, v := range filterTransferableAnnotations(e.Integration.Annotations) {
+			annotations[k] = v
+		}
+	}
+
+	// StatefulSet replicas defaults to 1, so we avoid forcing
+	// an update to nil that will result to another update cycle
+	// back to that default value by the StatefulSet controller.
+	replicas := e.Integration.Spec.Replicas
+	if replicas == nil {
+		replicas = pointer.Int32(1)
+	}
+
+	statefulSet := appsv1.StatefulSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "StatefulSet",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+			Annotations: annotations,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:            replicas,
+			ServiceName:         serviceName,
+			PodManagementPolicy: t.PodManagementPolicy,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					v1.IntegrationLabel: e.Integration.Name,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      label.AddLabels(e.Integration.Name),
+					Annotations: annotations,
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: e.Integration.Spec.ServiceAccountName,
+				},
+			},
+		},
+	}
+
+	for _, claim := range claims {
+		pvc := corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: claim.name,
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: claim.size,
+					},
+				},
+			},
+		}
+		if claim.storageClass != "" {
+			pvc.Spec.StorageClassName = pointer.String(claim.storageClass)
+		}
+		statefulSet.Spec.VolumeClaimTemplates = append(statefulSet.Spec.VolumeClaimTemplates, pvc)
+	}
+
+	return &statefulSet
+}
+
+// deleteOnImmutableFieldsChange deletes the existing StatefulSet when its service name or volume claim templates,
+// that cannot be updated, have changed, so that it's re-created by the deployer. The persistent volume claims
+// created from the templates are retained.
+func (t *statefulSetTrait) deleteOnImmutableFieldsChange(e *Environment, statefulSet *appsv1.StatefulSet) error {
+	if t.Client == nil {
+		return nil
+	}
+	// The resources are renamed by the deployer trait
+	name, serviceName := statefulSet.Name, statefulSet.Spec.ServiceName
+	if deployer, ok := e.GetTrait(deployerTraitID).(*deployerTrait); ok {
+		name, serviceName = deployer.resourceName(name), deployer.resourceName(serviceName)
+	}
+
+	existing := &appsv1.StatefulSet{}
+	err := t.Client.Get(e.Ctx, ctrl.ObjectKey{Namespace: statefulSet.Namespace, Name: name}, existing)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if existing.Labels[v1.IntegrationLabel] != e.Integration.Name ||
+		existing.Spec.ServiceName == serviceName && !volumeClaimTemplatesChanged(existing.Spec.VolumeClaimTemplates, statefulSet.Spec.VolumeClaimTemplates) {
+		return nil
+	}
+
+	t.L.ForIntegration(e.Integration).Infof("Re-creating StatefulSet %s, as its service name or volume claims have changed", name)
+	err = t.Client.Delete(e.Ctx, existing, ctrl.Preconditions{UID: &existing.UID}, ctrl.PropagationPolicy(metav1.DeletePropagationBackground))
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("unable to delete StatefulSet %s: %w", name, err)
+	}
+	return nil
+}
+
+// volumeClaimTemplatesChanged compares the generated fields of the volume claim templates, so that the fields
+// defaulted server-side are ignored.
+func volumeClaimTemplatesChanged(existing []corev1.PersistentVolumeClaim, target []corev1.PersistentVolumeClaim) bool {
+	if len(existing) != len(target) {
+		return true
+	}
+	for i := range target {
+		e, t := existing[i], target[i]
+		if e.Name != t.Name ||
+			!reflect.DeepEqual(e.Spec.AccessModes, t.Spec.AccessModes) ||
+			pointer.StringDeref(e.Spec.StorageClassName, "") != pointer.StringDeref(t.Spec.StorageClassName, "") {
+			return true
+		}
+		size, targetSize := e.Spec.Resources.Requests[corev1.ResourceStorage], t.Spec.Resources.Requests[corev1.ResourceStorage]
+		if size.Cmp(targetSize) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// mountVolumeClaims mounts the volumes, that the StatefulSet controller creates from the claim templates, into the integration container.
+func mountVolumeClaims(e *Environment, claims []volumeClaim) error {
+	container := e.GetIntegrationContainer()
+	if container == nil {
+		return fmt.Errorf("unable to find integration container: %s", e.Integration.Name)
+	}
+	for _, claim := range claims {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      claim.name,
+			MountPath: claim.path,
+		})
+	}
+	return nil
+}
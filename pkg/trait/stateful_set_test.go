@@ -0,0 +1,258 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestStatefulSetTraitWithDeployerKind(t *testing.T) {
+	env := createTestEnv(t, v1.IntegrationPlatformClusterKubernetes, "from('timer:tick').to('log:info')")
+	env.Integration.Spec.Traits.Deployer = &traitv1.DeployerTrait{
+		Kind: string(ControllerStrategyStatefulSet),
+	}
+
+	res := processTestEnv(t, env)
+
+	assert.NotNil(t, env.GetTrait(statefulSetTraitID))
+	assert.Nil(t, env.GetTrait("deployment"))
+	assert.Nil(t, res.GetDeploymentForIntegration(env.Integration))
+
+	statefulSet := res.GetStatefulSet(func(s *appsv1.StatefulSet) bool {
+		return s.Name == TestDeploymentName
+	})
+	assert.NotNil(t, statefulSet)
+	assert.Equal(t, pointer.Int32(1), statefulSet.Spec.Replicas)
+	assert.Equal(t, TestDeploymentName+"-headless", statefulSet.Spec.ServiceName)
+	assert.Equal(t, TestDeploymentName, statefulSet.Spec.Selector.MatchLabels[v1.IntegrationLabel])
+	assert.Equal(t, TestDeploymentName, statefulSet.Spec.Template.Labels[v1.IntegrationLabel])
+	assert.Len(t, statefulSet.Spec.Template.Spec.Containers, 1)
+	assert.Empty(t, statefulSet.Spec.VolumeClaimTemplates)
+
+	service := res.GetService(func(s *corev1.Service) bool {
+		return s.Name == TestDeploymentName+"-headless"
+	})
+	assert.NotNil(t, service)
+	assert.Equal(t, corev1.ClusterIPNone, service.Spec.ClusterIP)
+	assert.Equal(t, map[string]string{v1.IntegrationLabel: TestDeploymentName}, service.Spec.Selector)
+	assert.Equal(t, v1.ServiceTypeAdditional, service.Labels["camel.apache.org/service.type"])
+
+	condition := env.Integration.Status.GetCondition(v1.IntegrationConditionStatefulSetAvailable)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "stateful set name is "+TestDeploymentName, condition.Message)
+	assert.False(t, env.Integration.IsConditionTrue(v1.IntegrationConditionDeploymentAvailable))
+}
+
+func TestStatefulSetTraitWithVolumeClaims(t *testing.T) {
+	env := createTestEnv(t, v1.IntegrationPlatformClusterKubernetes, "from('timer:tick').to('log:info')")
+	env.Integration.Spec.Replicas = pointer.Int32(3)
+	env.Integration.Spec.Traits.StatefulSet = &traitv1.StatefulSetTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+		PodManagementPolicy: appsv1.ParallelPodManagement,
+		VolumeClaims:        []string{"data:1Gi:/var/data", "cache:500Mi:/var/cache:fast"},
+	}
+
+	res := processTestEnv(t, env)
+
+	statefulSet := res.GetStatefulSet(func(s *appsv1.StatefulSet) bool {
+		return s.Name == TestDeploymentName
+	})
+	assert.NotNil(t, statefulSet)
+	assert.Equal(t, pointer.Int32(3), statefulSet.Spec.Replicas)
+	assert.Equal(t, appsv1.ParallelPodManagement, statefulSet.Spec.PodManagementPolicy)
+
+	claims := statefulSet.Spec.VolumeClaimTemplates
+	assert.Len(t, claims, 2)
+	assert.Equal(t, "data", claims[0].Name)
+	assert.Equal(t, resource.MustParse("1Gi"), claims[0].Spec.Resources.Requests[corev1.ResourceStorage])
+	assert.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}, claims[0].Spec.AccessModes)
+	assert.Nil(t, claims[0].Spec.StorageClassName)
+	assert.Equal(t, "cache", claims[1].Name)
+	assert.Equal(t, resource.MustParse("500Mi"), claims[1].Spec.Resources.Requests[corev1.ResourceStorage])
+	assert.Equal(t, pointer.String("fast"), claims[1].Spec.StorageClassName)
+
+	container := env.GetIntegrationContainer()
+	assert.NotNil(t, container)
+	assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{Name: "data", MountPath: "/var/data"})
+	assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{Name: "cache", MountPath: "/var/cache"})
+}
+
+func TestStatefulSetTraitWithService(t *testing.T) {
+	env := createTestEnv(t, v1.IntegrationPlatformClusterKubernetes, "from('platform-http:/hello').to('log:info')")
+	env.Integration.Spec.Traits.Deployer = &traitv1.DeployerTrait{
+		Kind: string(ControllerStrategyStatefulSet),
+	}
+
+	res := processTestEnv(t, env)
+
+	assert.NotNil(t, env.GetTrait(serviceTraitID))
+	headless := res.GetService(func(s *corev1.Service) bool {
+		return s.Name == TestDeploymentName+"-headless"
+	})
+	assert.NotNil(t, headless)
+	service := res.GetServiceForIntegration(env.Integration)
+	assert.NotNil(t, service)
+	assert.Equal(t, TestDeploymentName, service.Name)
+	assert.NotEqual(t, corev1.ClusterIPNone, service.Spec.ClusterIP)
+	assert.Len(t, service.Spec.Ports, 1)
+}
+
+func TestStatefulSetTraitWithChangedVolumeClaims(t *testing.T) {
+	testCases := []struct {
+		name         string
+		volumeClaims []string
+		deleted      bool
+	}{
+		{name: "unchanged", volumeClaims: []string{"data:1Gi:/var/data"}},
+		{name: "mount path changed", volumeClaims: []string{"data:1Gi:/var/other"}},
+		{name: "size changed", volumeClaims: []string{"data:2Gi:/var/data"}, deleted: true},
+		{name: "claim added", volumeClaims: []string{"data:1Gi:/var/data", "cache:1Gi:/var/cache"}, deleted: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			existing := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      TestDeploymentName,
+					Labels: map[string]string{
+						v1.IntegrationLabel: TestDeploymentName,
+					},
+				},
+				Spec: appsv1.StatefulSetSpec{
+					ServiceName: TestDeploymentName + "-headless",
+					VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "data",
+							},
+							Spec: corev1.PersistentVolumeClaimSpec{
+								AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceStorage: resource.MustParse("1024Mi"),
+									},
+								},
+								// Defaulted server-side
+								VolumeMode: &[]corev1.PersistentVolumeMode{corev1.PersistentVolumeFilesystem}[0],
+							},
+						},
+					},
+				},
+			}
+			c, err := test.NewFakeClient(existing)
+			assert.Nil(t, err)
+			env := createTestEnv(t, v1.IntegrationPlatformClusterKubernetes, "from('timer:tick').to('log:info')")
+			env.Client = c
+			env.Integration.Namespace = "ns"
+			env.Integration.Spec.Traits.StatefulSet = &traitv1.StatefulSetTrait{
+				Trait: traitv1.Trait{
+					Enabled: pointer.Bool(true),
+				},
+				VolumeClaims: tc.volumeClaims,
+			}
+
+			err = NewCatalog(c).apply(env)
+			assert.Nil(t, err)
+
+			err = c.Get(env.Ctx, ctrl.ObjectKeyFromObject(existing), &appsv1.StatefulSet{})
+			if tc.deleted {
+				assert.True(t, k8serrors.IsNotFound(err))
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestStatefulSetTraitWithInvalidVolumeClaims(t *testing.T) {
+	testCases := []struct {
+		claim string
+		err   string
+	}{
+		{claim: "data:1Gi", err: `invalid volume claim "data:1Gi": it must be in the name:size:/container/path[:storage-class] form`},
+		{claim: "Data:1Gi:/var/data", err: `invalid volume claim "Data:1Gi:/var/data", the name "Data" is invalid`},
+		{claim: "data:big:/var/data", err: `invalid volume claim "data:big:/var/data": quantities must match the regular expression`},
+		{claim: "data:0:/var/data", err: `invalid volume claim "data:0:/var/data": the size must be positive`},
+		{claim: "data:1Gi:var/data", err: `invalid volume claim "data:1Gi:var/data": the container path must be absolute`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.claim, func(t *testing.T) {
+			env := createTestEnv(t, v1.IntegrationPlatformClusterKubernetes, "from('timer:tick').to('log:info')")
+			env.Integration.Spec.Traits.StatefulSet = &traitv1.StatefulSetTrait{
+				Trait: traitv1.Trait{
+					Enabled: pointer.Bool(true),
+				},
+				VolumeClaims: []string{tc.claim},
+			}
+
+			err := NewTraitTestCatalog().apply(env)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestStatefulSetTraitWithHPA(t *testing.T) {
+	env := createTestEnv(t, v1.IntegrationPlatformClusterKubernetes, "from('timer:tick').to('log:info')")
+	env.Integration.Spec.Traits.Deployer = &traitv1.DeployerTrait{
+		Kind: string(ControllerStrategyStatefulSet),
+	}
+	env.Integration.Spec.Traits.HPA = &traitv1.HPATrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(true),
+		},
+		MaxReplicas: pointer.Int32(5),
+	}
+
+	res := processTestEnv(t, env)
+
+	statefulSet := res.GetStatefulSet(func(s *appsv1.StatefulSet) bool {
+		return s.Name == TestDeploymentName
+	})
+	assert.NotNil(t, statefulSet)
+	assert.Nil(t, statefulSet.Spec.Replicas)
+
+	hpa := getHorizontalPodAutoscaler(*env)
+	assert.NotNil(t, hpa)
+	assert.Equal(t, autoscalingv2.CrossVersionObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       "StatefulSet",
+		Name:       TestDeploymentName,
+	}, hpa.Spec.ScaleTargetRef)
+}
@@ -19,6 +19,7 @@ package trait
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -55,17 +56,25 @@ func Apply(ctx context.Context, c client.Client, integration *v1.Integration, ki
 	environment.Catalog = catalog
 
 	// invoke the trait framework to determine the needed resources
-	if err := catalog.apply(environment); err != nil {
+	begin := time.Now()
+	err = catalog.apply(environment)
+	observeIntegrationStage(integration, traitsStage, begin, err)
+	if err != nil {
 		return nil, errors.Wrap(err, "error during trait customization")
 	}
 
 	// execute post actions registered by traits
+	begin = time.Now()
 	for _, postAction := range environment.PostActions {
-		err := postAction(environment)
+		err = postAction(environment)
 		if err != nil {
-			return nil, errors.Wrap(err, "error executing post actions")
+			break
 		}
 	}
+	observeIntegrationStage(integration, deployStage, begin, err)
+	if err != nil {
+		return nil, errors.Wrap(err, "error executing post actions")
+	}
 
 	switch {
 	case integration != nil:
@@ -78,6 +87,51 @@ func Apply(ctx context.Context, c client.Client, integration *v1.Integration, ki
 	return environment, nil
 }
 
+// DryRun returns the resources the traits generate for the integration, as if it were deployed, without applying them.
+// The image of the integration kit is used if it's ready, or a placeholder image otherwise. The write operations
+// the traits may perform with the client are executed in dry-run mode.
+func DryRun(ctx context.Context, c client.Client, integration *v1.Integration) (*kubernetes.Collection, error) {
+	environment, err := newEnvironment(ctx, &dryRunModeClient{Client: c}, integration, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating trait environment")
+	}
+
+	resources, err := generateDryRunResources(environment)
+	if err != nil {
+		return nil, errors.Wrap(err, "error during trait customization")
+	}
+	return resources, nil
+}
+
+// dryRunModeClient executes the write operations in dry-run mode.
+type dryRunModeClient struct {
+	client.Client
+}
+
+func (c *dryRunModeClient) Create(ctx context.Context, obj k8sclient.Object, opts ...k8sclient.CreateOption) error {
+	return c.Client.Create(ctx, obj, append(opts, k8sclient.DryRunAll)...)
+}
+
+func (c *dryRunModeClient) Update(ctx context.Context, obj k8sclient.Object, opts ...k8sclient.UpdateOption) error {
+	return c.Client.Update(ctx, obj, append(opts, k8sclient.DryRunAll)...)
+}
+
+func (c *dryRunModeClient) Patch(ctx context.Context, obj k8sclient.Object, patch k8sclient.Patch, opts ...k8sclient.PatchOption) error {
+	return c.Client.Patch(ctx, obj, patch, append(opts, k8sclient.DryRunAll)...)
+}
+
+func (c *dryRunModeClient) Delete(ctx context.Context, obj k8sclient.Object, opts ...k8sclient.DeleteOption) error {
+	return c.Client.Delete(ctx, obj, append(opts, k8sclient.DryRunAll)...)
+}
+
+func (c *dryRunModeClient) DeleteAllOf(ctx context.Context, obj k8sclient.Object, opts ...k8sclient.DeleteAllOfOption) error {
+	return c.Client.DeleteAllOf(ctx, obj, append(opts, k8sclient.DryRunAll)...)
+}
+
+func (c *dryRunModeClient) Status() k8sclient.StatusWriter {
+	return k8sclient.NewDryRunClient(c.Client).Status()
+}
+
 // newEnvironment creates a Environment from the given data.
 func newEnvironment(ctx context.Context, c client.Client, integration *v1.Integration, kit *v1.IntegrationKit) (*Environment, error) {
 	if integration == nil && kit == nil {
@@ -79,6 +79,10 @@ func (c *Catalog) configureTraits(traits interface{}) error {
 	// Addons
 	for id, trait := range traitMap["addons"] {
 		if addons, ok := trait.(map[string]interface{}); ok {
+			// Unlike the built-in traits, the addons aren't validated by the CRD schema
+			if c.GetTrait(id) == nil {
+				return fmt.Errorf("unknown addon trait %q", id)
+			}
 			if err := c.configureTrait(id, addons); err != nil {
 				return err
 			}
@@ -151,11 +155,11 @@ func (c *Catalog) configureTraitsFromAnnotations(annotations map[string]string)
 func (c *Catalog) configureFromOptions(traits map[string]map[string]interface{}) error {
 	for id, config := range traits {
 		t := c.GetTrait(id)
-		if t != nil {
-			err := configureTrait(id, config, t)
-			if err != nil {
-				return err
-			}
+		if t == nil {
+			return fmt.Errorf("unknown trait %q", id)
+		}
+		if err := configureTrait(id, config, t); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -112,6 +112,40 @@ func TestFailOnWrongTraitAnnotations(t *testing.T) {
 	assert.Error(t, c.Configure(&env))
 }
 
+func TestFailOnUnknownTraitAnnotations(t *testing.T) {
+	env := Environment{
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					"trait.camel.apache.org/missing.enabled": "true",
+				},
+			},
+			Spec: v1.IntegrationSpec{
+				Profile: v1.TraitProfileKubernetes,
+			},
+		},
+	}
+	c := NewCatalog(nil)
+	assert.EqualError(t, c.Configure(&env), `unknown trait "missing"`)
+}
+
+func TestFailOnUnknownAddonTrait(t *testing.T) {
+	env := Environment{
+		Integration: &v1.Integration{
+			Spec: v1.IntegrationSpec{
+				Profile: v1.TraitProfileKubernetes,
+				Traits: v1.Traits{
+					Addons: map[string]v1.AddonTrait{
+						"missing": ToAddonTrait(t, map[string]interface{}{"enabled": true}),
+					},
+				},
+			},
+		},
+	}
+	c := NewCatalog(nil)
+	assert.EqualError(t, c.Configure(&env), `unknown addon trait "missing"`)
+}
+
 func TestTraitConfigurationOverrideRulesFromAnnotations(t *testing.T) {
 	env := Environment{
 		Platform: &v1.IntegrationPlatform{
@@ -28,20 +28,27 @@ func init() {
 	AddToTraits(newDependenciesTrait)
 	AddToTraits(newDeployerTrait)
 	AddToTraits(newDeploymentTrait)
+	AddToTraits(newDNSTrait)
+	AddToTraits(newDrainTrait)
 	AddToTraits(newEnvironmentTrait)
 	AddToTraits(newErrorHandlerTrait)
 	AddToTraits(newGCTrait)
 	AddToTraits(newHealthTrait)
+	AddToTraits(newHPATrait)
+	AddToTraits(newHTTPTrait)
+	AddToTraits(newInfinispanTrait)
 	AddToTraits(NewInitTrait)
 	AddToTraits(newIngressTrait)
 	AddToTraits(newIstioTrait)
 	AddToTraits(newJolokiaTrait)
 	AddToTraits(newJvmTrait)
+	AddToTraits(newKafkaTrait)
 	AddToTraits(newKameletsTrait)
 	AddToTraits(newKnativeTrait)
 	AddToTraits(newKnativeServiceTrait)
 	AddToTraits(newLoggingTraitTrait)
 	AddToTraits(newMountTrait)
+	AddToTraits(newNetworkPolicyTrait)
 	AddToTraits(newOpenAPITrait)
 	AddToTraits(newOwnerTrait)
 	AddToTraits(newPdbTrait)
@@ -52,8 +59,13 @@ func init() {
 	AddToTraits(newQuarkusTrait)
 	AddToTraits(newRegistryTrait)
 	AddToTraits(newRouteTrait)
+	AddToTraits(newSecurityContextTrait)
 	AddToTraits(newServiceTrait)
 	AddToTraits(newServiceBindingTrait)
+	AddToTraits(newServiceCATrait)
+	AddToTraits(newSpotTrait)
+	AddToTraits(newStatefulSetTrait)
 	AddToTraits(newTolerationTrait)
+	AddToTraits(newValidationTrait)
 	// ^^ Declaration order is not important, but let's keep them sorted for debugging.
 }
@@ -18,6 +18,7 @@ limitations under the License.
 package trait
 
 import (
+	"context"
 	"path/filepath"
 	"testing"
 
@@ -27,13 +28,17 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/pkg/util/camel"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/apache/camel-k/pkg/util/test"
 )
 
 const (
@@ -329,7 +334,7 @@ func TestOnlySomeTraitsArePlatform(t *testing.T) {
 	c := NewTraitTestCatalog()
 	platformTraits := []string{
 		"builder", "camel", "jvm", "runtime", "container", "mount", "dependencies", "deployer",
-		"deployment", "environment", "error-handler", "kamelets", "openapi", "owner", "platform", "quarkus",
+		"deployment", "environment", "error-handler", "kamelets", "openapi", "owner", "platform", "quarkus", "stateful-set",
 	}
 
 	for _, trait := range c.AllTraits() {
@@ -354,6 +359,46 @@ func TestOnlySomeTraitsDoNotRequireIntegrationPlatform(t *testing.T) {
 	}
 }
 
+func TestDryRun(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+	pl.Status.Cluster = v1.IntegrationPlatformClusterKubernetes
+	pl.Status.Build.RuntimeVersion = catalog.Runtime.Version
+	camelCatalog := v1.NewCamelCatalogWithSpecs("ns", "camel-catalog", catalog.CamelCatalogSpec)
+	kit := v1.NewIntegrationKit("ns", "my-kit")
+	kit.Status.Phase = v1.IntegrationKitPhaseReady
+	kit.Status.Image = "registry.example.com/ns/my-kit:1"
+
+	integration := v1.NewIntegration("ns", "my-it")
+	integration.Spec.Sources = []v1.SourceSpec{
+		{
+			DataSpec: v1.DataSpec{
+				Name:    "route.yaml",
+				Content: "- from:\n    uri: timer:tick\n    steps:\n      - to: log:info\n",
+			},
+		},
+	}
+	integration.Status.Phase = v1.IntegrationPhaseRunning
+	integration.SetIntegrationKit(kit)
+
+	c, err := test.NewFakeClient(&pl, &camelCatalog, kit, &integration)
+	assert.Nil(t, err)
+
+	resources, err := DryRun(context.TODO(), c, &integration)
+	assert.Nil(t, err)
+
+	deployment := resources.GetDeploymentForIntegration(&integration)
+	assert.NotNil(t, deployment)
+	assert.Equal(t, "registry.example.com/ns/my-kit:1", deployment.Spec.Template.Spec.Containers[0].Image)
+	// The integration is left untouched, and the resources are not created
+	assert.Equal(t, v1.IntegrationPhaseRunning, integration.Status.Phase)
+	err = c.Get(context.TODO(), ctrl.ObjectKeyFromObject(deployment), &appsv1.Deployment{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
 func findVolume(vols []corev1.Volume, condition func(corev1.Volume) bool) *corev1.Volume {
 	for _, v := range vols {
 		v := v
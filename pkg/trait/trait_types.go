@@ -23,6 +23,7 @@ import (
 	"path"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -206,6 +207,7 @@ const (
 	ControllerStrategyDeployment     ControllerStrategy = "deployment"
 	ControllerStrategyKnativeService ControllerStrategy = "knative-service"
 	ControllerStrategyCronJob        ControllerStrategy = "cron-job"
+	ControllerStrategyStatefulSet    ControllerStrategy = "stateful-set"
 
 	DefaultControllerStrategy = ControllerStrategyDeployment
 )
@@ -348,6 +350,14 @@ func (e *Environment) GetIntegrationPodSpec() *corev1.PodSpec {
 		return &cronJob.Spec.JobTemplate.Spec.Template.Spec
 	}
 
+	// StatefulSet
+	statefulSet := e.Resources.GetStatefulSet(func(s *appsv1.StatefulSet) bool {
+		return s.Name == e.Integration.Name
+	})
+	if statefulSet != nil {
+		return &statefulSet.Spec.Template.Spec
+	}
+
 	return nil
 }
 
@@ -401,6 +411,15 @@ func (e *Environment) computeApplicationProperties() (*corev1.ConfigMap, error)
 	return nil, nil
 }
 
+// setShutdownTimeout sets the time in seconds granted to the runtime to complete the in-flight exchanges on shutdown.
+func (e *Environment) setShutdownTimeout(seconds int64) {
+	if e.ApplicationProperties == nil {
+		e.ApplicationProperties = make(map[string]string)
+	}
+	e.ApplicationProperties["quarkus.shutdown.timeout"] = fmt.Sprintf("%ds", seconds)
+	e.ApplicationProperties["camel.main.shutdown-timeout"] = strconv.FormatInt(seconds, 10)
+}
+
 func (e *Environment) addSourcesProperties() {
 	if e.ApplicationProperties == nil {
 		e.ApplicationProperties = make(map[string]string)
@@ -30,6 +30,7 @@ import (
 	"github.com/scylladb/go-set/strset"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -182,6 +183,22 @@ func keyValuePairArrayAsStringMap(pairs []string) (map[string]string, error) {
 	return m, nil
 }
 
+// parseAnnotations parses the annotations in the form <key>=<value>, that are set on the given kind of resources.
+func parseAnnotations(kind string, annotations []string) (map[string]string, error) {
+	m := make(map[string]string, len(annotations))
+	for _, annotation := range annotations {
+		kv := strings.SplitN(annotation, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid %s annotation %q, expected <key>=<value>", kind, annotation)
+		}
+		if errs := validation.IsQualifiedName(kv[0]); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid %s annotation key %s: %s", kind, kv[0], strings.Join(errs, ", "))
+		}
+		m[kv[0]] = kv[1]
+	}
+	return m, nil
+}
+
 // filterTransferableAnnotations returns a map containing annotations that are meaningful for being transferred to child resources.
 func filterTransferableAnnotations(annotations map[string]string) map[string]string {
 	res := make(map[string]string)
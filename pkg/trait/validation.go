@@ -0,0 +1,276 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	yaml2 "gopkg.in/yaml.v2"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util/gzip"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/apache/camel-k/pkg/util/patch"
+)
+
+const (
+	// dryRunImageName is the name of the placeholder container image of the resources validated in dry-run mode.
+	dryRunImageName = "camel-k-dry-run:latest"
+	// defaultDryRunImage is the placeholder container image, when no registry is configured.
+	defaultDryRunImage = "docker.io/apache/" + dryRunImageName
+)
+
+type validationTrait struct {
+	BaseTrait
+	traitv1.ValidationTrait `property:",squash"`
+}
+
+func newValidationTrait() Trait {
+	return &validationTrait{
+		BaseTrait: NewBaseTrait("validation", 150),
+	}
+}
+
+func (t *validationTrait) Configure(e *Environment) (bool, error) {
+	if e.Integration == nil || !pointer.BoolDeref(t.Enabled, true) {
+		return false, nil
+	}
+
+	if !pointer.BoolDeref(t.Sources, true) && !pointer.BoolDeref(t.DryRun, false) {
+		return false, nil
+	}
+
+	return e.IntegrationInPhase(v1.IntegrationPhaseInitialization), nil
+}
+
+func (t *validationTrait) Apply(e *Environment) error {
+	if pointer.BoolDeref(t.Sources, true) {
+		sources, err := kubernetes.ResolveIntegrationSources(e.Ctx, e.Client, e.Integration, e.Resources)
+		if err != nil {
+			return err
+		}
+
+		for _, source := range sources {
+			if err := validateSource(source); err != nil {
+				return err
+			}
+		}
+	}
+
+	if pointer.BoolDeref(t.DryRun, false) {
+		// The resources depend on the integration status, e.g., the dependencies,
+		// so they are generated once all the traits are applied
+		e.PostProcessors = append(e.PostProcessors, validateResourcesAdmission)
+	}
+
+	return nil
+}
+
+// validateResourcesAdmission validates the resources, the traits would generate for the integration to be deployed,
+// with the API server in dry-run mode, and reports the outcome in the integration status.
+func validateResourcesAdmission(e *Environment) error {
+	resources, err := generateDryRunResources(e)
+	if err != nil {
+		return reportResourcesAdmission(e, fmt.Sprintf("unable to generate the integration resources: %v", err))
+	}
+
+	var failures []string
+	for _, resource := range resources.Items() {
+		target, err := patch.ApplyPatch(resource)
+		if err != nil {
+			return err
+		}
+		err = e.Client.Patch(e.Ctx, target, ctrl.Apply, ctrl.ForceOwnership, ctrl.FieldOwner("camel-k-operator"), ctrl.DryRunAll)
+		switch {
+		case err == nil:
+			continue
+		case isIncompatibleServerError(err):
+			return reportResourcesAdmission(e, "the dry-run validation requires server-side apply, that's not supported by the API server")
+		default:
+			failures = append(failures, fmt.Sprintf("%s %s: %v", resource.GetObjectKind().GroupVersionKind().Kind, resource.GetName(), err))
+		}
+	}
+
+	if len(failures) > 0 {
+		return reportResourcesAdmission(e, fmt.Sprintf("integration resources not admitted: %s", strings.Join(failures, "; ")))
+	}
+
+	e.Integration.Status.SetCondition(
+		v1.IntegrationConditionResourcesAdmitted,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionResourcesAdmittedReason,
+		fmt.Sprintf("%d resources admitted in dry-run mode", len(resources.Items())),
+	)
+
+	return nil
+}
+
+func reportResourcesAdmission(e *Environment, message string) error {
+	e.Integration.Status.SetCondition(
+		v1.IntegrationConditionResourcesAdmitted,
+		corev1.ConditionFalse,
+		v1.IntegrationConditionResourcesNotAdmittedReason,
+		message,
+	)
+
+	return errors.New(message)
+}
+
+// generateDryRunResources applies the traits to a copy of the integration, as if it were deployed, with its integration
+// kit, or a kit that's not built yet, and returns the generated resources. The post actions, that create or delete resources,
+// are not executed.
+func generateDryRunResources(e *Environment) (*kubernetes.Collection, error) {
+	integration := e.Integration.DeepCopy()
+	integration.Status.Phase = v1.IntegrationPhaseDeploying
+
+	// The image of the integration kit is used if it's ready, or a placeholder image otherwise
+	kit := e.IntegrationKit
+	if kit == nil || kit.Status.Phase != v1.IntegrationKitPhaseReady || kit.Status.Image == "" {
+		kit = v1.NewIntegrationKit(integration.GetIntegrationKitNamespace(e.Platform), integration.Name)
+		kit.Status.Phase = v1.IntegrationKitPhaseReady
+		kit.Status.Image = dryRunImage(e)
+	}
+	integration.SetIntegrationKit(kit)
+
+	// The write operations the traits may perform are executed in dry-run mode
+	c := e.Client
+	if _, ok := c.(*dryRunModeClient); !ok {
+		c = &dryRunModeClient{Client: c}
+	}
+	env := &Environment{
+		Ctx:                   e.Ctx,
+		Platform:              e.Platform,
+		Client:                c,
+		Catalog:               NewCatalog(c),
+		CamelCatalog:          e.CamelCatalog,
+		IntegrationKit:        kit,
+		Integration:           integration,
+		ExecutedTraits:        make([]Trait, 0),
+		Resources:             kubernetes.NewCollection(),
+		EnvVars:               make([]corev1.EnvVar, 0),
+		ApplicationProperties: make(map[string]string),
+	}
+	if err := env.Catalog.apply(env); err != nil {
+		return nil, err
+	}
+
+	// The resources are otherwise renamed by the deployer trait post action
+	if deployer, ok := env.GetTrait(deployerTraitID).(*deployerTrait); ok {
+		if err := deployer.renameResources(env); err != nil {
+			return nil, err
+		}
+	}
+
+	return env.Resources, nil
+}
+
+// dryRunImage returns the fully qualified placeholder image, in the registry the integration kit images are published to,
+// so that the resources validated in dry-run mode satisfy the admission policies that restrict the image registries.
+func dryRunImage(e *Environment) string {
+	if e.Platform == nil || e.Platform.Status.Build.Registry.Address == "" {
+		return defaultDryRunImage
+	}
+	organization := e.Platform.Status.Build.Registry.Organization
+	if organization == "" {
+		organization = e.Platform.Namespace
+	}
+	return e.Platform.Status.Build.Registry.Address + "/" + organization + "/" + dryRunImageName
+}
+
+func validateSource(source v1.SourceSpec) error {
+	content := source.Content
+	// The sources sent with kamel run --compression are base64 encoded gzip archives
+	if source.Compression {
+		uncompressed, err := gzip.UncompressBase64([]byte(content))
+		if err != nil {
+			return fmt.Errorf("invalid source %s: %w", source.Name, err)
+		}
+		content = string(uncompressed)
+	}
+
+	if strings.TrimSpace(content) == "" {
+		return fmt.Errorf("invalid source %s: the source is empty", source.Name)
+	}
+
+	var err error
+	switch source.InferLanguage() {
+	case v1.LanguageYaml:
+		err = validateYAMLSource(content)
+	case v1.LanguageXML:
+		err = validateXMLSource(content)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid source %s: %w", source.Name, err)
+	}
+
+	return nil
+}
+
+// validateYAMLSource checks the source is a list of definitions, and relies on the YAML parser errors
+// to reference the line of the error.
+func validateYAMLSource(content string) error {
+	var definitions []interface{}
+	if err := yaml2.Unmarshal([]byte(content), &definitions); err != nil {
+		return err
+	}
+
+	for i, definition := range definitions {
+		if d, ok := definition.(map[interface{}]interface{}); !ok || len(d) == 0 {
+			return fmt.Errorf("definition #%d must be a non-empty mapping", i+1)
+		}
+	}
+
+	return nil
+}
+
+func validateXMLSource(content string) error {
+	decoder := xml.NewDecoder(strings.NewReader(content))
+
+	hasRoot := false
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var syntaxError *xml.SyntaxError
+		if errors.As(err, &syntaxError) {
+			return fmt.Errorf("line %d: %s", syntaxError.Line, syntaxError.Msg)
+		} else if err != nil {
+			return err
+		}
+		if _, ok := token.(xml.StartElement); ok {
+			hasRoot = true
+		}
+	}
+
+	if !hasRoot {
+		return errors.New("no XML element found")
+	}
+
+	return nil
+}
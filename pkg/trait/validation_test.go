@@ -0,0 +1,336 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/gzip"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestConfigureValidationTraitDoesSucceed(t *testing.T) {
+	trait, environment := createNominalValidationTest(v1.SourceSpec{})
+
+	configured, err := trait.Configure(environment)
+
+	assert.Nil(t, err)
+	assert.True(t, configured)
+
+	trait.Sources = pointer.Bool(false)
+	configured, err = trait.Configure(environment)
+
+	assert.Nil(t, err)
+	assert.False(t, configured)
+
+	trait.Sources = nil
+	environment.Integration.Status.Phase = v1.IntegrationPhaseRunning
+	configured, err = trait.Configure(environment)
+
+	assert.Nil(t, err)
+	assert.False(t, configured)
+}
+
+func TestApplyValidationTraitWithValidSourcesDoesSucceed(t *testing.T) {
+	trait, environment := createNominalValidationTest(
+		v1.SourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:    "route.yaml",
+				Content: "- from:\n    uri: timer:tick\n    steps:\n      - to: log:info\n",
+			},
+		},
+		v1.SourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:    "route.xml",
+				Content: `<routes><route><from uri="timer:tick"/><to uri="log:info"/></route></routes>`,
+			},
+		},
+		v1.SourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:    "Route.java",
+				Content: "public class Route extends RouteBuilder {}",
+			},
+		},
+	)
+
+	err := trait.Apply(environment)
+
+	assert.Nil(t, err)
+}
+
+func TestApplyValidationTraitWithInvalidSourcesFails(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{
+			name:    "Route.java",
+			content: " \n",
+			err:     "invalid source Route.java: the source is empty",
+		},
+		{
+			name:    "route.yaml",
+			content: "- from:\n    uri: timer:tick\n   steps: []\n",
+			err:     "invalid source route.yaml: yaml: line 2: did not find expected key",
+		},
+		{
+			name:    "route.yaml",
+			content: "from:\n  uri: timer:tick\n",
+			err:     "invalid source route.yaml: yaml: unmarshal errors:\n  line 1",
+		},
+		{
+			name:    "route.yaml",
+			content: "- from:\n    uri: timer:tick\n- \"to\"\n",
+			err:     "invalid source route.yaml: definition #2 must be a non-empty mapping",
+		},
+		{
+			name:    "route.xml",
+			content: "<routes>\n  <route>\n    <from uri=\"timer:tick\">\n  </route>\n</routes>",
+			err:     "invalid source route.xml: line 4: element <from> closed by </route>",
+		},
+		{
+			name:    "route.xml",
+			content: "<!-- no routes -->",
+			err:     "invalid source route.xml: no XML element found",
+		},
+	}
+
+	for _, tc := range testCases {
+		trait, environment := createNominalValidationTest(v1.SourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:    tc.name,
+				Content: tc.content,
+			},
+		})
+
+		err := trait.Apply(environment)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), tc.err)
+	}
+}
+
+func TestValidateCompressedSources(t *testing.T) {
+	compress := func(content string) string {
+		compressed, err := gzip.CompressBase64([]byte(content))
+		assert.Nil(t, err)
+		return string(compressed)
+	}
+
+	testCases := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{
+			name:    "route.yaml",
+			content: "- from:\n    uri: timer:tick\n    steps:\n      - to: log:info\n",
+		},
+		{
+			name:    "route.xml",
+			content: `<routes><route><from uri="timer:tick"/><to uri="log:info"/></route></routes>`,
+		},
+		{
+			name:    "route.yaml",
+			content: "- from:\n    uri: timer:tick\n   steps: []\n",
+			err:     "invalid source route.yaml: yaml: line 2: did not find expected key",
+		},
+	}
+
+	for _, tc := range testCases {
+		err := validateSource(v1.SourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:        tc.name,
+				Content:     compress(tc.content),
+				Compression: true,
+			},
+		})
+
+		if tc.err == "" {
+			assert.Nil(t, err)
+		} else {
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		}
+	}
+}
+
+func TestValidationTraitWithDryRun(t *testing.T) {
+	environment := createDryRunValidationTest(t, nil)
+
+	err := environment.Catalog.apply(environment)
+	assert.Nil(t, err)
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionResourcesAdmitted)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionResourcesAdmittedReason, condition.Reason)
+	assert.Contains(t, environment.Client.(*dryRunClient).patched, "Deployment/my-it")
+	assert.Equal(t, []string{"docker.io/apache/camel-k-dry-run:latest"}, environment.Client.(*dryRunClient).images)
+	// The integration is not deployed by the dry-run validation
+	assert.Nil(t, environment.Resources.GetDeploymentForIntegration(environment.Integration))
+	assert.Equal(t, v1.IntegrationPhaseInitialization, environment.Integration.Status.Phase)
+}
+
+func TestValidationTraitWithDryRunInPlatformRegistry(t *testing.T) {
+	environment := createDryRunValidationTest(t, nil)
+	environment.Platform.Namespace = "camel-k"
+	environment.Platform.Status.Build.Registry.Address = "registry.example.com"
+
+	err := environment.Catalog.apply(environment)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"registry.example.com/camel-k/camel-k-dry-run:latest"}, environment.Client.(*dryRunClient).images)
+}
+
+func TestValidationTraitWithDryRunNotAdmitted(t *testing.T) {
+	environment := createDryRunValidationTest(t, func(obj ctrl.Object) error {
+		if obj.GetObjectKind().GroupVersionKind().Kind == "Deployment" {
+			return k8serrors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "deployments"}, obj.GetName(),
+				errors.New("admission webhook denied the request: privileged containers are not allowed"))
+		}
+		return nil
+	})
+
+	err := environment.Catalog.apply(environment)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "integration resources not admitted: Deployment my-it: ")
+	assert.Contains(t, err.Error(), "privileged containers are not allowed")
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionResourcesAdmitted)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionResourcesNotAdmittedReason, condition.Reason)
+	assert.Contains(t, err.Error(), condition.Message)
+}
+
+// dryRunClient records the patched resources, fails the patches that are not in dry-run mode,
+// and rejects the ones the given function returns an error for.
+type dryRunClient struct {
+	client.Client
+	reject  func(obj ctrl.Object) error
+	patched []string
+	images  []string
+}
+
+func (c *dryRunClient) Patch(ctx context.Context, obj ctrl.Object, patch ctrl.Patch, opts ...ctrl.PatchOption) error {
+	patchOptions := &ctrl.PatchOptions{}
+	patchOptions.ApplyOptions(opts)
+	if len(patchOptions.DryRun) == 0 {
+		return errors.New("unexpected patch without dry-run")
+	}
+	c.patched = append(c.patched, obj.GetObjectKind().GroupVersionKind().Kind+"/"+obj.GetName())
+	if u, ok := obj.(*unstructured.Unstructured); ok && u.GetKind() == "Deployment" {
+		containers, _, _ := unstructured.NestedSlice(u.Object, "spec", "template", "spec", "containers")
+		c.images = append(c.images, containers[0].(map[string]interface{})["image"].(string))
+	}
+	if c.reject != nil {
+		return c.reject(obj)
+	}
+	return nil
+}
+
+func createDryRunValidationTest(t *testing.T, reject func(obj ctrl.Object) error) *Environment {
+	t.Helper()
+
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+	fakeClient, err := test.NewFakeClient()
+	assert.Nil(t, err)
+
+	return &Environment{
+		Ctx:          context.TODO(),
+		Client:       &dryRunClient{Client: fakeClient, reject: reject},
+		Catalog:      NewCatalog(nil),
+		CamelCatalog: catalog,
+		Platform: &v1.IntegrationPlatform{
+			Status: v1.IntegrationPlatformStatus{
+				Phase: v1.IntegrationPlatformPhaseReady,
+				IntegrationPlatformSpec: v1.IntegrationPlatformSpec{
+					Build: v1.IntegrationPlatformBuildSpec{
+						RuntimeVersion: catalog.Runtime.Version,
+					},
+				},
+			},
+		},
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-it",
+				Namespace: "ns",
+			},
+			Spec: v1.IntegrationSpec{
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name:    "route.yaml",
+							Content: "- from:\n    uri: timer:tick\n    steps:\n      - to: log:info\n",
+						},
+					},
+				},
+				Traits: v1.Traits{
+					Validation: &traitv1.ValidationTrait{
+						DryRun: pointer.Bool(true),
+					},
+				},
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseInitialization,
+			},
+		},
+		Resources:             kubernetes.NewCollection(),
+		ApplicationProperties: make(map[string]string),
+	}
+}
+
+func createNominalValidationTest(sources ...v1.SourceSpec) (*validationTrait, *Environment) {
+	trait, _ := newValidationTrait().(*validationTrait)
+
+	environment := &Environment{
+		Ctx:     context.TODO(),
+		Catalog: NewCatalog(nil),
+		Integration: &v1.Integration{
+			Spec: v1.IntegrationSpec{
+				Sources: sources,
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseInitialization,
+			},
+		},
+		Resources: kubernetes.NewCollection(),
+	}
+
+	return trait, environment
+}
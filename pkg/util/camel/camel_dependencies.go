@@ -102,6 +102,38 @@ func ValidateDependenciesE(catalog *RuntimeCatalog, dependencies []string) error
 	return nil
 }
 
+// ValidateDependencyFormat checks the dependency is in one of the supported formats, without resolving it.
+func ValidateDependencyFormat(dependency string) error {
+	switch {
+	case strings.HasPrefix(dependency, "bom:"):
+		if _, err := maven.ParseGAV(strings.TrimPrefix(dependency, "bom:")); err != nil {
+			return err
+		}
+	case strings.HasPrefix(dependency, "mvn:"):
+		if _, err := maven.ParseGAV(strings.TrimPrefix(dependency, "mvn:")); err != nil {
+			return err
+		}
+	case strings.HasPrefix(dependency, "registry-mvn:"):
+		mapping := strings.Split(strings.TrimPrefix(dependency, "registry-mvn:"), "@")
+		if len(mapping) < 2 || mapping[1] == "" {
+			return fmt.Errorf("registry dependency %s must have the registry-mvn:<gav>@<path>[@classpath] format", dependency)
+		}
+		if _, err := maven.ParseGAV(mapping[0]); err != nil {
+			return err
+		}
+	case strings.HasPrefix(dependency, "camel:"), strings.HasPrefix(dependency, "camel-k:"), strings.HasPrefix(dependency, "camel-quarkus:"):
+		if strings.SplitN(dependency, ":", 2)[1] == "" {
+			return fmt.Errorf("dependency %s has no artifact", dependency)
+		}
+	default:
+		if jitpack.ToDependency(dependency) == nil {
+			return fmt.Errorf("unknown dependency type: %s", dependency)
+		}
+	}
+
+	return nil
+}
+
 // ManageIntegrationDependencies sets up all the required dependencies for the given Maven project.
 func ManageIntegrationDependencies(project *maven.Project, dependencies []string, catalog *RuntimeCatalog) error {
 	// Add dependencies from build
@@ -31,3 +31,26 @@ func TestNormalizeDependency(t *testing.T) {
 	assert.Equal(t, "camel-k:knative", NormalizeDependency("camel-k-knative"))
 	assert.Equal(t, "camel-k:knative", NormalizeDependency("camel-k:knative"))
 }
+
+func TestValidateDependencyFormat(t *testing.T) {
+	for _, dependency := range []string{
+		"camel:file",
+		"camel-k:knative",
+		"camel-quarkus:file",
+		"mvn:org.acme:acme-lib:1.0.0",
+		"bom:org.acme:acme-bom:1.0.0",
+		"registry-mvn:org.acme:acme-lib:1.0.0@/deployments/acme.jar",
+		"github:apache/camel-sample",
+	} {
+		assert.NoError(t, ValidateDependencyFormat(dependency), dependency)
+	}
+
+	for _, dependency := range []string{
+		"camel:",
+		"mvn:acme-lib",
+		"registry-mvn:org.acme:acme-lib:1.0.0",
+		"acme-lib",
+	} {
+		assert.Error(t, ValidateDependencyFormat(dependency), dependency)
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosign
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+const (
+	// SignatureAnnotation is the annotation of the signature image layers holding the payload signature.
+	SignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// SimpleSigningMediaType is the media type of the signature image layers holding the signed payload.
+	SimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// SignatureTagSuffix is the suffix of the tag the signatures of an image are stored with.
+	SignatureTagSuffix = ".sig"
+)
+
+// ErrNoValidSignature is returned when an image has no signature that is valid for the public key.
+var ErrNoValidSignature = errors.New("no valid signature found")
+
+// Payload is the simple signing payload, that cosign signs for a container image.
+type Payload struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// ParsePublicKey parses a PEM encoded ECDSA public key, as generated by `cosign generate-key-pair`.
+func ParsePublicKey(data []byte) (*ecdsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM encoded public key found")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	ecdsaKey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported public key type %T, only ECDSA keys are supported", key)
+	}
+	return ecdsaKey, nil
+}
+
+// SignatureTag returns the tag the cosign signatures of the image with the given digest are stored with.
+func SignatureTag(repository name.Repository, digest v1.Hash) name.Tag {
+	return repository.Tag(strings.ReplaceAll(digest.String(), ":", "-") + SignatureTagSuffix)
+}
+
+// Verify checks that the image has a cosign signature, stored in the same repository, that is valid
+// for the given public key, and that signs the image manifest digest.
+// It returns the digest of the verified image.
+func Verify(ref name.Reference, key *ecdsa.PublicKey, options ...remote.Option) (v1.Hash, error) {
+	descriptor, err := remote.Head(ref, options...)
+	if err != nil {
+		return v1.Hash{}, fmt.Errorf("unable to get image %s: %w", ref, err)
+	}
+	digest := descriptor.Digest
+
+	signatures, err := remote.Image(SignatureTag(ref.Context(), digest), options...)
+	if err != nil {
+		return digest, fmt.Errorf("unable to get signatures of image %s: %w", ref, err)
+	}
+	manifest, err := signatures.Manifest()
+	if err != nil {
+		return digest, err
+	}
+
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != SimpleSigningMediaType {
+			continue
+		}
+		signature, err := base64.StdEncoding.DecodeString(layer.Annotations[SignatureAnnotation])
+		if err != nil {
+			continue
+		}
+		payload, err := readLayer(signatures, layer.Digest)
+		if err != nil {
+			return digest, err
+		}
+		if verifyPayload(payload, signature, key, digest) {
+			return digest, nil
+		}
+	}
+
+	return digest, fmt.Errorf("image %s: %w", ref, ErrNoValidSignature)
+}
+
+func readLayer(image v1.Image, digest v1.Hash) ([]byte, error) {
+	layer, err := image.LayerByDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+	content, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	var buffer bytes.Buffer
+	if _, err := io.Copy(&buffer, content); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func verifyPayload(payload []byte, signature []byte, key *ecdsa.PublicKey, digest v1.Hash) bool {
+	hash := sha256.Sum256(payload)
+	if !ecdsa.VerifyASN1(key, hash[:], signature) {
+		return false
+	}
+	p := Payload{}
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return false
+	}
+	// The signature must be for the image, and not for another image of the repository
+	return p.Critical.Image.DockerManifestDigest == digest.String()
+}
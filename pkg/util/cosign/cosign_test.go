@@ -0,0 +1,130 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosign
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePublicKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	parsed, err := ParsePublicKey(encodePublicKey(t, &key.PublicKey))
+	assert.NoError(t, err)
+	assert.True(t, key.PublicKey.Equal(parsed))
+
+	_, err = ParsePublicKey([]byte("not a key"))
+	assert.Error(t, err)
+}
+
+func TestVerify(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	signed := pushImage(t, host+"/test/signed:latest")
+	signImage(t, signed, key)
+	unsigned := pushImage(t, host+"/test/unsigned:latest")
+
+	digest, err := Verify(signed, &key.PublicKey)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, digest.Hex)
+
+	_, err = Verify(signed, &otherKey.PublicKey)
+	assert.True(t, errors.Is(err, ErrNoValidSignature))
+
+	_, err = Verify(unsigned, &key.PublicKey)
+	assert.Error(t, err)
+}
+
+func pushImage(t *testing.T, image string) name.Reference {
+	t.Helper()
+
+	ref, err := name.ParseReference(image, name.Insecure)
+	require.NoError(t, err)
+	img, err := random.Image(256, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+
+	return ref
+}
+
+func signImage(t *testing.T, ref name.Reference, key *ecdsa.PrivateKey) {
+	t.Helper()
+
+	descriptor, err := remote.Head(ref)
+	require.NoError(t, err)
+
+	p := Payload{}
+	p.Critical.Identity.DockerReference = ref.Context().String()
+	p.Critical.Image.DockerManifestDigest = descriptor.Digest.String()
+	p.Critical.Type = "cosign container image signature"
+	payload, err := json.Marshal(p)
+	require.NoError(t, err)
+
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+
+	img, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer: static.NewLayer(payload, SimpleSigningMediaType),
+		Annotations: map[string]string{
+			SignatureAnnotation: base64.StdEncoding.EncodeToString(signature),
+		},
+	})
+	require.NoError(t, err)
+	img = mutate.MediaType(img, types.OCIManifestSchema1)
+	require.NoError(t, remote.Write(SignatureTag(ref.Context(), descriptor.Digest), img))
+}
+
+func encodePublicKey(t *testing.T, key *ecdsa.PublicKey) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
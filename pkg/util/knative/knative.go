@@ -19,8 +19,12 @@ package knative
 
 import (
 	"context"
+	// #nosec G505
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
 	"net/url"
+	"sort"
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -73,15 +77,27 @@ func CreateSubscription(channelReference corev1.ObjectReference, serviceName str
 	}
 }
 
-func CreateTrigger(brokerReference corev1.ObjectReference, serviceName string, eventType string, path string) *eventing.Trigger {
+// CreateTrigger returns a Trigger subscribing the service to the events of the Broker that match the event type,
+// if any, and the other CloudEvent attributes filters.
+func CreateTrigger(brokerReference corev1.ObjectReference, serviceName string, eventType string, filters map[string]string, path string) *eventing.Trigger {
 	nameSuffix := ""
 	var attributes map[string]string
 	if eventType != "" {
 		nameSuffix = fmt.Sprintf("-%s", util.SanitizeLabel(eventType))
-		attributes = map[string]string{
-			"type": eventType,
+	}
+	if eventType != "" || len(filters) > 0 {
+		attributes = make(map[string]string, len(filters)+1)
+		for attribute, value := range filters {
+			attributes[attribute] = value
+		}
+		if eventType != "" {
+			attributes["type"] = eventType
 		}
 	}
+	if len(filters) > 0 {
+		// Distinguish the Triggers of the same event type with different filters
+		nameSuffix += "-" + filtersHash(filters)
+	}
 	return &eventing.Trigger{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: eventing.SchemeGroupVersion.String(),
@@ -110,6 +126,21 @@ func CreateTrigger(brokerReference corev1.ObjectReference, serviceName string, e
 	}
 }
 
+// filtersHash returns a short hash of the filters, that's stable across reconciliations.
+func filtersHash(filters map[string]string) string {
+	attributes := make([]string, 0, len(filters))
+	for attribute := range filters {
+		attributes = append(attributes, attribute)
+	}
+	sort.Strings(attributes)
+	// #nosec G401
+	h := sha1.New()
+	for _, attribute := range attributes {
+		h.Write([]byte(attribute + "=" + filters[attribute] + "\n"))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:8]
+}
+
 func CreateSinkBinding(source corev1.ObjectReference, target corev1.ObjectReference) *sources.SinkBinding {
 	return &sources.SinkBinding{
 		TypeMeta: metav1.TypeMeta{
@@ -19,7 +19,9 @@ package knative
 
 import (
 	"fmt"
+	"net/url"
 	"regexp"
+	"strings"
 
 	knativev1 "github.com/apache/camel-k/pkg/apis/camel/v1/knative"
 	uriutils "github.com/apache/camel-k/pkg/util/uri"
@@ -35,6 +37,8 @@ const (
 	paramAPIVersion = "apiVersion"
 	paramKind       = "kind"
 	paramBrokerName = "name"
+	// paramFilterPrefix is the prefix of the event URI parameters that filter the events on their CloudEvent attributes.
+	paramFilterPrefix = "filter."
 )
 
 // FilterURIs returns all Knative URIs of the given type from a slice.
@@ -83,6 +87,33 @@ func ExtractObjectReference(uri string) (v1.ObjectReference, error) {
 	}, nil
 }
 
+// ExtractBrokerName returns the name of the Broker explicitly set in the event URI, if any.
+func ExtractBrokerName(uri string) string {
+	if !isKnativeURI(knativev1.CamelServiceTypeEvent, uri) {
+		return ""
+	}
+	return uriutils.GetQueryParameter(uri, paramBrokerName)
+}
+
+// ExtractEventFilters returns the CloudEvent attributes filters, set with the `filter.<attribute>` parameters of the event URI.
+func ExtractEventFilters(uri string) (map[string]string, error) {
+	filters := make(map[string]string)
+	_, query, ok := strings.Cut(uri, "?")
+	if !isKnativeURI(knativev1.CamelServiceTypeEvent, uri) || !ok {
+		return filters, nil
+	}
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid parameters in uri %s: %w", uri, err)
+	}
+	for param, values := range params {
+		if attribute := strings.TrimPrefix(param, paramFilterPrefix); attribute != param && attribute != "" && len(values) > 0 {
+			filters[attribute] = values[0]
+		}
+	}
+	return filters, nil
+}
+
 // ExtractEventType extract the eventType from a event URI.
 func ExtractEventType(uri string) string {
 	return matchOrEmpty(uriRegexp, 2, uri)
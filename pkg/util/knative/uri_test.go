@@ -98,6 +98,27 @@ func TestChannelUri(t *testing.T) {
 	}, ref)
 }
 
+func TestEventUri(t *testing.T) {
+	assert.Equal(t, "broker2", ExtractBrokerName("knative:event/chuck?name=broker2"))
+	assert.Equal(t, "", ExtractBrokerName("knative:event/chuck"))
+	assert.Equal(t, "", ExtractBrokerName("knative:endpoint/chuck?name=broker2"))
+
+	filters, err := ExtractEventFilters("knative:event/chuck?name=broker2&filter.source=my%2Fsource&filter.myext=x&filter.=y")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"source": "my/source", "myext": "x"}, filters)
+
+	filters, err = ExtractEventFilters("knative:event/chuck")
+	assert.Nil(t, err)
+	assert.Empty(t, filters)
+
+	filters, err = ExtractEventFilters("knative:channel/chuck?filter.source=x")
+	assert.Nil(t, err)
+	assert.Empty(t, filters)
+
+	_, err = ExtractEventFilters("knative:event/chuck?filter.source=%zz")
+	assert.NotNil(t, err)
+}
+
 func TestNormalizeToUri(t *testing.T) {
 	assert.Equal(t, "knative://channel/name.chan", NormalizeToURI(knative.CamelServiceTypeChannel, "name.chan"))
 	assert.Equal(t, "knative://event/chuck", NormalizeToURI(knative.CamelServiceTypeEvent, "chuck"))
@@ -242,7 +242,8 @@ func (c *Collection) GetServiceForIntegration(integration *v1.Integration) *core
 		return nil
 	}
 	return c.GetService(func(s *corev1.Service) bool {
-		return s.ObjectMeta.Labels != nil && s.ObjectMeta.Labels[v1.IntegrationLabel] == integration.Name
+		return s.ObjectMeta.Labels != nil && s.ObjectMeta.Labels[v1.IntegrationLabel] == integration.Name &&
+			s.ObjectMeta.Labels["camel.apache.org/service.type"] != v1.ServiceTypeAdditional
 	})
 }
 
@@ -308,6 +309,37 @@ func (c *Collection) VisitCronJobE(visitor func(*batchv1.CronJob) error) error {
 	})
 }
 
+// GetStatefulSet returns a StatefulSet that matches the given function.
+func (c *Collection) GetStatefulSet(filter func(*appsv1.StatefulSet) bool) *appsv1.StatefulSet {
+	var retValue *appsv1.StatefulSet
+	c.VisitStatefulSet(func(re *appsv1.StatefulSet) {
+		if filter(re) {
+			retValue = re
+		}
+	})
+	return retValue
+}
+
+// VisitStatefulSet executes the visitor function on all StatefulSet resources.
+func (c *Collection) VisitStatefulSet(visitor func(*appsv1.StatefulSet)) {
+	c.Visit(func(res runtime.Object) {
+		if conv, ok := res.(*appsv1.StatefulSet); ok {
+			visitor(conv)
+		}
+	})
+}
+
+// VisitStatefulSetE executes the visitor function on all StatefulSet resources.
+func (c *Collection) VisitStatefulSetE(visitor func(*appsv1.StatefulSet) error) error {
+	return c.VisitE(func(res runtime.Object) error {
+		if conv, ok := res.(*appsv1.StatefulSet); ok {
+			return visitor(conv)
+		}
+
+		return nil
+	})
+}
+
 // VisitKnativeService executes the visitor function on all Knative serving Service resources.
 func (c *Collection) VisitKnativeService(visitor func(*serving.Service)) {
 	c.Visit(func(res runtime.Object) {
@@ -389,9 +421,15 @@ func (c *Collection) VisitContainer(visitor func(container *corev1.Container)) {
 			visitor(cntref)
 		}
 	})
+	c.VisitStatefulSet(func(s *appsv1.StatefulSet) {
+		for idx := range s.Spec.Template.Spec.Containers {
+			cntref := &s.Spec.Template.Spec.Containers[idx]
+			visitor(cntref)
+		}
+	})
 }
 
-// GetController returns the controller associated with the integration (e.g. Deployment, Knative Service or CronJob).
+// GetController returns the controller associated with the integration (e.g. Deployment, Knative Service, CronJob or StatefulSet).
 func (c *Collection) GetController(filter func(object ctrl.Object) bool) ctrl.Object {
 	d := c.GetDeployment(func(deployment *appsv1.Deployment) bool {
 		return filter(deployment)
@@ -411,6 +449,12 @@ func (c *Collection) GetController(filter func(object ctrl.Object) bool) ctrl.Ob
 	if cj != nil {
 		return cj
 	}
+	ss := c.GetStatefulSet(func(set *appsv1.StatefulSet) bool {
+		return filter(set)
+	})
+	if ss != nil {
+		return ss
+	}
 	return nil
 }
 
@@ -425,6 +469,9 @@ func (c *Collection) VisitPodSpec(visitor func(container *corev1.PodSpec)) {
 	c.VisitCronJob(func(d *batchv1.CronJob) {
 		visitor(&d.Spec.JobTemplate.Spec.Template.Spec)
 	})
+	c.VisitStatefulSet(func(s *appsv1.StatefulSet) {
+		visitor(&s.Spec.Template.Spec)
+	})
 }
 
 // VisitPodTemplateMeta executes the visitor function on all PodTemplate metadata inside deployments or other resources.
@@ -438,6 +485,9 @@ func (c *Collection) VisitPodTemplateMeta(visitor func(meta *metav1.ObjectMeta))
 	c.VisitCronJob(func(d *batchv1.CronJob) {
 		visitor(&d.Spec.JobTemplate.Spec.Template.ObjectMeta)
 	})
+	c.VisitStatefulSet(func(s *appsv1.StatefulSet) {
+		visitor(&s.Spec.Template.ObjectMeta)
+	})
 }
 
 // VisitKnativeConfigurationSpec executes the visitor function on all knative ConfigurationSpec inside serving Services.
@@ -31,6 +31,18 @@ func (s Settings) MarshalBytes() ([]byte, error) {
 	return util.EncodeXML(s)
 }
 
+// NewSettingsSecurity returns the security of the Maven settings, with the given encrypted master password.
+func NewSettingsSecurity(master string) SettingsSecurity {
+	return SettingsSecurity{
+		XMLName: xml.Name{Local: "settingsSecurity"},
+		Master:  master,
+	}
+}
+
+func (s SettingsSecurity) MarshalBytes() ([]byte, error) {
+	return util.EncodeXML(s)
+}
+
 type SettingsOption interface {
 	apply(settings *Settings) error
 }
@@ -64,6 +64,12 @@ type Settings struct {
 	Mirrors           []Mirror    `xml:"mirrors>mirror,omitempty"`
 }
 
+// SettingsSecurity models the security of the Maven settings, i.e., the `settings-security.xml` file.
+type SettingsSecurity struct {
+	XMLName xml.Name
+	Master  string `xml:"master"`
+}
+
 // Project models a Maven project.
 type Project struct {
 	XMLName              xml.Name
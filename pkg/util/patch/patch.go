@@ -18,15 +18,33 @@ limitations under the License.
 package patch
 
 import (
+	"errors"
 	"reflect"
 
 	jsonpatch "github.com/evanphx/json-patch"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/apimachinery/pkg/util/mergepatch"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes/scheme"
 )
 
+// ErrConflict is returned by ThreeWayMergePatch when the patch changes fields that have been modified
+// since the original object has been applied.
+var ErrConflict = errors.New("the object has been modified since it was last applied")
+
+// builtinScheme registers the built-in types, that support strategic merge patches.
+var builtinScheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(scheme.AddToScheme(builtinScheme))
+}
+
 func MergePatch(source interface{}, target interface{}) ([]byte, error) {
 	sourceJSON, err := json.Marshal(source)
 	if err != nil {
@@ -62,6 +80,152 @@ func MergePatch(source interface{}, target interface{}) ([]byte, error) {
 	}
 }
 
+// ThreeWayMergePatch returns the patch, and its type, that changes the current object into the target, computed against
+// the original object the current one has been last applied from. The fields removed from the target since the original
+// are deleted, while the fields that are not set in the target, e.g., the ones added by other actors or defaulted
+// server-side, are preserved. A strategic merge patch is returned for the built-in types, so that the lists are merged
+// by key, and a JSON merge patch otherwise.
+// When checkConflicts is true, it returns ErrConflict if the patch changes fields that have also been modified
+// on the current object since the original.
+func ThreeWayMergePatch(original []byte, current *unstructured.Unstructured, target runtime.Object, checkConflicts bool) (types.PatchType, []byte, error) {
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		return "", nil, err
+	}
+	targetJSON, err := positiveJSON(target)
+	if err != nil {
+		return "", nil, err
+	}
+	if _, ok := target.(*unstructured.Unstructured); !ok && len(original) > 0 {
+		// Remove the null fields from the original object as well, so that they're not computed as deletions
+		var originalObj map[string]interface{}
+		if err := json.Unmarshal(original, &originalObj); err != nil {
+			return "", nil, err
+		}
+		removeNilValues(reflect.ValueOf(originalObj), reflect.Value{})
+		if original, err = json.Marshal(originalObj); err != nil {
+			return "", nil, err
+		}
+	}
+	// The conflicts can only be detected against the original object
+	checkConflicts = checkConflicts && len(original) > 0
+
+	var patchType types.PatchType
+	var threeWayPatch []byte
+	if versioned, err := builtinScheme.New(target.GetObjectKind().GroupVersionKind()); err == nil {
+		patchType = types.StrategicMergePatchType
+		lookupPatchMeta, err := strategicpatch.NewPatchMetaFromStruct(versioned)
+		if err != nil {
+			return "", nil, err
+		}
+		threeWayPatch, err = strategicpatch.CreateThreeWayMergePatch(original, targetJSON, currentJSON, lookupPatchMeta, !checkConflicts)
+		if mergepatch.IsConflict(err) {
+			return "", nil, ErrConflict
+		} else if err != nil {
+			return "", nil, err
+		}
+	} else {
+		patchType = types.MergePatchType
+		threeWayPatch, err = jsonmergepatch.CreateThreeWayJSONMergePatch(original, targetJSON, currentJSON)
+		if err != nil {
+			return "", nil, err
+		}
+		if checkConflicts {
+			if conflict, err := hasJSONMergePatchConflicts(original, current.Object, threeWayPatch); err != nil {
+				return "", nil, err
+			} else if conflict {
+				return "", nil, ErrConflict
+			}
+		}
+	}
+
+	var patchObj map[string]interface{}
+	if err := json.Unmarshal(threeWayPatch, &patchObj); err != nil {
+		return "", nil, err
+	}
+	if len(patchObj) == 0 {
+		return patchType, make([]byte, 0), nil
+	}
+
+	return patchType, threeWayPatch, nil
+}
+
+// hasJSONMergePatchConflicts returns whether the patch changes fields that have been modified on the current object since
+// the original. The fields that are not set in the original object, e.g., the ones defaulted server-side, are not
+// considered as modified, as JSON merge patches replace lists as a whole.
+func hasJSONMergePatchConflicts(original []byte, current map[string]interface{}, threeWayPatch []byte) (bool, error) {
+	var originalObj map[string]interface{}
+	if err := json.Unmarshal(original, &originalObj); err != nil {
+		return false, err
+	}
+	currentJSON, err := json.Marshal(retainFields(current, originalObj))
+	if err != nil {
+		return false, err
+	}
+	changes, err := jsonpatch.CreateMergePatch(original, currentJSON)
+	if err != nil {
+		return false, err
+	}
+	var changesObj map[string]interface{}
+	if err := json.Unmarshal(changes, &changesObj); err != nil {
+		return false, err
+	}
+	var patchObj map[string]interface{}
+	if err := json.Unmarshal(threeWayPatch, &patchObj); err != nil {
+		return false, err
+	}
+	return mergepatch.HasConflicts(changesObj, patchObj)
+}
+
+// retainFields returns the value with only the fields that are set in the reference value.
+// The elements of the lists that have the same length are retained pairwise.
+func retainFields(value interface{}, reference interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		r, ok := reference.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		retained := make(map[string]interface{}, len(r))
+		for k, rv := range r {
+			if vv, ok := v[k]; ok {
+				retained[k] = retainFields(vv, rv)
+			}
+		}
+		return retained
+	case []interface{}:
+		r, ok := reference.([]interface{})
+		if !ok || len(r) != len(v) {
+			return value
+		}
+		retained := make([]interface{}, len(v))
+		for i := range v {
+			retained[i] = retainFields(v[i], r[i])
+		}
+		return retained
+	default:
+		return value
+	}
+}
+
+// positiveJSON returns the JSON encoding of the given object, without the null fields for typed objects,
+// so that values managed by controllers server-side are not deleted.
+func positiveJSON(source runtime.Object) ([]byte, error) {
+	sourceJSON, err := json.Marshal(source)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := source.(*unstructured.Unstructured); ok {
+		return sourceJSON, nil
+	}
+	var positive map[string]interface{}
+	if err := json.Unmarshal(sourceJSON, &positive); err != nil {
+		return nil, err
+	}
+	removeNilValues(reflect.ValueOf(positive), reflect.Value{})
+	return json.Marshal(positive)
+}
+
 func ApplyPatch(source runtime.Object) (*unstructured.Unstructured, error) {
 	switch s := source.(type) {
 	case *unstructured.Unstructured:
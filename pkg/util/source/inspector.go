@@ -19,7 +19,10 @@ package source
 
 import (
 	"fmt"
+	"net"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
@@ -29,6 +32,12 @@ import (
 
 type catalog2deps func(*camel.RuntimeCatalog) []string
 
+// consumerPortSchemes are the schemes of the consumers that listen on the port of their URI.
+var consumerPortSchemes = map[string]bool{
+	"grpc":  true,
+	"netty": true,
+}
+
 const (
 	defaultJSONDataFormat = "jackson"
 )
@@ -242,6 +251,7 @@ func (i *baseInspector) extract(source v1.SourceSpec, meta *Metadata,
 
 	meta.ExposesHTTPServices = hasRest || i.containsHTTPURIs(meta.FromURIs)
 	meta.PassiveEndpoints = i.hasOnlyPassiveEndpoints(meta.FromURIs)
+	meta.ConsumerPorts = i.discoverConsumerPorts(meta.FromURIs)
 
 	return nil
 }
@@ -438,3 +448,62 @@ func (i *baseInspector) containsHTTPURIs(fromURI []string) bool {
 
 	return false
 }
+
+// discoverConsumerPorts returns the ports the consumers of the given URIs listen on, for the schemes of the servers
+// that bind the port of their URI, e.g., netty:tcp://0.0.0.0:5150 or grpc://0.0.0.0:50051/org.acme.Service.
+// The URIs whose port is not a number, e.g., a property placeholder, are ignored.
+func (i *baseInspector) discoverConsumerPorts(fromURIs []string) []ConsumerPort {
+	ports := make([]ConsumerPort, 0)
+	names := make(map[string]bool)
+	for _, uri := range fromURIs {
+		prefix := i.getURIPrefix(uri)
+		if !consumerPortSchemes[prefix] {
+			continue
+		}
+
+		address := strings.TrimPrefix(uri, prefix+":")
+		protocol := "TCP"
+		// The netty URIs start with the transport protocol
+		if idx := strings.Index(address, "://"); idx >= 0 && prefix == "netty" {
+			switch address[:idx] {
+			case "tcp":
+			case "udp":
+				protocol = "UDP"
+			default:
+				continue
+			}
+			address = address[idx+3:]
+		}
+		address = strings.TrimPrefix(address, "//")
+		if idx := strings.IndexAny(address, "/?"); idx >= 0 {
+			address = address[:idx]
+		}
+
+		_, p, err := net.SplitHostPort(address)
+		if err != nil {
+			continue
+		}
+		port, err := strconv.ParseInt(p, 10, 32)
+		if err != nil || port <= 0 || port > 65535 {
+			continue
+		}
+
+		name := fmt.Sprintf("%s-%d", prefix, port)
+		if protocol == "UDP" {
+			name = fmt.Sprintf("%s-udp-%d", prefix, port)
+		}
+		if names[name] {
+			continue
+		}
+		names[name] = true
+		ports = append(ports, ConsumerPort{Name: name, Port: int32(port), Protocol: protocol})
+	}
+	// The URIs order isn't stable across the languages
+	sort.SliceStable(ports, func(i, j int) bool {
+		if ports[i].Port != ports[j].Port {
+			return ports[i].Port < ports[j].Port
+		}
+		return ports[i].Name < ports[j].Name
+	})
+	return ports
+}
@@ -41,6 +41,18 @@ type Metadata struct {
 	RequiredCapabilities *strset.Set
 	// All kamelets
 	Kamelets []string
+	// ConsumerPorts lists the ports the route consumers listen on, other than the HTTP ones
+	ConsumerPorts []ConsumerPort
+}
+
+// ConsumerPort represents a port a route consumer listens on, e.g., a netty or grpc server.
+type ConsumerPort struct {
+	// The port name, derived from the consumer scheme and the port number
+	Name string
+	// The port number
+	Port int32
+	// The port protocol, either TCP or UDP
+	Protocol string
 }
 
 // NewMetadata creates a new metadata.
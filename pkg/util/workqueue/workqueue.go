@@ -0,0 +1,90 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workqueue
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"k8s.io/client-go/util/workqueue"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Defaults of the client-go default controller rate limiter.
+const (
+	DefaultBaseDelay = 5 * time.Millisecond
+	DefaultMaxDelay  = 1000 * time.Second
+	DefaultQPS       = 10
+	DefaultBurst     = 100
+)
+
+// Options configures the work queues of the operator controllers.
+type Options struct {
+	// MaxConcurrentReconciles is the maximum number of concurrent reconciles, indexed by the kind
+	// of the resource reconciled by the controller. Controllers not listed run a single reconcile at a time,
+	// except the Kamelet controller, that runs as many reconciles as available CPUs.
+	MaxConcurrentReconciles map[string]int
+	// BaseDelay is the delay before retrying a failed request, doubled on each consecutive failure.
+	BaseDelay time.Duration
+	// MaxDelay is the maximum delay before retrying a failed request.
+	MaxDelay time.Duration
+	// QPS is the overall rate at which requests are processed by each controller.
+	QPS float64
+	// Burst is the overall number of requests that can be processed at once by each controller.
+	Burst int
+}
+
+// ControllerOptions returns the options of the controller reconciling the given kind.
+// Each controller gets its own rate limiter, so that they don't throttle each other.
+func (o Options) ControllerOptions(kind string, r reconcile.Reconciler) controller.Options {
+	return controller.Options{
+		Reconciler:              r,
+		MaxConcurrentReconciles: o.MaxConcurrentReconciles[kind],
+		RateLimiter:             o.newRateLimiter(),
+	}
+}
+
+func (o Options) newRateLimiter() ratelimiter.RateLimiter {
+	baseDelay := DefaultBaseDelay
+	if o.BaseDelay > 0 {
+		baseDelay = o.BaseDelay
+	}
+	maxDelay := DefaultMaxDelay
+	if o.MaxDelay > 0 {
+		maxDelay = o.MaxDelay
+	}
+	qps := float64(DefaultQPS)
+	if o.QPS > 0 {
+		qps = o.QPS
+	}
+	burst := DefaultBurst
+	if o.Burst > 0 {
+		burst = o.Burst
+	}
+
+	return workqueue.NewMaxOfRateLimiter(
+		// Per request exponential backoff, e.g. for failing builds
+		workqueue.NewItemExponentialFailureRateLimiter(baseDelay, maxDelay),
+		// Overall rate limiting, to protect the API server
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(qps), burst)},
+	)
+}
@@ -0,0 +1,98 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/platform"
+	"github.com/apache/camel-k/pkg/trait"
+)
+
+type integrationValidator struct {
+	client client.Client
+}
+
+// ValidateCreate validates the created Integration.
+func (v *integrationValidator) ValidateCreate(_ context.Context, obj runtime.Object) error {
+	return v.validate(obj)
+}
+
+// ValidateUpdate validates the updated Integration.
+func (v *integrationValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) error {
+	return v.validate(newObj)
+}
+
+// ValidateDelete doesn't validate the deleted Integration.
+func (v *integrationValidator) ValidateDelete(_ context.Context, _ runtime.Object) error {
+	return nil
+}
+
+func (v *integrationValidator) validate(obj runtime.Object) error {
+	integration, ok := obj.(*v1.Integration)
+	if !ok {
+		return fmt.Errorf("expected an Integration, got %T", obj)
+	}
+	// Leave the integrations managed by other operators to their own webhooks
+	if !platform.IsOperatorHandler(integration) {
+		return nil
+	}
+	// Don't block the removal of the finalizers
+	if integration.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if errs := validateIntegration(v.client, integration); len(errs) > 0 {
+		return k8serrors.NewInvalid(v1.SchemeGroupVersion.WithKind(v1.IntegrationKind).GroupKind(), integration.Name, errs)
+	}
+	return nil
+}
+
+func validateIntegration(c client.Client, integration *v1.Integration) field.ErrorList {
+	var errs field.ErrorList
+	spec := field.NewPath("spec")
+
+	for i, source := range integration.Spec.Sources {
+		if source.InferLanguage() == "" {
+			errs = append(errs, field.Invalid(spec.Child("sources").Index(i).Child("language"), source.Language,
+				fmt.Sprintf("unable to detect the language of source %s", source.Name)))
+		}
+	}
+
+	errs = append(errs, validateDependencies(spec.Child("dependencies"), integration.Spec.Dependencies)...)
+
+	// The trait annotations and addons aren't validated by the CRD schema
+	env := trait.Environment{Integration: integration}
+	errs = append(errs, validateTraits(spec.Child("traits"), trait.NewCatalog(c).Configure(&env))...)
+
+	if deployer := integration.Spec.Traits.Deployer; deployer != nil && trait.ControllerStrategy(deployer.Kind) == trait.ControllerStrategyKnativeService {
+		if integration.Spec.Profile != "" && !integration.Spec.Profile.Equal(v1.TraitProfileKnative) {
+			errs = append(errs, field.Invalid(spec.Child("traits", "deployer", "kind"), deployer.Kind,
+				fmt.Sprintf("the %s kind requires the %s profile, not %s", deployer.Kind, v1.TraitProfileKnative, integration.Spec.Profile)))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,82 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/platform"
+	"github.com/apache/camel-k/pkg/trait"
+)
+
+type integrationKitValidator struct {
+	client client.Client
+}
+
+// ValidateCreate validates the created IntegrationKit.
+func (v *integrationKitValidator) ValidateCreate(_ context.Context, obj runtime.Object) error {
+	return v.validate(obj)
+}
+
+// ValidateUpdate validates the updated IntegrationKit.
+func (v *integrationKitValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) error {
+	return v.validate(newObj)
+}
+
+// ValidateDelete doesn't validate the deleted IntegrationKit.
+func (v *integrationKitValidator) ValidateDelete(_ context.Context, _ runtime.Object) error {
+	return nil
+}
+
+func (v *integrationKitValidator) validate(obj runtime.Object) error {
+	kit, ok := obj.(*v1.IntegrationKit)
+	if !ok {
+		return fmt.Errorf("expected an IntegrationKit, got %T", obj)
+	}
+	// Leave the kits managed by other operators to their own webhooks
+	if !platform.IsOperatorHandler(kit) {
+		return nil
+	}
+	if kit.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if errs := validateIntegrationKit(v.client, kit); len(errs) > 0 {
+		return k8serrors.NewInvalid(v1.SchemeGroupVersion.WithKind(v1.IntegrationKitKind).GroupKind(), kit.Name, errs)
+	}
+	return nil
+}
+
+func validateIntegrationKit(c client.Client, kit *v1.IntegrationKit) field.ErrorList {
+	var errs field.ErrorList
+	spec := field.NewPath("spec")
+
+	errs = append(errs, validateDependencies(spec.Child("dependencies"), kit.Spec.Dependencies)...)
+
+	env := trait.Environment{IntegrationKit: kit}
+	errs = append(errs, validateTraits(spec.Child("traits"), trait.NewCatalog(c).Configure(&env))...)
+
+	return errs
+}
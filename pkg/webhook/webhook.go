@@ -0,0 +1,67 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/log"
+)
+
+const (
+	// IntegrationValidationPath is the path the Integration validating admission webhook is served at.
+	IntegrationValidationPath = "/validate-camel-apache-org-v1-integration"
+	// IntegrationKitValidationPath is the path the IntegrationKit validating admission webhook is served at.
+	IntegrationKitValidationPath = "/validate-camel-apache-org-v1-integrationkit"
+)
+
+// Log is the logger of the admission webhooks.
+var Log = log.Log.WithName("webhook")
+
+// AddToManager registers the validating admission webhooks with the Manager webhook server.
+func AddToManager(manager ctrl.Manager, client client.Client) error {
+	server := manager.GetWebhookServer()
+	server.Register(IntegrationValidationPath, admission.WithCustomValidator(&v1.Integration{}, &integrationValidator{client: client}))
+	server.Register(IntegrationKitValidationPath, admission.WithCustomValidator(&v1.IntegrationKit{}, &integrationKitValidator{client: client}))
+	Log.Infof("Validating admission webhooks registered on port %d", server.Port)
+	return nil
+}
+
+func validateDependencies(path *field.Path, dependencies []string) field.ErrorList {
+	var errs field.ErrorList
+	for i, dependency := range dependencies {
+		if err := camel.ValidateDependencyFormat(dependency); err != nil {
+			errs = append(errs, field.Invalid(path.Index(i), dependency, err.Error()))
+		}
+	}
+	return errs
+}
+
+func validateTraits(path *field.Path, err error) field.ErrorList {
+	if err == nil {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(path, field.OmitValueType{}, fmt.Sprintf("invalid trait configuration: %s", err))}
+}
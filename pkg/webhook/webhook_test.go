@@ -0,0 +1,121 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestValidateIntegration(t *testing.T) {
+	c, err := test.NewFakeClient()
+	require.NoError(t, err)
+
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "it",
+		},
+		Spec: v1.IntegrationSpec{
+			Sources: []v1.SourceSpec{
+				{
+					DataSpec: v1.DataSpec{Name: "routes.java", Content: "from(\"timer:tick\").log(\"hello\")"},
+				},
+			},
+			Dependencies: []string{"camel:log", "mvn:org.acme:acme:1.0"},
+		},
+	}
+	assert.Empty(t, validateIntegration(c, integration))
+
+	v := &integrationValidator{client: c}
+	assert.NoError(t, v.validate(integration))
+}
+
+func TestValidateInvalidIntegration(t *testing.T) {
+	c, err := test.NewFakeClient()
+	require.NoError(t, err)
+
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "it",
+			Annotations: map[string]string{
+				"trait.camel.apache.org/unknown.enabled": "true",
+			},
+		},
+		Spec: v1.IntegrationSpec{
+			Profile: v1.TraitProfileKubernetes,
+			Sources: []v1.SourceSpec{
+				{
+					DataSpec: v1.DataSpec{Name: "routes", Content: "from(\"timer:tick\").log(\"hello\")"},
+				},
+			},
+			Dependencies: []string{"camel:", "mvn:org.acme"},
+			Traits: v1.Traits{
+				Deployer: &traitv1.DeployerTrait{
+					Trait: traitv1.Trait{Enabled: pointer.Bool(true)},
+					Kind:  "knative-service",
+				},
+			},
+		},
+	}
+
+	errs := validateIntegration(c, integration)
+	require.Len(t, errs, 5)
+	assert.Equal(t, "spec.sources[0].language", errs[0].Field)
+	assert.Equal(t, "spec.dependencies[0]", errs[1].Field)
+	assert.Equal(t, "spec.dependencies[1]", errs[2].Field)
+	assert.Equal(t, "spec.traits", errs[3].Field)
+	assert.Contains(t, errs[3].Detail, "unknown trait")
+	assert.Equal(t, "spec.traits.deployer.kind", errs[4].Field)
+
+	v := &integrationValidator{client: c}
+	assert.Error(t, v.validate(integration))
+}
+
+func TestValidateIntegrationKit(t *testing.T) {
+	c, err := test.NewFakeClient()
+	require.NoError(t, err)
+
+	kit := &v1.IntegrationKit{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "kit",
+		},
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel:log"},
+		},
+	}
+	v := &integrationKitValidator{client: c}
+	assert.NoError(t, v.validate(kit))
+
+	kit.Spec.Dependencies = append(kit.Spec.Dependencies, "registry-mvn:org.acme:acme:1.0")
+	errs := validateIntegrationKit(c, kit)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.dependencies[1]", errs[0].Field)
+	assert.Error(t, v.validate(kit))
+}